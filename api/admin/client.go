@@ -6,9 +6,11 @@ package admin
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
@@ -29,6 +31,15 @@ type Client interface {
 	SetLoggerLevel(ctx context.Context, loggerName, logLevel, displayLevel string, options ...rpc.Option) (bool, error)
 	GetLoggerLevel(ctx context.Context, loggerName string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetConfig(ctx context.Context, options ...rpc.Option) (interface{}, error)
+	SnapshotDB(ctx context.Context, path string, options ...rpc.Option) (bool, error)
+	GetPeerFilter(ctx context.Context, options ...rpc.Option) (*peerfilter.Rules, error)
+	AddPeerFilterRules(ctx context.Context, rules peerfilter.Rules, options ...rpc.Option) (bool, error)
+	RemovePeerFilterRules(ctx context.Context, rules peerfilter.Rules, options ...rpc.Option) (bool, error)
+	DisconnectPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (bool, error)
+	BanPeer(ctx context.Context, nodeID ids.NodeID, duration time.Duration, options ...rpc.Option) (bool, error)
+	UnbanPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (bool, error)
+	ConnectPeer(ctx context.Context, nodeID ids.NodeID, ip string, options ...rpc.Option) (bool, error)
+	ReplayCapture(ctx context.Context, path string, options ...rpc.Option) (uint64, error)
 }
 
 // Client implementation for the Avalanche Platform Info API Endpoint
@@ -160,3 +171,71 @@ func (c *client) GetConfig(ctx context.Context, options ...rpc.Option) (interfac
 	err := c.requester.SendRequest(ctx, "getConfig", struct{}{}, &res, options...)
 	return res, err
 }
+
+func (c *client) SnapshotDB(ctx context.Context, path string, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "snapshotDB", &SnapshotDBArgs{
+		Path: path,
+	}, res, options...)
+	return res.Success, err
+}
+
+func (c *client) GetPeerFilter(ctx context.Context, options ...rpc.Option) (*peerfilter.Rules, error) {
+	res := &peerfilter.Rules{}
+	err := c.requester.SendRequest(ctx, "getPeerFilter", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) AddPeerFilterRules(ctx context.Context, rules peerfilter.Rules, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "addPeerFilterRules", &rules, res, options...)
+	return res.Success, err
+}
+
+func (c *client) RemovePeerFilterRules(ctx context.Context, rules peerfilter.Rules, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "removePeerFilterRules", &rules, res, options...)
+	return res.Success, err
+}
+
+func (c *client) DisconnectPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "disconnectPeer", &PeerArgs{
+		NodeID: nodeID,
+	}, res, options...)
+	return res.Success, err
+}
+
+func (c *client) BanPeer(ctx context.Context, nodeID ids.NodeID, duration time.Duration, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "banPeer", &BanPeerArgs{
+		NodeID:   nodeID,
+		Duration: duration.String(),
+	}, res, options...)
+	return res.Success, err
+}
+
+func (c *client) UnbanPeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "unbanPeer", &PeerArgs{
+		NodeID: nodeID,
+	}, res, options...)
+	return res.Success, err
+}
+
+func (c *client) ConnectPeer(ctx context.Context, nodeID ids.NodeID, ip string, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "connectPeer", &ConnectPeerArgs{
+		NodeID: nodeID,
+		IP:     ip,
+	}, res, options...)
+	return res.Success, err
+}
+
+func (c *client) ReplayCapture(ctx context.Context, path string, options ...rpc.Option) (uint64, error) {
+	res := &ReplayCaptureReply{}
+	err := c.requester.SendRequest(ctx, "replayCapture", &ReplayCaptureArgs{
+		Path: path,
+	}, res, options...)
+	return uint64(res.Replayed), err
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
@@ -70,6 +72,12 @@ func (mc *mockClient) SendRequest(ctx context.Context, method string, params int
 	case *GetLoggerLevelReply:
 		response := mc.response.(*GetLoggerLevelReply)
 		*p = *response
+	case *peerfilter.Rules:
+		response := mc.response.(*peerfilter.Rules)
+		*p = *response
+	case *ReplayCaptureReply:
+		response := mc.response.(*ReplayCaptureReply)
+		*p = *response
 	case *interface{}:
 		response := mc.response.(*interface{})
 		*p = *response
@@ -233,6 +241,103 @@ func TestStacktrace(t *testing.T) {
 	}
 }
 
+func TestSnapshotDB(t *testing.T) {
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(api.SuccessResponse{Success: test.Success}, test.Err)}
+		success, err := mockClient.SnapshotDB(context.Background(), "snapshot")
+		// if there is error as expected, the test passes
+		if err != nil && test.Err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if success != test.Success {
+			t.Fatalf("Expected success response to be: %v, but found: %v", test.Success, success)
+		}
+	}
+}
+
+func TestAddPeerFilterRules(t *testing.T) {
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(api.SuccessResponse{Success: test.Success}, test.Err)}
+		success, err := mockClient.AddPeerFilterRules(context.Background(), peerfilter.Rules{
+			DeniedIPs: []string{"10.0.0.0/8"},
+		})
+		// if there is error as expected, the test passes
+		if err != nil && test.Err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if success != test.Success {
+			t.Fatalf("Expected success response to be: %v, but found: %v", test.Success, success)
+		}
+	}
+}
+
+func TestBanPeer(t *testing.T) {
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(api.SuccessResponse{Success: test.Success}, test.Err)}
+		success, err := mockClient.BanPeer(context.Background(), ids.GenerateTestNodeID(), time.Hour)
+		// if there is error as expected, the test passes
+		if err != nil && test.Err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if success != test.Success {
+			t.Fatalf("Expected success response to be: %v, but found: %v", test.Success, success)
+		}
+	}
+}
+
+func TestConnectPeer(t *testing.T) {
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(api.SuccessResponse{Success: test.Success}, test.Err)}
+		success, err := mockClient.ConnectPeer(context.Background(), ids.GenerateTestNodeID(), "127.0.0.1:9651")
+		// if there is error as expected, the test passes
+		if err != nil && test.Err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if success != test.Success {
+			t.Fatalf("Expected success response to be: %v, but found: %v", test.Success, success)
+		}
+	}
+}
+
+func TestGetPeerFilter(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		expectedRules := &peerfilter.Rules{
+			DeniedNodeIDs: []ids.NodeID{ids.GenerateTestNodeID()},
+			AllowedIPs:    []string{"10.0.0.0/8"},
+		}
+		mockClient := client{requester: NewMockClient(expectedRules, nil)}
+		rules, err := mockClient.GetPeerFilter(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedRules, rules)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&peerfilter.Rules{}, errors.New("some error"))}
+		_, err := mockClient.GetPeerFilter(context.Background())
+		assert.Error(t, err)
+	})
+}
+
 func TestReloadInstalledVMs(t *testing.T) {
 	t.Run("successful", func(t *testing.T) {
 		expectedNewVMs := map[ids.ID][]string{
@@ -414,3 +519,18 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestReplayCapture(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&ReplayCaptureReply{Replayed: 5}, nil)}
+		replayed, err := mockClient.ReplayCapture(context.Background(), "capture")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 5, replayed)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&ReplayCaptureReply{}, errors.New("some error"))}
+		_, err := mockClient.ReplayCapture(context.Background(), "capture")
+		assert.Error(t, err)
+	})
+}
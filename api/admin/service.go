@@ -5,16 +5,25 @@ package admin
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/api/server"
 	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database/manager"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/utils/profiler"
@@ -34,6 +43,10 @@ const (
 var (
 	errAliasTooLong = errors.New("alias length is too long")
 	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+	errNoPath       = errors.New("need to specify a path")
+	errNotConnected = errors.New("not connected to peer")
+	errNotBanned    = errors.New("peer isn't banned")
+	errNoDuration   = errors.New("need to specify a positive duration")
 )
 
 type Config struct {
@@ -45,6 +58,17 @@ type Config struct {
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	DBManager    manager.Manager
+	// DBName is the type of the databases in [DBManager]
+	DBName string
+	// DBConfig is the config the databases in [DBManager] were created with
+	DBConfig []byte
+	// PeerFilter is the filter of the peers that the node may connect to
+	PeerFilter peerfilter.Filter
+	// Network is the p2p network that the node's peers are connected to
+	Network network.Network
+	// MsgParser parses the messages of replayed captures
+	MsgParser message.Parser
 }
 
 // Admin is the API service for node admin management
@@ -301,3 +325,173 @@ func (service *Admin) LoadVMs(_ *http.Request, _ *struct{}, reply *LoadVMsReply)
 	reply.NewVMs, err = ids.GetRelevantAliases(service.VMManager, loadedVMs)
 	return err
 }
+
+// SnapshotDBArgs are the arguments for calling SnapshotDB
+type SnapshotDBArgs struct {
+	Path string `json:"path"`
+}
+
+// SnapshotDB writes a consistent copy of the node's database to the specified
+// directory while the node keeps running. The snapshot can be restored by
+// starting a node with the db-restore-dir flag set to [args.Path].
+func (service *Admin) SnapshotDB(_ *http.Request, args *SnapshotDBArgs, reply *api.SuccessResponse) error {
+	service.Log.Debug("Admin: SnapshotDB called with Path: %q", args.Path)
+
+	if len(args.Path) == 0 {
+		return errNoPath
+	}
+	newDB, err := manager.NewDatabaseFunc(service.DBName)
+	if err != nil {
+		return err
+	}
+	if err := manager.Snapshot(service.DBManager, newDB, args.Path, service.DBConfig, service.Log); err != nil {
+		return err
+	}
+
+	reply.Success = true
+	return nil
+}
+
+// GetPeerFilter returns the NodeIDs and IPs that the node is allowed, or
+// denied, to connect to.
+func (service *Admin) GetPeerFilter(_ *http.Request, _ *struct{}, reply *peerfilter.Rules) error {
+	service.Log.Debug("Admin: GetPeerFilter called")
+
+	*reply = service.PeerFilter.Rules()
+	return nil
+}
+
+// AddPeerFilterRules adds entries to the peer filter. The entries only affect
+// new connections and IPs that are learned about after they are added.
+func (service *Admin) AddPeerFilterRules(_ *http.Request, args *peerfilter.Rules, reply *api.SuccessResponse) error {
+	service.Log.Debug("Admin: AddPeerFilterRules called")
+
+	if err := service.PeerFilter.Add(*args); err != nil {
+		return err
+	}
+	reply.Success = true
+	return nil
+}
+
+// RemovePeerFilterRules removes entries from the peer filter.
+func (service *Admin) RemovePeerFilterRules(_ *http.Request, args *peerfilter.Rules, reply *api.SuccessResponse) error {
+	service.Log.Debug("Admin: RemovePeerFilterRules called")
+
+	if err := service.PeerFilter.Remove(*args); err != nil {
+		return err
+	}
+	reply.Success = true
+	return nil
+}
+
+// PeerArgs are the arguments for calling DisconnectPeer and UnbanPeer
+type PeerArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// DisconnectPeer closes the connection to a peer. The peer may reconnect
+// afterwards, use BanPeer to prevent that.
+func (service *Admin) DisconnectPeer(_ *http.Request, args *PeerArgs, reply *api.SuccessResponse) error {
+	service.Log.Debug("Admin: DisconnectPeer called with NodeID: %s", args.NodeID)
+
+	if !service.Network.DisconnectPeer(args.NodeID) {
+		return fmt.Errorf("%w: %s", errNotConnected, args.NodeID)
+	}
+	reply.Success = true
+	return nil
+}
+
+// BanPeerArgs are the arguments for calling BanPeer
+type BanPeerArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Duration of the ban, formatted as a Go duration. e.g. "30m"
+	Duration string `json:"duration"`
+}
+
+// BanPeer disconnects from a peer and refuses connections to and from the
+// peer for the specified duration.
+func (service *Admin) BanPeer(_ *http.Request, args *BanPeerArgs, reply *api.SuccessResponse) error {
+	service.Log.Debug("Admin: BanPeer called with NodeID: %s, Duration: %q", args.NodeID, args.Duration)
+
+	duration, err := time.ParseDuration(args.Duration)
+	if err != nil {
+		return fmt.Errorf("couldn't parse duration %q: %w", args.Duration, err)
+	}
+	if duration <= 0 {
+		return errNoDuration
+	}
+
+	service.Network.BanPeer(args.NodeID, duration)
+	reply.Success = true
+	return nil
+}
+
+// UnbanPeer lifts the ban of a peer
+func (service *Admin) UnbanPeer(_ *http.Request, args *PeerArgs, reply *api.SuccessResponse) error {
+	service.Log.Debug("Admin: UnbanPeer called with NodeID: %s", args.NodeID)
+
+	if !service.Network.UnbanPeer(args.NodeID) {
+		return fmt.Errorf("%w: %s", errNotBanned, args.NodeID)
+	}
+	reply.Success = true
+	return nil
+}
+
+// ConnectPeerArgs are the arguments for calling ConnectPeer
+type ConnectPeerArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// IP and port of the peer. e.g. "127.0.0.1:9651"
+	IP string `json:"ip"`
+}
+
+// ConnectPeer attempts to connect to a peer at the specified IP. The node
+// never stops attempting to be connected to the peer.
+func (service *Admin) ConnectPeer(_ *http.Request, args *ConnectPeerArgs, reply *api.SuccessResponse) error {
+	service.Log.Debug("Admin: ConnectPeer called with NodeID: %s, IP: %q", args.NodeID, args.IP)
+
+	ip, err := ips.ToIPPort(args.IP)
+	if err != nil {
+		return fmt.Errorf("couldn't parse IP %q: %w", args.IP, err)
+	}
+
+	service.Network.ManuallyTrack(args.NodeID, ip)
+	reply.Success = true
+	return nil
+}
+
+// ReplayCaptureArgs are the arguments for calling ReplayCapture
+type ReplayCaptureArgs struct {
+	// Path of a file recorded with the network-capture-file flag
+	Path string `json:"path"`
+}
+
+// ReplayCaptureReply is the response from calling ReplayCapture
+type ReplayCaptureReply struct {
+	Replayed cjson.Uint64 `json:"replayed"`
+}
+
+// ReplayCapture feeds the consensus and app messages that were received from
+// peers in a capture file to the node's chains, in the order that they were
+// received.
+func (service *Admin) ReplayCapture(r *http.Request, args *ReplayCaptureArgs, reply *ReplayCaptureReply) error {
+	service.Log.Debug("Admin: ReplayCapture called with Path: %q", args.Path)
+
+	if len(args.Path) == 0 {
+		return errNoPath
+	}
+	file, err := os.Open(args.Path)
+	if err != nil {
+		return err
+	}
+	records, err := peer.ReadCapture(file)
+	if err := file.Close(); err != nil {
+		service.Log.Debug("couldn't close %q: %s", args.Path, err)
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't read capture %q: %w", args.Path, err)
+	}
+
+	replayed, err := peer.Replay(r.Context(), records, service.MsgParser, service.ChainManager.Router())
+	reply.Replayed = cjson.Uint64(replayed)
+	return err
+}
@@ -6,12 +6,18 @@ package admin
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
+	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/registry"
@@ -120,3 +126,112 @@ func TestLoadVMsGetAliasesFails(t *testing.T) {
 
 	assert.Equal(t, err, errOops)
 }
+
+func TestPeerFilterRules(t *testing.T) {
+	assert := assert.New(t)
+
+	peerFilter, err := peerfilter.NewFilter(peerfilter.Config{}, logging.NoLog{}, "", prometheus.NewRegistry())
+	assert.NoError(err)
+	admin := &Admin{Config: Config{
+		Log:        logging.NoLog{},
+		PeerFilter: peerFilter,
+	}}
+
+	nodeID := ids.GenerateTestNodeID()
+	successReply := api.SuccessResponse{}
+	err = admin.AddPeerFilterRules(nil, &peerfilter.Rules{
+		DeniedNodeIDs: []ids.NodeID{nodeID},
+		DeniedIPs:     []string{"10.0.0.0/8"},
+	}, &successReply)
+	assert.NoError(err)
+	assert.True(successReply.Success)
+	assert.False(peerFilter.Allowed(nodeID, nil))
+
+	successReply = api.SuccessResponse{}
+	err = admin.RemovePeerFilterRules(nil, &peerfilter.Rules{
+		DeniedNodeIDs: []ids.NodeID{nodeID},
+	}, &successReply)
+	assert.NoError(err)
+	assert.True(successReply.Success)
+	assert.True(peerFilter.Allowed(nodeID, nil))
+
+	rules := peerfilter.Rules{}
+	err = admin.GetPeerFilter(nil, nil, &rules)
+	assert.NoError(err)
+	assert.Equal([]string{"10.0.0.0/8"}, rules.DeniedIPs)
+	assert.Empty(rules.DeniedNodeIDs)
+
+	err = admin.AddPeerFilterRules(nil, &peerfilter.Rules{
+		AllowedIPs: []string{"10.0.0.0/33"},
+	}, &api.SuccessResponse{})
+	assert.Error(err)
+}
+
+// testNetwork records the peers that the admin API acts on
+type testNetwork struct {
+	network.Network
+
+	connected ids.NodeIDSet
+	banned    map[ids.NodeID]time.Duration
+	tracked   map[ids.NodeID]ips.IPPort
+}
+
+func (n *testNetwork) DisconnectPeer(nodeID ids.NodeID) bool {
+	connected := n.connected.Contains(nodeID)
+	n.connected.Remove(nodeID)
+	return connected
+}
+
+func (n *testNetwork) BanPeer(nodeID ids.NodeID, duration time.Duration) {
+	n.connected.Remove(nodeID)
+	n.banned[nodeID] = duration
+}
+
+func (n *testNetwork) UnbanPeer(nodeID ids.NodeID) bool {
+	_, banned := n.banned[nodeID]
+	delete(n.banned, nodeID)
+	return banned
+}
+
+func (n *testNetwork) ManuallyTrack(nodeID ids.NodeID, ip ips.IPPort) {
+	n.tracked[nodeID] = ip
+}
+
+func TestManagePeers(t *testing.T) {
+	assert := assert.New(t)
+
+	nodeID := ids.GenerateTestNodeID()
+	net := &testNetwork{
+		banned:  make(map[ids.NodeID]time.Duration),
+		tracked: make(map[ids.NodeID]ips.IPPort),
+	}
+	net.connected.Add(nodeID)
+	admin := &Admin{Config: Config{
+		Log:     logging.NoLog{},
+		Network: net,
+	}}
+
+	reply := api.SuccessResponse{}
+	assert.NoError(admin.DisconnectPeer(nil, &PeerArgs{NodeID: nodeID}, &reply))
+	assert.True(reply.Success)
+	err := admin.DisconnectPeer(nil, &PeerArgs{NodeID: nodeID}, &api.SuccessResponse{})
+	assert.ErrorIs(err, errNotConnected)
+
+	err = admin.BanPeer(nil, &BanPeerArgs{NodeID: nodeID, Duration: "1h30m"}, &api.SuccessResponse{})
+	assert.NoError(err)
+	assert.Equal(90*time.Minute, net.banned[nodeID])
+	err = admin.BanPeer(nil, &BanPeerArgs{NodeID: nodeID, Duration: "forever"}, &api.SuccessResponse{})
+	assert.Error(err)
+	err = admin.BanPeer(nil, &BanPeerArgs{NodeID: nodeID, Duration: "-1s"}, &api.SuccessResponse{})
+	assert.ErrorIs(err, errNoDuration)
+
+	assert.NoError(admin.UnbanPeer(nil, &PeerArgs{NodeID: nodeID}, &api.SuccessResponse{}))
+	err = admin.UnbanPeer(nil, &PeerArgs{NodeID: nodeID}, &api.SuccessResponse{})
+	assert.ErrorIs(err, errNotBanned)
+
+	err = admin.ConnectPeer(nil, &ConnectPeerArgs{NodeID: nodeID, IP: "127.0.0.1:9651"}, &api.SuccessResponse{})
+	assert.NoError(err)
+	assert.Equal("127.0.0.1:9651", net.tracked[nodeID].String())
+	err = admin.ConnectPeer(nil, &ConnectPeerArgs{NodeID: nodeID, IP: "127.0.0.1"}, &api.SuccessResponse{})
+	assert.Error(err)
+}
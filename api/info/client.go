@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
 
@@ -21,7 +22,9 @@ type Client interface {
 	GetNetworkName(context.Context, ...rpc.Option) (string, error)
 	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
 	Peers(context.Context, ...rpc.Option) ([]Peer, error)
+	PeerStats(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]peer.Stats, error)
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
+	BootstrapStatus(context.Context, string, ...rpc.Option) (*BootstrapStatusReply, error)
 	GetTxFee(context.Context, ...rpc.Option) (*GetTxFeeResponse, error)
 	Uptime(context.Context, ...rpc.Option) (*UptimeResponse, error)
 	GetVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, error)
@@ -83,6 +86,14 @@ func (c *client) Peers(ctx context.Context, options ...rpc.Option) ([]Peer, erro
 	return res.Peers, err
 }
 
+func (c *client) PeerStats(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]peer.Stats, error) {
+	res := &PeerStatsReply{}
+	err := c.requester.SendRequest(ctx, "peerStats", &PeersArgs{
+		NodeIDs: nodeIDs,
+	}, res, options...)
+	return res.Peers, err
+}
+
 func (c *client) IsBootstrapped(ctx context.Context, chainID string, options ...rpc.Option) (bool, error) {
 	res := &IsBootstrappedResponse{}
 	err := c.requester.SendRequest(ctx, "isBootstrapped", &IsBootstrappedArgs{
@@ -91,6 +102,14 @@ func (c *client) IsBootstrapped(ctx context.Context, chainID string, options ...
 	return res.IsBootstrapped, err
 }
 
+func (c *client) BootstrapStatus(ctx context.Context, chainID string, options ...rpc.Option) (*BootstrapStatusReply, error) {
+	res := &BootstrapStatusReply{}
+	err := c.requester.SendRequest(ctx, "bootstrapStatus", &BootstrapStatusArgs{
+		Chain: chainID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTxFee(ctx context.Context, options ...rpc.Option) (*GetTxFeeResponse, error) {
 	res := &GetTxFeeResponse{}
 	err := c.requester.SendRequest(ctx, "getTxFee", struct{}{}, res, options...)
@@ -18,6 +18,36 @@ type Client struct {
 	mock.Mock
 }
 
+// BootstrapStatus provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Client) BootstrapStatus(_a0 context.Context, _a1 string, _a2 ...rpc.Option) (*info.BootstrapStatusReply, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *info.BootstrapStatusReply
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...rpc.Option) *info.BootstrapStatusReply); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*info.BootstrapStatusReply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...rpc.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockchainID provides a mock function with given fields: _a0, _a1, _a2
 func (_m *Client) GetBlockchainID(_a0 context.Context, _a1 string, _a2 ...rpc.Option) (ids.ID, error) {
 	_va := make([]interface{}, len(_a2))
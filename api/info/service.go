@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -218,6 +220,24 @@ func (service *Info) Peers(_ *http.Request, args *PeersArgs, reply *PeersReply)
 	return nil
 }
 
+// PeerStatsReply are the results from calling PeerStats
+type PeerStatsReply struct {
+	// Number of elements in [Peers]
+	NumPeers json.Uint64 `json:"numPeers"`
+	// Each element is the stats of a peer
+	Peers []peer.Stats `json:"peers"`
+}
+
+// PeerStats returns the number of messages, and bytes, that were sent to and
+// received from each peer, both in total and per message type
+func (service *Info) PeerStats(_ *http.Request, args *PeersArgs, reply *PeerStatsReply) error {
+	service.log.Debug("Info: PeerStats called")
+
+	reply.Peers = service.networking.PeerStats(args.NodeIDs)
+	reply.NumPeers = json.Uint64(len(reply.Peers))
+	return nil
+}
+
 // IsBootstrappedArgs are the arguments for calling IsBootstrapped
 type IsBootstrappedArgs struct {
 	// Alias of the chain
@@ -247,6 +267,67 @@ func (service *Info) IsBootstrapped(_ *http.Request, args *IsBootstrappedArgs, r
 	return nil
 }
 
+// BootstrapStatusArgs are the arguments for calling BootstrapStatus
+type BootstrapStatusArgs struct {
+	// Alias of the chain
+	// Can also be the string representation of the chain's ID
+	Chain string `json:"chain"`
+}
+
+// BootstrapStatusReply are the results from calling BootstrapStatus
+type BootstrapStatusReply struct {
+	// True iff the chain is done bootstrapping
+	IsBootstrapped bool `json:"isBootstrapped"`
+	// One of waiting, stateSyncing, frontier, fetching, executing or done
+	Phase string `json:"phase"`
+	// Height of the last accepted block when fetching started. 0 if the chain
+	// doesn't have heights.
+	StartingHeight json.Uint64 `json:"startingHeight"`
+	// Greatest height of the blocks being fetched. 0 if the chain doesn't have
+	// heights.
+	TargetHeight json.Uint64 `json:"targetHeight"`
+	// Number of containers fetched into the execution queue, including the
+	// containers fetched before the node restarted
+	Fetched json.Uint64 `json:"fetched"`
+	// Number of containers executed out of [ToExecute]
+	Executed  json.Uint64 `json:"executed"`
+	ToExecute json.Uint64 `json:"toExecute"`
+	// Number of containers fetched or executed per second in the current phase
+	Throughput json.Float64 `json:"throughput"`
+	// Estimated number of seconds until the current phase finishes. 0 if
+	// unknown.
+	ETA json.Uint64 `json:"eta"`
+}
+
+// BootstrapStatus returns the progress of the bootstrapping of [args.Chain]
+// Returns an error if the chain doesn't exist
+func (service *Info) BootstrapStatus(_ *http.Request, args *BootstrapStatusArgs, reply *BootstrapStatusReply) error {
+	service.log.Debug("Info: BootstrapStatus called with chain: %s", args.Chain)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := service.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+	status, err := service.chainManager.BootstrapStatus(chainID)
+	if err != nil {
+		return fmt.Errorf("couldn't get the bootstrap status of '%s': %w", args.Chain, err)
+	}
+
+	reply.IsBootstrapped = status.Phase == snow.DonePhase
+	reply.Phase = status.Phase.String()
+	reply.StartingHeight = json.Uint64(status.StartingHeight)
+	reply.TargetHeight = json.Uint64(status.TargetHeight)
+	reply.Fetched = json.Uint64(status.Fetched)
+	reply.Executed = json.Uint64(status.Executed)
+	reply.ToExecute = json.Uint64(status.ToExecute)
+	reply.Throughput = json.Float64(status.Throughput)
+	reply.ETA = json.Uint64(status.ETA / time.Second)
+	return nil
+}
+
 // UptimeResponse are the results from calling Uptime
 type UptimeResponse struct {
 	// RewardingStakePercentage shows what percent of network stake thinks we're
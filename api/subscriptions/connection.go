@@ -0,0 +1,429 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package subscriptions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+var (
+	errInvalidCommand     = errors.New("invalid command")
+	errUnknownChain       = errors.New("unknown chain")
+	errUnknownType        = errors.New("unknown container type")
+	errCursorNeedsOne     = errors.New("a cursor requires exactly one chain and one container type")
+	errCursorNotIndexed   = errors.New("a cursor requires the chain to be indexed")
+	errTooManyPendingMsgs = errors.New("too many pending messages")
+)
+
+// event is a container that was accepted by a chain
+type event struct {
+	chainID       ids.ID
+	containerType indexer.ContainerType
+	container     indexer.Container
+	// nil if the container's chain isn't indexed
+	index *uint64
+}
+
+// subscription describes the containers that a connection is sent
+type subscription struct {
+	// If nil, all chains match
+	chainIDs ids.Set
+	// If nil, all container types match
+	containerTypes map[indexer.ContainerType]struct{}
+	// If nil, all txs match
+	txIDs    ids.Set
+	encoding formatting.Encoding
+
+	// The following fields are only used if a cursor was provided.
+	hasCursor bool
+	// Index of the next container to send
+	nextIndex uint64
+	// True while the containers before the current tip of the index are being
+	// sent. Newly accepted containers are buffered in [pending] meanwhile.
+	replaying bool
+	pending   []*event
+	// Closed when the subscription is replaced
+	done chan struct{}
+}
+
+func (s *subscription) matches(ev *event) bool {
+	if s.chainIDs != nil && !s.chainIDs.Contains(ev.chainID) {
+		return false
+	}
+	if s.containerTypes != nil {
+		if _, ok := s.containerTypes[ev.containerType]; !ok {
+			return false
+		}
+	}
+	if s.txIDs != nil && ev.containerType == indexer.TxType && !s.txIDs.Contains(ev.container.ID) {
+		return false
+	}
+	return true
+}
+
+func (s *subscription) accepted(ev *event) (*Accepted, error) {
+	bytes, err := formatting.EncodeWithChecksum(s.encoding, ev.container.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't encode container %s: %w", ev.container.ID, err)
+	}
+	msg := &Accepted{
+		ChainID:       ev.chainID,
+		ContainerType: ev.containerType,
+		ID:            ev.container.ID,
+		Bytes:         bytes,
+		Encoding:      s.encoding,
+		Timestamp:     time.Unix(0, ev.container.Timestamp),
+	}
+	if ev.index != nil {
+		index := avajson.Uint64(*ev.index)
+		msg.Index = &index
+	}
+	return msg, nil
+}
+
+// connection is a representation of the websocket connection.
+type connection struct {
+	s *Server
+
+	// The websocket connection.
+	conn *websocket.Conn
+
+	// Buffered channel of outbound messages.
+	send chan interface{}
+
+	// Closed when the connection is closed
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	lock sync.Mutex
+	// nil if the connection isn't subscribed
+	sub *subscription
+}
+
+// close the connection. The pumps remove the connection from the server once
+// they exit.
+func (c *connection) close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		_ = c.conn.Close()
+	})
+}
+
+// trySend queues [msg] without blocking. If the queue is full, the connection
+// is closed, as the client isn't keeping up.
+func (c *connection) trySend(msg interface{}) {
+	select {
+	case c.send <- msg:
+	default:
+		c.s.log.Debug("closing subscription connection due to %s", errTooManyPendingMsgs)
+		c.close()
+	}
+}
+
+// publish sends [ev] to the client if it's subscribed to it.
+func (c *connection) publish(ev *event) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	sub := c.sub
+	if sub == nil || !sub.matches(ev) {
+		return
+	}
+	if sub.replaying {
+		if len(sub.pending) >= maxPendingMessages {
+			c.s.log.Debug("closing subscription connection due to %s", errTooManyPendingMsgs)
+			c.close()
+			return
+		}
+		sub.pending = append(sub.pending, ev)
+		return
+	}
+	c.sendEvent(sub, ev)
+}
+
+// sendEvent assumes [c.lock] is held and [sub] isn't replaying.
+func (c *connection) sendEvent(sub *subscription, ev *event) {
+	if sub.hasCursor {
+		// Drop the containers that were already sent while replaying
+		if ev.index == nil || *ev.index < sub.nextIndex {
+			return
+		}
+		sub.nextIndex = *ev.index + 1
+	}
+	msg, err := sub.accepted(ev)
+	if err != nil {
+		c.trySend(&errorMsg{Error: err.Error()})
+		return
+	}
+	c.trySend(msg)
+}
+
+// readPump pumps messages from the websocket connection to the server.
+//
+// The application runs readPump in a per-connection goroutine. The application
+// ensures that there is at most one reader on a connection by executing all
+// reads from this goroutine.
+func (c *connection) readPump() {
+	defer func() {
+		c.close()
+		c.s.removeConnection(c)
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	// SetReadDeadline returns an error if the connection is corrupted
+	if err := c.conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+		return
+	}
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		err := c.readMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				c.s.log.Debug("Unexpected close in websockets: %s", err)
+			}
+			break
+		}
+	}
+}
+
+// writePump pumps messages from the server to the websocket connection.
+//
+// A goroutine running writePump is started for each connection. The
+// application ensures that there is at most one writer to a connection by
+// executing all writes from this goroutine.
+func (c *connection) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.close()
+		c.s.removeConnection(c)
+	}()
+	for {
+		select {
+		case message := <-c.send:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				c.s.log.Debug("failed to set the write deadline, closing the connection due to %s", err)
+				return
+			}
+			if err := c.conn.WriteJSON(message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				c.s.log.Debug("failed to set the write deadline, closing the connection due to %s", err)
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+// readMessage handles the next command of the client. Invalid commands are
+// reported to the client without closing the connection.
+func (c *connection) readMessage() error {
+	_, r, err := c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	cmd := &Command{}
+	if err := json.NewDecoder(r).Decode(cmd); err != nil {
+		return err
+	}
+
+	switch {
+	case cmd.Subscribe != nil:
+		err = c.handleSubscribe(cmd.Subscribe)
+	case cmd.Unsubscribe != nil:
+		c.handleUnsubscribe()
+	default:
+		err = errInvalidCommand
+	}
+	if err != nil {
+		c.trySend(&errorMsg{Error: err.Error()})
+	}
+	return nil
+}
+
+func (c *connection) handleSubscribe(cmd *Subscribe) error {
+	sub := &subscription{
+		encoding: cmd.Encoding,
+		done:     make(chan struct{}),
+	}
+	if len(cmd.ChainIDs) > 0 {
+		sub.chainIDs = ids.NewSet(len(cmd.ChainIDs))
+		for _, chain := range cmd.ChainIDs {
+			chainID, err := c.s.aliaser.Lookup(chain)
+			if err != nil {
+				chainID, err = ids.FromString(chain)
+				if err != nil {
+					return fmt.Errorf("%w: %q", errUnknownChain, chain)
+				}
+			}
+			sub.chainIDs.Add(chainID)
+		}
+	}
+	if len(cmd.ContainerTypes) > 0 {
+		sub.containerTypes = make(map[indexer.ContainerType]struct{}, len(cmd.ContainerTypes))
+		for _, containerType := range cmd.ContainerTypes {
+			switch containerType {
+			case indexer.BlockType, indexer.VertexType, indexer.TxType:
+			default:
+				return fmt.Errorf("%w: %q", errUnknownType, containerType)
+			}
+			sub.containerTypes[containerType] = struct{}{}
+		}
+	}
+	if len(cmd.TxIDs) > 0 {
+		sub.txIDs = ids.NewSet(len(cmd.TxIDs))
+		sub.txIDs.Add(cmd.TxIDs...)
+	}
+
+	var index indexer.Index
+	if cmd.Cursor != nil {
+		if sub.chainIDs.Len() != 1 || len(sub.containerTypes) != 1 {
+			return errCursorNeedsOne
+		}
+		var ok bool
+		index, ok = c.s.indices.GetIndex(sub.chainIDs.List()[0], cmd.ContainerTypes[0])
+		if !ok {
+			return errCursorNotIndexed
+		}
+		sub.hasCursor = true
+		sub.nextIndex = uint64(*cmd.Cursor)
+		sub.replaying = true
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.replace(sub)
+	c.trySend(&ackMsg{Subscribed: true})
+	if index != nil {
+		go c.replay(sub, sub.chainIDs.List()[0], cmd.ContainerTypes[0], index)
+	}
+	return nil
+}
+
+func (c *connection) handleUnsubscribe() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.replace(nil)
+	c.trySend(&ackMsg{Unsubscribed: true})
+}
+
+// replace the current subscription with [sub]. Assumes [c.lock] is held.
+func (c *connection) replace(sub *subscription) {
+	if c.sub != nil {
+		close(c.sub.done)
+	}
+	c.sub = sub
+}
+
+// replay sends the containers of [index] starting at [sub.nextIndex]. Once the
+// tip of the index is reached, the containers that were accepted meanwhile are
+// sent and [sub] switches to sending newly accepted containers.
+func (c *connection) replay(
+	sub *subscription,
+	chainID ids.ID,
+	containerType indexer.ContainerType,
+	index indexer.Index,
+) {
+	for {
+		lastAccepted, err := index.GetLastAccepted()
+		if err == indexer.ErrNoneAccepted {
+			break
+		}
+		if err != nil {
+			c.replayFailed(sub, err)
+			return
+		}
+		lastIndex, err := index.GetIndex(lastAccepted.ID)
+		if err != nil {
+			c.replayFailed(sub, err)
+			return
+		}
+		if sub.nextIndex > lastIndex {
+			break
+		}
+
+		numToFetch := lastIndex - sub.nextIndex + 1
+		if numToFetch > indexer.MaxFetchedByRange {
+			numToFetch = indexer.MaxFetchedByRange
+		}
+		containers, err := index.GetContainerRange(sub.nextIndex, numToFetch)
+		if err != nil {
+			c.replayFailed(sub, err)
+			return
+		}
+		for _, container := range containers {
+			i := sub.nextIndex
+			ev := &event{
+				chainID:       chainID,
+				containerType: containerType,
+				container:     container,
+				index:         &i,
+			}
+			var msg interface{}
+			msg, err = sub.accepted(ev)
+			if err != nil {
+				msg = &errorMsg{Error: err.Error()}
+			}
+
+			// Replayed messages are sent blocking, as the client is expected
+			// to be catching up.
+			select {
+			case c.send <- msg:
+			case <-sub.done:
+				return
+			case <-c.closed:
+				return
+			}
+			sub.nextIndex++
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.sub != sub {
+		return
+	}
+	sub.replaying = false
+	for _, ev := range sub.pending {
+		c.sendEvent(sub, ev)
+	}
+	sub.pending = nil
+}
+
+func (c *connection) replayFailed(sub *subscription, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.sub != sub {
+		return
+	}
+	c.s.log.Debug("failed to replay containers: %s", err)
+	c.trySend(&errorMsg{Error: fmt.Sprintf("couldn't replay containers: %s", err)})
+	c.close()
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package subscriptions
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+// Command is a message sent by a client. Exactly one of its fields must be
+// set.
+type Command struct {
+	Subscribe   *Subscribe   `json:"subscribe,omitempty"`
+	Unsubscribe *Unsubscribe `json:"unsubscribe,omitempty"`
+}
+
+// Subscribe replaces the subscription of the connection.
+type Subscribe struct {
+	// IDs or aliases of the chains to receive accepted containers of. If
+	// empty, containers of all chains are sent.
+	ChainIDs []string `json:"chainIDs"`
+	// Types of the containers to receive. If empty, containers of all types
+	// are sent.
+	ContainerTypes []indexer.ContainerType `json:"containerTypes"`
+	// If non-empty, only the txs with one of these IDs are sent.
+	TxIDs []ids.ID `json:"txIDs"`
+	// If provided, the containers in the indexer's index starting at index
+	// [Cursor] are sent before newly accepted containers. A client that was
+	// disconnected can resume by passing the index after the last container it
+	// received. Requires exactly one chain and container type to be specified,
+	// and that chain to be indexed.
+	Cursor *json.Uint64 `json:"cursor"`
+	// Encoding of the container bytes in the Accepted messages.
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// Unsubscribe removes the subscription of the connection.
+type Unsubscribe struct{}
+
+// Accepted is sent for every accepted container that matches the subscription
+// of the connection.
+type Accepted struct {
+	ChainID       ids.ID                `json:"chainID"`
+	ContainerType indexer.ContainerType `json:"containerType"`
+	ID            ids.ID                `json:"id"`
+	Bytes         string                `json:"bytes"`
+	Encoding      formatting.Encoding   `json:"encoding"`
+	Timestamp     time.Time             `json:"timestamp"`
+	// Index of the container in the indexer's index. Omitted if the
+	// container's chain isn't indexed.
+	Index *json.Uint64 `json:"index,omitempty"`
+}
+
+type ackMsg struct {
+	Subscribed   bool `json:"subscribed,omitempty"`
+	Unsubscribed bool `json:"unsubscribed,omitempty"`
+}
+
+type errorMsg struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package subscriptions
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/avalanche"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	acceptorName = "subscriptions"
+
+	// Size of the ws read buffer
+	readBufferSize = units.KiB
+
+	// Size of the ws write buffer
+	writeBufferSize = units.KiB
+
+	// Time allowed to write a message to the peer.
+	writeWait = 10 * time.Second
+
+	// Time allowed to read the next pong message from the peer.
+	pongWait = 60 * time.Second
+
+	// Send pings to peer with this period. Must be less than pongWait.
+	pingPeriod = (pongWait * 9) / 10
+
+	// Maximum message size allowed from peer.
+	maxMessageSize = 64 * units.KiB // bytes
+
+	// Maximum number of pending messages to send to a peer. A connection that
+	// falls further behind is closed, the client can then resume from the
+	// index after the last container it received.
+	maxPendingMessages = 1024 // messages
+)
+
+var (
+	_ chains.Registrant = &Server{}
+	_ http.Handler      = &Server{}
+	_ snow.Acceptor     = &acceptor{}
+
+	upgrader = websocket.Upgrader{
+		ReadBufferSize:  readBufferSize,
+		WriteBufferSize: writeBufferSize,
+		CheckOrigin:     func(*http.Request) bool { return true },
+	}
+)
+
+// IndexGetter provides the indices that cursors are resolved against.
+type IndexGetter interface {
+	GetIndex(chainID ids.ID, containerType indexer.ContainerType) (indexer.Index, bool)
+}
+
+// Server sends the containers accepted by this node to the websocket clients
+// that subscribed to them.
+type Server struct {
+	log                    logging.Logger
+	aliaser                ids.AliaserReader
+	indices                IndexGetter
+	decisionAcceptorGroup  snow.AcceptorGroup
+	consensusAcceptorGroup snow.AcceptorGroup
+	clock                  mockable.Clock
+
+	lock  sync.RWMutex
+	conns map[*connection]struct{}
+}
+
+// New returns a new subscription server.
+//
+//   - [aliaser] resolves the chain aliases that clients subscribe to.
+//   - [indices] provides the indices used to resume subscriptions from a cursor.
+//   - [decisionAcceptorGroup] notifies the server of accepted txs.
+//   - [consensusAcceptorGroup] notifies the server of accepted blocks and
+//     vertices.
+func New(
+	log logging.Logger,
+	aliaser ids.AliaserReader,
+	indices IndexGetter,
+	decisionAcceptorGroup snow.AcceptorGroup,
+	consensusAcceptorGroup snow.AcceptorGroup,
+) *Server {
+	return &Server{
+		log:                    log,
+		aliaser:                aliaser,
+		indices:                indices,
+		decisionAcceptorGroup:  decisionAcceptorGroup,
+		consensusAcceptorGroup: consensusAcceptorGroup,
+		conns:                  make(map[*connection]struct{}),
+	}
+}
+
+// RegisterChain starts sending the containers accepted by [engine]'s chain to
+// the subscribed clients. To include the indices of the containers, the
+// indexer must have been registered before the server.
+func (s *Server) RegisterChain(name string, engine common.Engine) {
+	chainID := engine.Context().ChainID
+
+	var err error
+	switch engine.(type) {
+	case snowman.Engine:
+		err = s.registerAcceptor(chainID, indexer.BlockType, s.consensusAcceptorGroup)
+	case avalanche.Engine:
+		err = s.registerAcceptor(chainID, indexer.VertexType, s.consensusAcceptorGroup)
+		if err == nil {
+			err = s.registerAcceptor(chainID, indexer.TxType, s.decisionAcceptorGroup)
+		}
+	default:
+		s.log.Error("not registering chain %s for subscriptions due to unexpected engine type %T", name, engine)
+		return
+	}
+	if err != nil {
+		s.log.Error("couldn't register chain %s for subscriptions: %s", name, err)
+	}
+}
+
+func (s *Server) registerAcceptor(chainID ids.ID, containerType indexer.ContainerType, acceptorGroup snow.AcceptorGroup) error {
+	return acceptorGroup.RegisterAcceptor(
+		chainID,
+		acceptorName,
+		&acceptor{
+			s:             s,
+			containerType: containerType,
+		},
+		false,
+	)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	wsConn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.log.Debug("failed to upgrade %s", err)
+		return
+	}
+	conn := &connection{
+		s:      s,
+		conn:   wsConn,
+		send:   make(chan interface{}, maxPendingMessages),
+		closed: make(chan struct{}),
+	}
+
+	s.lock.Lock()
+	s.conns[conn] = struct{}{}
+	s.lock.Unlock()
+
+	go conn.writePump()
+	go conn.readPump()
+}
+
+func (s *Server) removeConnection(conn *connection) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.conns, conn)
+}
+
+// publish sends a newly accepted container to the subscribed connections.
+func (s *Server) publish(chainID ids.ID, containerType indexer.ContainerType, containerID ids.ID, containerBytes []byte) {
+	ev := &event{
+		chainID:       chainID,
+		containerType: containerType,
+		container: indexer.Container{
+			ID:        containerID,
+			Bytes:     containerBytes,
+			Timestamp: s.clock.Time().UnixNano(),
+		},
+	}
+	// The indexer is registered before the server, so if the chain is indexed
+	// the container has already been added to the index.
+	if index, ok := s.indices.GetIndex(chainID, containerType); ok {
+		if i, err := index.GetIndex(containerID); err == nil {
+			ev.index = &i
+		}
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for conn := range s.conns {
+		conn.publish(ev)
+	}
+}
+
+// acceptor notifies the server of the accepted containers of a given type
+type acceptor struct {
+	s             *Server
+	containerType indexer.ContainerType
+}
+
+func (a *acceptor) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container []byte) error {
+	a.s.publish(ctx.ChainID, a.containerType, containerID, container)
+	return nil
+}
@@ -0,0 +1,309 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package subscriptions
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var _ indexer.Index = &testIndex{}
+
+// testIndex is an in-memory index
+type testIndex struct {
+	lock       sync.Mutex
+	containers []indexer.Container
+}
+
+func (i *testIndex) Accept(_ *snow.ConsensusContext, containerID ids.ID, container []byte) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.containers = append(i.containers, indexer.Container{
+		ID:        containerID,
+		Bytes:     container,
+		Timestamp: time.Now().UnixNano(),
+	})
+	return nil
+}
+
+func (i *testIndex) GetContainerByIndex(index uint64) (indexer.Container, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if index >= uint64(len(i.containers)) {
+		return indexer.Container{}, database.ErrNotFound
+	}
+	return i.containers[index], nil
+}
+
+func (i *testIndex) GetContainerRange(startIndex uint64, numToFetch uint64) ([]indexer.Container, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if startIndex >= uint64(len(i.containers)) {
+		return nil, database.ErrNotFound
+	}
+	endIndex := startIndex + numToFetch
+	if endIndex > uint64(len(i.containers)) {
+		endIndex = uint64(len(i.containers))
+	}
+	return append([]indexer.Container(nil), i.containers[startIndex:endIndex]...), nil
+}
+
+func (i *testIndex) GetLastAccepted() (indexer.Container, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if len(i.containers) == 0 {
+		return indexer.Container{}, indexer.ErrNoneAccepted
+	}
+	return i.containers[len(i.containers)-1], nil
+}
+
+func (i *testIndex) GetIndex(containerID ids.ID) (uint64, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for index, container := range i.containers {
+		if container.ID == containerID {
+			return uint64(index), nil
+		}
+	}
+	return 0, database.ErrNotFound
+}
+
+func (i *testIndex) GetContainerByID(containerID ids.ID) (indexer.Container, error) {
+	index, err := i.GetIndex(containerID)
+	if err != nil {
+		return indexer.Container{}, err
+	}
+	return i.GetContainerByIndex(index)
+}
+
+func (i *testIndex) Close() error { return nil }
+
+type testIndexGetter map[ids.ID]map[indexer.ContainerType]indexer.Index
+
+func (g testIndexGetter) GetIndex(chainID ids.ID, containerType indexer.ContainerType) (indexer.Index, bool) {
+	index, ok := g[chainID][containerType]
+	return index, ok
+}
+
+type testEnv struct {
+	t              *testing.T
+	server         *Server
+	httpServer     *httptest.Server
+	aliaser        ids.Aliaser
+	indices        testIndexGetter
+	decisionGroup  snow.AcceptorGroup
+	consensusGroup snow.AcceptorGroup
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	env := &testEnv{
+		t:              t,
+		aliaser:        ids.NewAliaser(),
+		indices:        make(testIndexGetter),
+		decisionGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+		consensusGroup: snow.NewAcceptorGroup(logging.NoLog{}),
+	}
+	env.server = New(logging.NoLog{}, env.aliaser, env.indices, env.decisionGroup, env.consensusGroup)
+	env.httpServer = httptest.NewServer(env.server)
+	t.Cleanup(env.httpServer.Close)
+	return env
+}
+
+// addChain registers a chain with the server. If [indexed], the chain's
+// containers of type [containerType] are indexed by the returned index.
+func (env *testEnv) addChain(alias string, containerType indexer.ContainerType, indexed bool) (ids.ID, *testIndex) {
+	assert := assert.New(env.t)
+
+	chainID := ids.GenerateTestID()
+	assert.NoError(env.aliaser.Alias(chainID, alias))
+
+	acceptorGroup := env.consensusGroup
+	if containerType == indexer.TxType {
+		acceptorGroup = env.decisionGroup
+	}
+
+	var index *testIndex
+	if indexed {
+		index = &testIndex{}
+		env.indices[chainID] = map[indexer.ContainerType]indexer.Index{
+			containerType: index,
+		}
+		// Mirrors the node, which registers the indexer before the server
+		assert.NoError(acceptorGroup.RegisterAcceptor(chainID, "indexer", index, true))
+	}
+	assert.NoError(env.server.registerAcceptor(chainID, containerType, acceptorGroup))
+	return chainID, index
+}
+
+func (env *testEnv) accept(chainID ids.ID, containerType indexer.ContainerType, containerID ids.ID, container []byte) {
+	ctx := snow.DefaultConsensusContextTest()
+	ctx.ChainID = chainID
+
+	acceptorGroup := env.consensusGroup
+	if containerType == indexer.TxType {
+		acceptorGroup = env.decisionGroup
+	}
+	assert.NoError(env.t, acceptorGroup.Accept(ctx, containerID, container))
+}
+
+func (env *testEnv) dial() *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(env.httpServer.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.NoError(env.t, err)
+	env.t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func subscribe(t *testing.T, conn *websocket.Conn, cmd *Subscribe) {
+	assert := assert.New(t)
+
+	assert.NoError(conn.WriteJSON(&Command{Subscribe: cmd}))
+	ack := ackMsg{}
+	assert.NoError(conn.ReadJSON(&ack))
+	assert.True(ack.Subscribed)
+}
+
+func readAccepted(t *testing.T, conn *websocket.Conn) *Accepted {
+	assert := assert.New(t)
+
+	assert.NoError(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	msg := &Accepted{}
+	assert.NoError(conn.ReadJSON(msg))
+	return msg
+}
+
+func TestSubscribeFiltersChains(t *testing.T) {
+	assert := assert.New(t)
+	env := newTestEnv(t)
+
+	chainA, _ := env.addChain("A", indexer.BlockType, false)
+	chainB, _ := env.addChain("B", indexer.BlockType, false)
+
+	conn := env.dial()
+	subscribe(t, conn, &Subscribe{
+		ChainIDs: []string{"A"},
+		Encoding: formatting.Hex,
+	})
+
+	blkB := ids.GenerateTestID()
+	env.accept(chainB, indexer.BlockType, blkB, []byte{1})
+	blkA := ids.GenerateTestID()
+	env.accept(chainA, indexer.BlockType, blkA, []byte{2})
+
+	msg := readAccepted(t, conn)
+	assert.Equal(chainA, msg.ChainID)
+	assert.Equal(indexer.BlockType, msg.ContainerType)
+	assert.Equal(blkA, msg.ID)
+	assert.Nil(msg.Index)
+	bytes, err := formatting.Decode(formatting.Hex, msg.Bytes)
+	assert.NoError(err)
+	assert.Equal([]byte{2}, bytes)
+}
+
+func TestSubscribeFiltersTxIDs(t *testing.T) {
+	assert := assert.New(t)
+	env := newTestEnv(t)
+
+	chainID, _ := env.addChain("X", indexer.TxType, false)
+
+	txID := ids.GenerateTestID()
+	conn := env.dial()
+	subscribe(t, conn, &Subscribe{
+		ContainerTypes: []indexer.ContainerType{indexer.TxType},
+		TxIDs:          []ids.ID{txID},
+	})
+
+	env.accept(chainID, indexer.TxType, ids.GenerateTestID(), []byte{1})
+	env.accept(chainID, indexer.TxType, txID, []byte{2})
+
+	msg := readAccepted(t, conn)
+	assert.Equal(txID, msg.ID)
+}
+
+func TestSubscribeCursor(t *testing.T) {
+	assert := assert.New(t)
+	env := newTestEnv(t)
+
+	chainID, _ := env.addChain("C", indexer.BlockType, true)
+
+	blkIDs := []ids.ID{}
+	for i := 0; i < 3; i++ {
+		blkID := ids.GenerateTestID()
+		blkIDs = append(blkIDs, blkID)
+		env.accept(chainID, indexer.BlockType, blkID, []byte{byte(i)})
+	}
+
+	conn := env.dial()
+	cursor := json.Uint64(1)
+	subscribe(t, conn, &Subscribe{
+		ChainIDs:       []string{chainID.String()},
+		ContainerTypes: []indexer.ContainerType{indexer.BlockType},
+		Cursor:         &cursor,
+	})
+
+	// The containers starting at the cursor are replayed
+	for i := 1; i < 3; i++ {
+		msg := readAccepted(t, conn)
+		assert.Equal(blkIDs[i], msg.ID)
+		assert.NotNil(msg.Index)
+		assert.EqualValues(i, *msg.Index)
+	}
+
+	// Newly accepted containers follow
+	blkID := ids.GenerateTestID()
+	env.accept(chainID, indexer.BlockType, blkID, []byte{3})
+	msg := readAccepted(t, conn)
+	assert.Equal(blkID, msg.ID)
+	assert.NotNil(msg.Index)
+	assert.EqualValues(3, *msg.Index)
+}
+
+func TestSubscribeInvalidCursor(t *testing.T) {
+	assert := assert.New(t)
+	env := newTestEnv(t)
+
+	env.addChain("A", indexer.BlockType, false)
+
+	conn := env.dial()
+	cursor := json.Uint64(0)
+
+	// A cursor requires a single chain and container type
+	assert.NoError(conn.WriteJSON(&Command{Subscribe: &Subscribe{
+		Cursor: &cursor,
+	}}))
+	errMsg := errorMsg{}
+	assert.NoError(conn.ReadJSON(&errMsg))
+	assert.Equal(errCursorNeedsOne.Error(), errMsg.Error)
+
+	// A cursor requires the chain to be indexed
+	assert.NoError(conn.WriteJSON(&Command{Subscribe: &Subscribe{
+		ChainIDs:       []string{"A"},
+		ContainerTypes: []indexer.ContainerType{indexer.BlockType},
+		Cursor:         &cursor,
+	}}))
+	assert.NoError(conn.ReadJSON(&errMsg))
+	assert.Equal(errCursorNotIndexed.Error(), errMsg.Error)
+
+	// The connection is still usable
+	subscribe(t, conn, &Subscribe{})
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package chainarchive implements the export and import subcommands, which
+// move the indexed containers of a chain between the indexer of a node's
+// database and an archive file. Importing blocks also has the node accept them
+// in the chain's VM.
+package chainarchive
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database/manager"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/node"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+const (
+	ExportCommand = "export"
+	ImportCommand = "import"
+)
+
+// Config of the export and import subcommands
+type Config struct {
+	// Database of the node. The node must not be running.
+	DatabaseConfig node.DatabaseConfig
+
+	// Chain to export. Ignored on import, where the chain is read from the
+	// archive.
+	ChainID ids.ID
+
+	// Type of the containers that are exported or imported
+	ContainerType indexer.ContainerType
+
+	// Path of the archive that is written on export and read on import
+	ArchivePath string
+}
+
+// Export writes the containers of the chain of [config] that are indexed in
+// the node's database to a new archive. Returns the number of containers
+// exported.
+func Export(config Config) (uint64, error) {
+	dbManager, err := openDatabase(config)
+	if err != nil {
+		return 0, err
+	}
+	defer dbManager.Close()
+
+	file, err := os.OpenFile(config.ArchivePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perms.ReadWrite)
+	if err != nil {
+		return 0, err
+	}
+
+	indexerDB := prefixdb.New(node.IndexerDBPrefix, dbManager.Current().Database)
+	numContainers, err := indexer.Export(indexerDB, logging.NoLog{}, config.ChainID, config.ContainerType, file)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(config.ArchivePath)
+		return 0, err
+	}
+	if err := file.Close(); err != nil {
+		return 0, err
+	}
+	return numContainers, nil
+}
+
+// Import indexes the containers of an archive in the indexer of the node's
+// database. Returns the ID of the chain of the archive and the number of
+// containers imported.
+//
+// If the archive holds blocks, the chain's VM is also imported: the next time
+// the node creates the chain, the blocks of the archive are verified and
+// accepted by the VM before the chain is bootstrapped from peers. The
+// containers of archives of vertices or transactions are only indexed. The
+// node must not have indexed the chain yet.
+func Import(config Config) (ids.ID, uint64, error) {
+	archivePath, err := filepath.Abs(config.ArchivePath)
+	if err != nil {
+		return ids.ID{}, 0, err
+	}
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return ids.ID{}, 0, err
+	}
+	defer file.Close()
+
+	dbManager, err := openDatabase(config)
+	if err != nil {
+		return ids.ID{}, 0, err
+	}
+	defer dbManager.Close()
+
+	db := dbManager.Current().Database
+	indexerDB := prefixdb.New(node.IndexerDBPrefix, db)
+	chainID, numContainers, err := indexer.Import(indexerDB, logging.NoLog{}, config.ContainerType, file)
+	if err != nil || config.ContainerType != indexer.BlockType {
+		return chainID, numContainers, err
+	}
+
+	lastAccepted, err := indexer.LastAccepted(indexerDB, logging.NoLog{}, chainID, config.ContainerType)
+	if err != nil {
+		return ids.ID{}, 0, err
+	}
+	// The chain's database is the node's database prefixed with the chain ID
+	if err := chains.SetArchiveImport(db, chainID, archivePath, lastAccepted.ID); err != nil {
+		return ids.ID{}, 0, fmt.Errorf("couldn't record import of chain %s: %w", chainID, err)
+	}
+	return chainID, numContainers, nil
+}
+
+func openDatabase(config Config) (manager.Manager, error) {
+	dbManager, err := manager.NewByName(
+		config.DatabaseConfig.Name,
+		config.DatabaseConfig.Path,
+		config.DatabaseConfig.Config,
+		logging.NoLog{},
+		version.CurrentDatabase,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open database at %s: %w", config.DatabaseConfig.Path, err)
+	}
+	return dbManager, nil
+}
@@ -9,9 +9,8 @@ import (
 	"sync"
 
 	"github.com/ava-labs/avalanchego/app"
-	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/manager"
-	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/pebble"
 	"github.com/ava-labs/avalanchego/database/rocksdb"
 	"github.com/ava-labs/avalanchego/nat"
 	"github.com/ava-labs/avalanchego/node"
@@ -81,25 +80,23 @@ func (p *process) Start() error {
 		return err
 	}
 
-	// start the db manager
-	var dbManager manager.Manager
-	switch p.config.DatabaseConfig.Name {
-	case rocksdb.Name:
-		path := filepath.Join(p.config.DatabaseConfig.Path, rocksdb.Name)
-		dbManager, err = manager.NewRocksDB(path, p.config.DatabaseConfig.Config, log, version.CurrentDatabase)
-	case leveldb.Name:
-		dbManager, err = manager.NewLevelDB(p.config.DatabaseConfig.Path, p.config.DatabaseConfig.Config, log, version.CurrentDatabase)
-	case memdb.Name:
-		dbManager = manager.NewMemDB(version.CurrentDatabase)
-	default:
-		err = fmt.Errorf(
-			"db-type was %q but should have been one of {%s, %s, %s}",
-			p.config.DatabaseConfig.Name,
-			leveldb.Name,
-			rocksdb.Name,
-			memdb.Name,
-		)
+	// restore the database from a snapshot before it is opened
+	if restoreDir := p.config.DatabaseConfig.RestoreDir; restoreDir != "" {
+		if err := p.restoreDatabase(log); err != nil {
+			log.Fatal("couldn't restore database snapshot from %s: %s", restoreDir, err)
+			logFactory.Close()
+			return err
+		}
 	}
+
+	// start the db manager
+	dbManager, err := manager.NewByName(
+		p.config.DatabaseConfig.Name,
+		p.config.DatabaseConfig.Path,
+		p.config.DatabaseConfig.Config,
+		log,
+		version.CurrentDatabase,
+	)
 	if err != nil {
 		log.Fatal("couldn't create %q db manager at %s: %s", p.config.DatabaseConfig.Name, p.config.DatabaseConfig.Path, err)
 		logFactory.Close()
@@ -167,11 +164,21 @@ func (p *process) Start() error {
 		log,
 		p.config.IPPort,
 	)
+	externalIPv6Updater := dynamicip.IPManager(&dynamicip.NoDynamicIP{})
+	if p.config.IPv6Port != nil {
+		externalIPv6Updater = dynamicip.NewDynamicIPManager(
+			p.config.DynamicPublicIPv6Resolver,
+			p.config.DynamicUpdateDuration,
+			log,
+			p.config.IPv6Port,
+		)
+	}
 
 	if err := p.node.Initialize(&p.config, dbManager, log, logFactory); err != nil {
 		log.Fatal("error initializing node: %s", err)
 		mapper.UnmapAllPorts()
 		externalIPUpdater.Stop()
+		externalIPv6Updater.Stop()
 		if err := dbManager.Close(); err != nil {
 			log.Warn("failed to close the node's DB: %s", err)
 		}
@@ -194,6 +201,7 @@ func (p *process) Start() error {
 		defer func() {
 			mapper.UnmapAllPorts()
 			externalIPUpdater.Stop()
+			externalIPv6Updater.Stop()
 			if err := dbManager.Close(); err != nil {
 				log.Warn("failed to close the node's DB: %s", err)
 			}
@@ -210,6 +218,34 @@ func (p *process) Start() error {
 	return nil
 }
 
+// restoreDatabase replaces the node's database with the snapshot in
+// [p.config.DatabaseConfig.RestoreDir].
+func (p *process) restoreDatabase(log logging.Logger) error {
+	newDB, err := manager.NewDatabaseFunc(p.config.DatabaseConfig.Name)
+	if err != nil {
+		return err
+	}
+
+	path := p.config.DatabaseConfig.Path
+	switch p.config.DatabaseConfig.Name {
+	case rocksdb.Name, pebble.Name:
+		path = filepath.Join(path, p.config.DatabaseConfig.Name)
+	}
+
+	backupPath, err := manager.Restore(
+		newDB,
+		p.config.DatabaseConfig.RestoreDir,
+		path,
+		p.config.DatabaseConfig.Config,
+		log,
+		version.CurrentDatabase,
+	)
+	if backupPath != "" {
+		log.Info("previous database was moved to %s", backupPath)
+	}
+	return err
+}
+
 // Stop attempts to shutdown the currently running node. This function will
 // return immediately.
 func (p *process) Stop() error {
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+
+	smbootstrap "github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap"
+)
+
+var (
+	archiveImportPrefix = []byte("archive_import")
+
+	archiveImportBlockIDKey = []byte("blockID")
+	archiveImportPathKey    = []byte("path")
+)
+
+// SetArchiveImport records, in the database [db] of the node, that the snowman
+// chain [chainID] is bootstrapped up to [blkID] from the archive at
+// [archivePath] the next time the chain is created, before it's bootstrapped
+// from peers.
+func SetArchiveImport(db database.Database, chainID ids.ID, archivePath string, blkID ids.ID) error {
+	importDB := prefixdb.New(archiveImportPrefix, prefixdb.New(chainID[:], db))
+	if err := importDB.Put(archiveImportBlockIDKey, blkID[:]); err != nil {
+		return err
+	}
+	return importDB.Put(archiveImportPathKey, []byte(archivePath))
+}
+
+// getArchiveImport returns the archive import that was recorded in the
+// database [chainDB] of a chain, or nil if there isn't one
+func getArchiveImport(chainDB database.Database) (*smbootstrap.ArchiveImport, error) {
+	importDB := prefixdb.New(archiveImportPrefix, chainDB)
+	blkIDBytes, err := importDB.Get(archiveImportBlockIDKey)
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	blkID, err := ids.ToID(blkIDBytes)
+	if err != nil {
+		return nil, err
+	}
+	archivePath, err := importDB.Get(archiveImportPathKey)
+	if err != nil {
+		return nil, err
+	}
+	return &smbootstrap.ArchiveImport{
+		BlockID:     blkID,
+		ArchivePath: string(archivePath),
+	}, nil
+}
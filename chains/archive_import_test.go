@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveImport(t *testing.T) {
+	assert := assert.New(t)
+
+	db := memdb.New()
+	chainID := ids.GenerateTestID()
+	chainDB := prefixdb.New(chainID[:], db)
+
+	archiveImport, err := getArchiveImport(chainDB)
+	assert.NoError(err)
+	assert.Nil(archiveImport)
+
+	blkID := ids.GenerateTestID()
+	assert.NoError(SetArchiveImport(db, chainID, "/archive", blkID))
+
+	archiveImport, err = getArchiveImport(chainDB)
+	assert.NoError(err)
+	assert.Equal(blkID, archiveImport.BlockID)
+	assert.Equal("/archive", archiveImport.ArchivePath)
+
+	// Other chains don't have an import
+	otherChainID := ids.GenerateTestID()
+	archiveImport, err = getArchiveImport(prefixdb.New(otherChainID[:], db))
+	assert.NoError(err)
+	assert.Nil(archiveImport)
+}
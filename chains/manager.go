@@ -24,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/trace"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/state"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/vertex"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -102,6 +103,9 @@ type Manager interface {
 	// Returns true iff the chain with the given ID exists and is finished bootstrapping
 	IsBootstrapped(ids.ID) bool
 
+	// Returns the bootstrapping progress of the chain with the given ID
+	BootstrapStatus(ids.ID) (snow.BootstrapStatus, error)
+
 	Shutdown()
 }
 
@@ -172,6 +176,12 @@ type ManagerConfig struct {
 
 	ConsensusGossipFrequency time.Duration
 
+	// Weights of the lanes of each chain's handler message queue
+	HandlerLaneWeights handler.LaneWeights
+
+	// Recording of the polls of the blocks processing in each snowman chain
+	ConsensusTraceConfig trace.Config
+
 	GossipConfig sender.GossipConfig
 
 	// Max Time to spend fetching a container and its
@@ -182,6 +192,13 @@ type ManagerConfig struct {
 	// This node will only consider the first [AncestorsMaxContainersReceived]
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int
+	// Number of workers that pre-verify upcoming blocks while snowman chains
+	// execute the blocks they bootstrapped
+	BootstrapPreVerificationWorkers int
+	// Checkpoint that a snowman chain is bootstrapped up to from a local
+	// archive before it's bootstrapped from peers. nil if chains are only
+	// bootstrapped from peers.
+	BootstrapCheckpoint *smbootstrap.CheckpointConfig
 
 	ApricotPhase4Time            time.Time
 	ApricotPhase4MinPChainHeight uint64
@@ -616,6 +633,7 @@ func (m *manager) createAvalancheChain(
 		sb.afterBootstrapped(),
 		m.ConsensusGossipFrequency,
 		m.ResourceTracker,
+		m.HandlerLaneWeights,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing network handler: %w", err)
@@ -815,6 +833,7 @@ func (m *manager) createSnowmanChain(
 		sb.afterBootstrapped(),
 		m.ConsensusGossipFrequency,
 		m.ResourceTracker,
+		m.HandlerLaneWeights,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't initialize message handler: %w", err)
@@ -847,6 +866,25 @@ func (m *manager) createSnowmanChain(
 		return nil, fmt.Errorf("couldn't initialize snow base message handler: %w", err)
 	}
 
+	var tracer trace.Tracer
+	if m.ConsensusTraceConfig.Enabled {
+		store := trace.NewStore(m.ConsensusTraceConfig)
+		tracer = store
+
+		traceHandler, err := trace.NewService(ctx.Log, store)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't initialize consensus trace API: %w", err)
+		}
+		// The route is added asynchronously for the same reason as the VM's
+		// routes are. See server.RegisterChain.
+		go func() {
+			endpoint := constants.ChainAliasPrefix + ctx.ChainID.String()
+			if err := m.Server.AddChainRoute(traceHandler, ctx, endpoint, "/debug"); err != nil {
+				ctx.Log.Error("couldn't add consensus trace API route: %s", err)
+			}
+		}()
+	}
+
 	// Create engine, bootstrapper and state-syncer in this order,
 	// to make sure start callbacks are duly initialized
 	engineConfig := smeng.Config{
@@ -856,7 +894,8 @@ func (m *manager) createSnowmanChain(
 		Sender:        commonCfg.Sender,
 		Validators:    vdrs,
 		Params:        consensusParams,
-		Consensus:     &smcon.Topological{},
+		Consensus:     &smcon.Topological{Tracer: tracer},
+		Tracer:        tracer,
 	}
 	engine, err := smeng.New(engineConfig)
 	if err != nil {
@@ -866,11 +905,19 @@ func (m *manager) createSnowmanChain(
 
 	// create bootstrap gear
 	bootstrapCfg := smbootstrap.Config{
-		Config:        commonCfg,
-		AllGetsServer: snowGetHandler,
-		Blocked:       blocked,
-		VM:            vm,
-		Bootstrapped:  m.unblockChains,
+		Config:                 commonCfg,
+		AllGetsServer:          snowGetHandler,
+		Blocked:                blocked,
+		VM:                     vm,
+		PreVerificationWorkers: m.BootstrapPreVerificationWorkers,
+		Bootstrapped:           m.unblockChains,
+	}
+	if checkpoint := m.BootstrapCheckpoint; checkpoint != nil && checkpoint.Checkpoint.ChainID == ctx.ChainID {
+		bootstrapCfg.Checkpoint = checkpoint
+	}
+	bootstrapCfg.ArchiveImport, err = getArchiveImport(db.Database)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get archive import: %w", err)
 	}
 	bootstrapper, err := smbootstrap.New(
 		bootstrapCfg,
@@ -933,6 +980,17 @@ func (m *manager) IsBootstrapped(id ids.ID) bool {
 	return chain.Context().GetState() == snow.NormalOp
 }
 
+func (m *manager) BootstrapStatus(id ids.ID) (snow.BootstrapStatus, error) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[id]
+	m.chainsLock.Unlock()
+	if !exists {
+		return snow.BootstrapStatus{}, errUnknownChainID
+	}
+
+	return chain.Context().BootstrapStatus(), nil
+}
+
 func (m *manager) chainsNotBootstrapped() []ids.ID {
 	m.chainsLock.Lock()
 	defer m.chainsLock.Unlock()
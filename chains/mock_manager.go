@@ -5,6 +5,7 @@ package chains
 
 import (
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 )
 
@@ -27,6 +28,10 @@ func (mm MockManager) Shutdown()                           {}
 func (mm MockManager) SubnetID(ids.ID) (ids.ID, error)     { return ids.ID{}, nil }
 func (mm MockManager) IsBootstrapped(ids.ID) bool          { return false }
 
+func (mm MockManager) BootstrapStatus(ids.ID) (snow.BootstrapStatus, error) {
+	return snow.BootstrapStatus{}, nil
+}
+
 func (mm MockManager) Lookup(s string) (ids.ID, error) {
 	id, err := ids.FromString(s)
 	if err == nil {
@@ -18,19 +18,26 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/ava-labs/avalanchego/app/chainarchive"
 	"github.com/ava-labs/avalanchego/app/runner"
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
 	"github.com/ava-labs/avalanchego/ipcs"
 	"github.com/ava-labs/avalanchego/nat"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/node"
 	"github.com/ava-labs/avalanchego/snow/consensus/avalanche"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/trace"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -186,7 +193,7 @@ func getAPIAuthConfig(v *viper.Viper) (node.APIAuthConfig, error) {
 	return config, nil
 }
 
-func getIPCConfig(v *viper.Viper) node.IPCConfig {
+func getIPCConfig(v *viper.Viper) (node.IPCConfig, error) {
 	config := node.IPCConfig{
 		IPCAPIEnabled: v.GetBool(IpcAPIEnabledKey),
 		IPCPath:       ipcs.DefaultBaseURL,
@@ -197,7 +204,29 @@ func getIPCConfig(v *viper.Viper) node.IPCConfig {
 	if v.IsSet(IpcsPathKey) {
 		config.IPCPath = GetExpandedArg(v, IpcsPathKey)
 	}
-	return config
+
+	var (
+		sinksBytes []byte
+		err        error
+	)
+	if v.IsSet(IpcsSinksContentKey) {
+		sinksContent := v.GetString(IpcsSinksContentKey)
+		sinksBytes, err = base64.StdEncoding.DecodeString(sinksContent)
+		if err != nil {
+			return node.IPCConfig{}, fmt.Errorf("unable to decode base64 content: %w", err)
+		}
+	} else if v.IsSet(IpcsSinksFileKey) {
+		sinksBytes, err = os.ReadFile(GetExpandedArg(v, IpcsSinksFileKey))
+		if err != nil {
+			return node.IPCConfig{}, err
+		}
+	}
+	if len(sinksBytes) > 0 {
+		if err := json.Unmarshal(sinksBytes, &config.IPCSinks); err != nil {
+			return node.IPCConfig{}, fmt.Errorf("problem unmarshaling ipcs sinks: %w", err)
+		}
+	}
+	return config, nil
 }
 
 func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
@@ -240,11 +269,12 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 				IndexAPIEnabled:      v.GetBool(IndexEnabledKey),
 				IndexAllowIncomplete: v.GetBool(IndexAllowIncompleteKey),
 			},
-			AdminAPIEnabled:    v.GetBool(AdminAPIEnabledKey),
-			InfoAPIEnabled:     v.GetBool(InfoAPIEnabledKey),
-			KeystoreAPIEnabled: v.GetBool(KeystoreAPIEnabledKey),
-			MetricsAPIEnabled:  v.GetBool(MetricsAPIEnabledKey),
-			HealthAPIEnabled:   v.GetBool(HealthAPIEnabledKey),
+			AdminAPIEnabled:         v.GetBool(AdminAPIEnabledKey),
+			InfoAPIEnabled:          v.GetBool(InfoAPIEnabledKey),
+			KeystoreAPIEnabled:      v.GetBool(KeystoreAPIEnabledKey),
+			MetricsAPIEnabled:       v.GetBool(MetricsAPIEnabledKey),
+			HealthAPIEnabled:        v.GetBool(HealthAPIEnabledKey),
+			SubscriptionsAPIEnabled: v.GetBool(SubscriptionsAPIEnabledKey),
 		},
 		HTTPHost:          v.GetString(HTTPHostKey),
 		HTTPPort:          uint16(v.GetUint(HTTPPortKey)),
@@ -261,8 +291,8 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 	if err != nil {
 		return node.HTTPConfig{}, err
 	}
-	config.IPCConfig = getIPCConfig(v)
-	return config, nil
+	config.IPCConfig, err = getIPCConfig(v)
+	return config, err
 }
 
 func getRouterHealthConfig(v *viper.Viper, halflife time.Duration) (router.HealthConfig, error) {
@@ -329,6 +359,10 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 	upgradeCooldown := v.GetDuration(InboundConnUpgradeThrottlerCooldownKey)
 	upgradeCooldownInSeconds := upgradeCooldown.Seconds()
 	maxRecentConnsUpgraded := int(math.Ceil(maxInboundConnsPerSec * upgradeCooldownInSeconds))
+	proxyConfig, err := getProxyConfig(v)
+	if err != nil {
+		return network.Config{}, err
+	}
 	config := network.Config{
 		// Throttling
 		ThrottlerConfig: network.ThrottlerConfig{
@@ -362,6 +396,13 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 				VdrAllocSize:        v.GetUint64(OutboundThrottlerVdrAllocSizeKey),
 				NodeMaxAtLargeBytes: v.GetUint64(OutboundThrottlerNodeMaxAtLargeBytesKey),
 			},
+
+			OutboundBandwidthThrottlerConfig: throttling.OutboundBandwidthThrottlerConfig{
+				RefillRate:       v.GetUint64(OutboundThrottlerBandwidthRefillRateKey),
+				MaxBurstSize:     v.GetUint64(OutboundThrottlerBandwidthMaxBurstSizeKey),
+				NodeRefillRate:   v.GetUint64(OutboundThrottlerNodeBandwidthRefillRateKey),
+				NodeMaxBurstSize: v.GetUint64(OutboundThrottlerNodeBandwidthMaxBurstSizeKey),
+			},
 		},
 
 		HealthConfig: network.HealthConfig{
@@ -376,8 +417,22 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 		DialerConfig: dialer.Config{
 			ThrottleRps:       v.GetUint32(OutboundConnectionThrottlingRps),
 			ConnectionTimeout: v.GetDuration(OutboundConnectionTimeout),
+			ProxyConfig:       proxyConfig,
+		},
+
+		PeerFilterConfig: peerfilter.Config{
+			Path:            GetExpandedArg(v, NetworkPeerFilterFileKey),
+			ReloadFrequency: v.GetDuration(NetworkPeerFilterReloadFrequencyKey),
 		},
 
+		CaptureConfig: peer.RecorderConfig{
+			Path:        GetExpandedArg(v, NetworkCaptureFileKey),
+			MaxFileSize: v.GetUint64(NetworkCaptureMaxFileSizeKey),
+			MaxFiles:    v.GetInt(NetworkCaptureMaxFilesKey),
+		},
+
+		PeerMetricsMaxPeers: v.GetInt(NetworkPeerMetricsMaxPeersKey),
+
 		TimeoutConfig: network.TimeoutConfig{
 			PingPongTimeout:      v.GetDuration(NetworkPingTimeoutKey),
 			ReadHandshakeTimeout: v.GetDuration(NetworkReadHandshakeTimeoutKey),
@@ -398,6 +453,8 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 
 		MaxClockDifference:           v.GetDuration(NetworkMaxClockDifferenceKey),
 		CompressionEnabled:           v.GetBool(NetworkCompressionEnabledKey),
+		ProtoCodecEnabled:            v.GetBool(NetworkProtoCodecEnabledKey),
+		ZstdEnabled:                  v.GetBool(NetworkCompressionZstdEnabledKey),
 		PingFrequency:                v.GetDuration(NetworkPingFrequencyKey),
 		AllowPrivateIPs:              v.GetBool(NetworkAllowPrivateIPsKey),
 		UptimeMetricFreq:             v.GetDuration(UptimeMetricFreqKey),
@@ -419,6 +476,12 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 		return network.Config{}, fmt.Errorf("%s must be in [0,1]", NetworkHealthMaxPortionSendQueueFillKey)
 	case config.DialerConfig.ConnectionTimeout < 0:
 		return network.Config{}, fmt.Errorf("%q must be >= 0", OutboundConnectionTimeout)
+	case config.ThrottlerConfig.OutboundBandwidthThrottlerConfig.RefillRate != 0 &&
+		config.ThrottlerConfig.OutboundBandwidthThrottlerConfig.MaxBurstSize < constants.DefaultMaxMessageSize:
+		return network.Config{}, fmt.Errorf("%q must be >= %d", OutboundThrottlerBandwidthMaxBurstSizeKey, constants.DefaultMaxMessageSize)
+	case config.ThrottlerConfig.OutboundBandwidthThrottlerConfig.NodeRefillRate != 0 &&
+		config.ThrottlerConfig.OutboundBandwidthThrottlerConfig.NodeMaxBurstSize < constants.DefaultMaxMessageSize:
+		return network.Config{}, fmt.Errorf("%q must be >= %d", OutboundThrottlerNodeBandwidthMaxBurstSizeKey, constants.DefaultMaxMessageSize)
 	case config.PeerListGossipFreq < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerListGossipFreqKey)
 	case config.MaxReconnectDelay < 0:
@@ -437,6 +500,12 @@ func getNetworkConfig(v *viper.Viper, halflife time.Duration) (network.Config, e
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkReadHandshakeTimeoutKey)
 	case config.MaxClockDifference < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkMaxClockDifferenceKey)
+	case config.PeerFilterConfig.ReloadFrequency < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerFilterReloadFrequencyKey)
+	case config.CaptureConfig.MaxFiles < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkCaptureMaxFilesKey)
+	case config.PeerMetricsMaxPeers < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerMetricsMaxPeersKey)
 	}
 	return config, nil
 }
@@ -505,7 +574,14 @@ func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig,
 		BootstrapMaxTimeGetAncestors:            v.GetDuration(BootstrapMaxTimeGetAncestorsKey),
 		BootstrapAncestorsMaxContainersSent:     int(v.GetUint(BootstrapAncestorsMaxContainersSentKey)),
 		BootstrapAncestorsMaxContainersReceived: int(v.GetUint(BootstrapAncestorsMaxContainersReceivedKey)),
+		BootstrapPreVerificationWorkers:         int(v.GetUint(BootstrapPreVerificationWorkersKey)),
+	}
+
+	checkpointConfig, err := getBootstrapCheckpointConfig(v)
+	if err != nil {
+		return node.BootstrapConfig{}, err
 	}
+	config.BootstrapCheckpoint = checkpointConfig
 
 	ipsSet := v.IsSet(BootstrapIPsKey)
 	idsSet := v.IsSet(BootstrapIDsKey)
@@ -554,19 +630,92 @@ func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig,
 	return config, nil
 }
 
+func getBootstrapCheckpointConfig(v *viper.Viper) (*bootstrap.CheckpointConfig, error) {
+	if !v.IsSet(BootstrapCheckpointFileKey) {
+		return nil, nil
+	}
+	if !v.IsSet(BootstrapCheckpointArchiveFileKey) {
+		return nil, fmt.Errorf("set %q but didn't set %q", BootstrapCheckpointFileKey, BootstrapCheckpointArchiveFileKey)
+	}
+
+	trustedSigners := ids.ShortSet{}
+	for _, signer := range strings.Split(v.GetString(BootstrapCheckpointSignersKey), ",") {
+		if signer == "" {
+			continue
+		}
+		signerID, err := ids.ShortFromString(signer)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse checkpoint signer %s: %w", signer, err)
+		}
+		trustedSigners.Add(signerID)
+	}
+	if trustedSigners.Len() == 0 {
+		return nil, fmt.Errorf("set %q but didn't set %q", BootstrapCheckpointFileKey, BootstrapCheckpointSignersKey)
+	}
+
+	checkpointPath := GetExpandedArg(v, BootstrapCheckpointFileKey)
+	checkpointBytes, err := os.ReadFile(filepath.Clean(checkpointPath))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read checkpoint file %s: %w", checkpointPath, err)
+	}
+	config := &bootstrap.CheckpointConfig{
+		ArchivePath: GetExpandedArg(v, BootstrapCheckpointArchiveFileKey),
+	}
+	if err := json.Unmarshal(checkpointBytes, &config.Checkpoint); err != nil {
+		return nil, fmt.Errorf("couldn't parse checkpoint file %s: %w", checkpointPath, err)
+	}
+	if err := config.Checkpoint.Verify(trustedSigners); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", checkpointPath, err)
+	}
+	if _, err := os.Stat(config.ArchivePath); err != nil {
+		return nil, fmt.Errorf("couldn't find checkpoint archive: %w", err)
+	}
+	return config, nil
+}
+
+func getProxyConfig(v *viper.Viper) (dialer.ProxyConfig, error) {
+	config := dialer.ProxyConfig{
+		Address:  v.GetString(ProxyAddressKey),
+		Username: v.GetString(ProxyUsernameKey),
+		Password: v.GetString(ProxyPasswordKey),
+	}
+	switch {
+	case config.Address == "" && config.Username != "":
+		return dialer.ProxyConfig{}, fmt.Errorf("%q requires %q to be set", ProxyUsernameKey, ProxyAddressKey)
+	case config.Username == "" && config.Password != "":
+		return dialer.ProxyConfig{}, fmt.Errorf("%q requires %q to be set", ProxyPasswordKey, ProxyUsernameKey)
+	case config.Address != "":
+		if _, _, err := net.SplitHostPort(config.Address); err != nil {
+			return dialer.ProxyConfig{}, fmt.Errorf("invalid %q: %w", ProxyAddressKey, err)
+		}
+	}
+	return config, nil
+}
+
 func getIPConfig(v *viper.Viper) (node.IPConfig, error) {
 	config := node.IPConfig{}
+
+	// If a proxy is configured, our public IP is resolved through it
+	proxyConfig, err := getProxyConfig(v)
+	if err != nil {
+		return node.IPConfig{}, err
+	}
+	var resolverDialer dynamicip.Dialer
+	if proxyConfig.Address != "" {
+		resolverDialer, err = dialer.NewContextDialer(10*time.Second, proxyConfig)
+		if err != nil {
+			return node.IPConfig{}, fmt.Errorf("couldn't create proxy dialer: %w", err)
+		}
+	}
+
 	// Resolves our public IP, or does nothing
-	config.DynamicPublicIPResolver = dynamicip.NewResolver(v.GetString(DynamicPublicIPResolverKey))
+	config.DynamicPublicIPResolver = dynamicip.NewResolver(v.GetString(DynamicPublicIPResolverKey), resolverDialer)
 	config.DynamicUpdateDuration = v.GetDuration(DynamicUpdateDurationKey)
 	if config.DynamicUpdateDuration < 0 {
 		return node.IPConfig{}, fmt.Errorf("%q must be <= 0", DynamicUpdateDurationKey)
 	}
 
-	var (
-		ip  net.IP
-		err error
-	)
+	var ip net.IP
 	publicIP := v.GetString(PublicIPKey)
 	switch {
 	case config.DynamicPublicIPResolver.IsResolver():
@@ -595,6 +744,29 @@ func getIPConfig(v *viper.Viper) (node.IPConfig, error) {
 
 	stakingPort := uint16(v.GetUint(StakingPortKey))
 	config.IPPort = ips.NewDynamicIPPort(ip, stakingPort)
+
+	// Resolves our public IPv6 address, or does nothing
+	config.DynamicPublicIPv6Resolver = dynamicip.NewIPv6Resolver(v.GetString(DynamicPublicIPv6ResolverKey), resolverDialer)
+	var ipv6 net.IP
+	publicIPv6 := v.GetString(PublicIPv6Key)
+	switch {
+	case config.DynamicPublicIPv6Resolver.IsResolver():
+		ipv6, err = dynamicip.FetchExternalIP(config.DynamicPublicIPv6Resolver)
+		if err != nil {
+			return node.IPConfig{}, fmt.Errorf("dynamic ipv6 address fetch failed: %w", err)
+		}
+	case publicIPv6 != "":
+		ipv6 = net.ParseIP(publicIPv6)
+		if ipv6 == nil || ipv6.To4() != nil {
+			return node.IPConfig{}, fmt.Errorf("invalid IPv6 Address %s", publicIPv6)
+		}
+	default:
+		return config, nil
+	}
+	if ip.To4() == nil {
+		return node.IPConfig{}, fmt.Errorf("%s must be an IPv4 address when an IPv6 address is advertised", PublicIPKey)
+	}
+	config.IPv6Port = ips.NewDynamicIPPort(ipv6, stakingPort)
 	return config, nil
 }
 
@@ -808,7 +980,8 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 			GetExpandedArg(v, DBPathKey),
 			constants.NetworkName(networkID),
 		),
-		Config: configBytes,
+		Config:     configBytes,
+		RestoreDir: GetExpandedArg(v, DBRestoreDirKey),
 	}, nil
 }
 
@@ -1119,6 +1292,34 @@ func GetNodeConfig(v *viper.Viper, buildDir string) (node.Config, error) {
 		return node.Config{}, fmt.Errorf("%s must be >= 0", ConsensusGossipFrequencyKey)
 	}
 
+	// Handler
+	nodeConfig.HandlerLaneWeights = handler.LaneWeights{
+		Consensus:     v.GetInt(HandlerConsensusLaneWeightKey),
+		Bootstrapping: v.GetInt(HandlerBootstrappingLaneWeightKey),
+	}
+	switch {
+	case nodeConfig.HandlerLaneWeights.Consensus <= 0:
+		return node.Config{}, fmt.Errorf("%q must be > 0", HandlerConsensusLaneWeightKey)
+	case nodeConfig.HandlerLaneWeights.Bootstrapping <= 0:
+		return node.Config{}, fmt.Errorf("%q must be > 0", HandlerBootstrappingLaneWeightKey)
+	}
+
+	// Consensus tracing
+	nodeConfig.ConsensusTraceConfig = trace.Config{
+		Enabled:          v.GetBool(ConsensusTraceEnabledKey),
+		MaxBlocks:        v.GetInt(ConsensusTraceMaxBlocksKey),
+		MaxPollsPerBlock: v.GetInt(ConsensusTraceMaxPollsPerBlockKey),
+		Retention:        v.GetDuration(ConsensusTraceRetentionKey),
+	}
+	switch {
+	case nodeConfig.ConsensusTraceConfig.MaxBlocks <= 0:
+		return node.Config{}, fmt.Errorf("%q must be > 0", ConsensusTraceMaxBlocksKey)
+	case nodeConfig.ConsensusTraceConfig.MaxPollsPerBlock <= 0:
+		return node.Config{}, fmt.Errorf("%q must be > 0", ConsensusTraceMaxPollsPerBlockKey)
+	case nodeConfig.ConsensusTraceConfig.Retention < 0:
+		return node.Config{}, fmt.Errorf("%q must be >= 0", ConsensusTraceRetentionKey)
+	}
+
 	var err error
 	// Logging
 	nodeConfig.LoggingConfig, err = getLoggingConfig(v)
@@ -1156,6 +1357,12 @@ func GetNodeConfig(v *viper.Viper, buildDir string) (node.Config, error) {
 		return node.Config{}, err
 	}
 
+	// Mempool
+	nodeConfig.MempoolFeePriority = v.GetBool(MempoolFeePriorityKey)
+
+	// P-chain state history
+	nodeConfig.StateHistoryRetention = v.GetUint64(StateHistoryRetentionKey)
+
 	// HTTP APIs
 	nodeConfig.HTTPConfig, err = getHTTPConfig(v)
 	if err != nil {
@@ -1271,3 +1478,40 @@ func GetNodeConfig(v *viper.Viper, buildDir string) (node.Config, error) {
 	nodeConfig.DiskTargeterConfig, err = getDiskTargeterConfig(v)
 	return nodeConfig, err
 }
+
+// GetChainArchiveConfig returns the config of the export or import subcommand
+// [command]
+func GetChainArchiveConfig(v *viper.Viper, command string) (chainarchive.Config, error) {
+	networkID, err := constants.NetworkID(v.GetString(NetworkNameKey))
+	if err != nil {
+		return chainarchive.Config{}, err
+	}
+	config := chainarchive.Config{
+		ContainerType: indexer.ContainerType(v.GetString(ArchiveContainerTypeKey)),
+		ArchivePath:   GetExpandedArg(v, ArchiveFileKey),
+	}
+	config.DatabaseConfig, err = getDatabaseConfig(v, networkID)
+	if err != nil {
+		return chainarchive.Config{}, err
+	}
+
+	switch config.ContainerType {
+	case indexer.BlockType, indexer.VertexType, indexer.TxType:
+	default:
+		return chainarchive.Config{}, fmt.Errorf("%q must be one of {%s, %s, %s} but is %q",
+			ArchiveContainerTypeKey, indexer.BlockType, indexer.VertexType, indexer.TxType, config.ContainerType)
+	}
+	if config.ArchivePath == "" {
+		return chainarchive.Config{}, fmt.Errorf("%q must be set", ArchiveFileKey)
+	}
+	if command == chainarchive.ExportCommand {
+		if !v.IsSet(ArchiveChainIDKey) {
+			return chainarchive.Config{}, fmt.Errorf("%q must be set", ArchiveChainIDKey)
+		}
+		config.ChainID, err = ids.FromString(v.GetString(ArchiveChainIDKey))
+		if err != nil {
+			return chainarchive.Config{}, fmt.Errorf("couldn't parse %q: %w", ArchiveChainIDKey, err)
+		}
+	}
+	return config, nil
+}
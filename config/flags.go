@@ -18,8 +18,11 @@ import (
 
 	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/pebble"
 	"github.com/ava-labs/avalanchego/database/rocksdb"
 	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ulimit"
 	"github.com/ava-labs/avalanchego/utils/units"
@@ -104,12 +107,15 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Uint64(CreateAssetTxFeeKey, genesis.LocalParams.CreateAssetTxFee, "Transaction fee, in nAVAX, for transactions that create new assets")
 	fs.Uint64(CreateSubnetTxFeeKey, genesis.LocalParams.CreateSubnetTxFee, "Transaction fee, in nAVAX, for transactions that create new subnets")
 	fs.Uint64(CreateBlockchainTxFeeKey, genesis.LocalParams.CreateBlockchainTxFee, "Transaction fee, in nAVAX, for transactions that create new blockchains")
+	fs.Bool(MempoolFeePriorityKey, false, "If true, the P-chain issues the decision transactions in its mempool with the highest fee per byte first rather than in the order they were received")
+	fs.Uint64(StateHistoryRetentionKey, 0, "Number of accepted P-chain blocks, below the last accepted block, that the P-chain state can be queried at through the height arguments of the platform API. If 0, the state history isn't recorded and any recorded history is deleted")
 
 	// Database
-	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s, %s}", leveldb.Name, rocksdb.Name, memdb.Name))
+	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s, %s, %s}", leveldb.Name, rocksdb.Name, pebble.Name, memdb.Name))
 	fs.String(DBPathKey, defaultDBDir, "Path to database directory")
 	fs.String(DBConfigFileKey, "", fmt.Sprintf("Path to database config file. Ignored if %s is specified", DBConfigContentKey))
 	fs.String(DBConfigContentKey, "", "Specifies base64 encoded database config content")
+	fs.String(DBRestoreDirKey, "", "Path to a database snapshot, written by admin.snapshotDB, to restore before the database is opened. The existing database is moved aside. A snapshot is only restored once, so restarting with this flag set doesn't restore it again")
 
 	// Logging
 	fs.String(LogsDirKey, defaultLogDir, "Logging directory for Avalanche")
@@ -147,6 +153,8 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.String(PublicIPKey, "", "Public IP of this node for P2P communication. If empty, try to discover with NAT. Ignored if dynamic-public-ip is non-empty")
 	fs.Duration(DynamicUpdateDurationKey, 5*time.Minute, "Dynamic IP and NAT Traversal update duration")
 	fs.String(DynamicPublicIPResolverKey, "", "'ifconfigco' (alias 'ifconfig') or 'opendns' or 'ifconfigme'. By default does not do dynamic public IP updates. If non-empty, ignores public-ip argument")
+	fs.String(PublicIPv6Key, "", fmt.Sprintf("Public IPv6 address of this node for P2P communication, advertised in addition to the IPv4 address given by %s. If empty, only a single IP is advertised. Ignored if %s is non-empty", PublicIPKey, DynamicPublicIPv6ResolverKey))
+	fs.String(DynamicPublicIPv6ResolverKey, "", fmt.Sprintf("'ifconfigco' (alias 'ifconfig') or 'opendns' or 'ifconfigme'. Resolves the public IPv6 address of this node over IPv6. By default does not do dynamic public IPv6 updates. If non-empty, ignores %s argument", PublicIPv6Key))
 
 	// Inbound Connection Throttling
 	fs.Duration(InboundConnUpgradeThrottlerCooldownKey, 10*time.Second, "Upgrade an inbound connection from a given IP at most once per this duration. If 0, don't rate-limit inbound connection upgrades")
@@ -154,6 +162,9 @@ func addNodeFlags(fs *flag.FlagSet) {
 	// Outbound Connection Throttling
 	fs.Uint(OutboundConnectionThrottlingRps, 50, "Make at most this number of outgoing peer connection attempts per second")
 	fs.Duration(OutboundConnectionTimeout, 30*time.Second, "Timeout when dialing a peer")
+	fs.String(ProxyAddressKey, "", "Address (host:port) of a SOCKS5 proxy that outbound peer connections and dynamic public IP resolution are made through. If empty, connections are made directly")
+	fs.String(ProxyUsernameKey, "", fmt.Sprintf("Username to authenticate with the proxy at %s. If empty, the proxy is used without authentication", ProxyAddressKey))
+	fs.String(ProxyPasswordKey, "", fmt.Sprintf("Password to authenticate with the proxy at %s", ProxyAddressKey))
 	// Timeouts
 	fs.Duration(NetworkInitialTimeoutKey, 5*time.Second, "Initial timeout value of the adaptive timeout manager")
 	fs.Duration(NetworkMinimumTimeoutKey, 2*time.Second, "Minimum timeout value of the adaptive timeout manager")
@@ -166,11 +177,19 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Duration(NetworkPingFrequencyKey, constants.DefaultPingFrequency, "Frequency of pinging other peers")
 
 	fs.Bool(NetworkCompressionEnabledKey, true, "If true, compress certain outbound messages. This node will be able to parse compressed inbound messages regardless of this flag's value")
+	fs.Bool(NetworkCompressionZstdEnabledKey, true, "If true, compress certain outbound messages with zstd, rather than gzip, when sending them to peers that advertise support for zstd. This node will be able to parse zstd compressed inbound messages regardless of this flag's value")
+	fs.Bool(NetworkProtoCodecEnabledKey, true, "If true, send protobuf encoded messages to peers that advertise support for them. This node will be able to parse protobuf encoded inbound messages regardless of this flag's value")
 	fs.Duration(NetworkMaxClockDifferenceKey, time.Minute, "Max allowed clock difference value between this node and peers")
 	fs.Bool(NetworkAllowPrivateIPsKey, true, "Allows the node to initiate outbound connection attempts to peers with private IPs")
 	fs.Bool(NetworkRequireValidatorToConnectKey, false, "If true, this node will only maintain a connection with another node if this node is a validator, the other node is a validator, or the other node is a beacon")
 	fs.Uint(NetworkPeerReadBufferSizeKey, 8*units.KiB, "Size, in bytes, of the buffer that we read peer messages into (there is one buffer per peer)")
 	fs.Uint(NetworkPeerWriteBufferSizeKey, 8*units.KiB, "Size, in bytes, of the buffer that we write peer messages into (there is one buffer per peer)")
+	fs.String(NetworkPeerFilterFileKey, "", "Specifies a JSON file of the NodeIDs and IPs (in CIDR notation) that this node is allowed, or denied, to connect to. If the file is modified, it is reloaded. Entries added or removed with the admin API are written to the file")
+	fs.Duration(NetworkPeerFilterReloadFrequencyKey, 10*time.Second, fmt.Sprintf("Frequency of checking whether the file specified by %s was modified", NetworkPeerFilterFileKey))
+	fs.String(NetworkCaptureFileKey, "", "Specifies a file that every message sent to and received from peers is recorded to. The capture can be replayed with the admin API. If empty, messages aren't recorded")
+	fs.Uint64(NetworkCaptureMaxFileSizeKey, 64*units.MiB, fmt.Sprintf("Size, in bytes, that the file specified by %s may grow to before it's rotated. If 0, the file is never rotated", NetworkCaptureFileKey))
+	fs.Int(NetworkCaptureMaxFilesKey, 5, fmt.Sprintf("Number of rotated files specified by %s that are kept", NetworkCaptureFileKey))
+	fs.Int(NetworkPeerMetricsMaxPeersKey, 0, "Maximum number of peers whose sent and received messages are reported in metrics labelled by their NodeID. The messages of the remaining peers are reported with the \"other\" label. If 0, messages aren't reported per peer")
 
 	// Benchlist
 	fs.Int(BenchlistFailThresholdKey, 10, "Number of consecutive failed queries before benchlisting a node")
@@ -180,6 +199,12 @@ func addNodeFlags(fs *flag.FlagSet) {
 	// Router
 	fs.Duration(ConsensusGossipFrequencyKey, 10*time.Second, "Frequency of gossiping accepted frontiers")
 	fs.Duration(ConsensusShutdownTimeoutKey, 30*time.Second, "Timeout before killing an unresponsive chain")
+	fs.Int(HandlerConsensusLaneWeightKey, handler.DefaultLaneWeights.Consensus, "Number of consensus messages (queries, votes and container fetches) a chain handles in each round while messages of multiple lanes are queued. Must be > 0")
+	fs.Int(HandlerBootstrappingLaneWeightKey, handler.DefaultLaneWeights.Bootstrapping, "Number of bootstrapping and state sync messages a chain handles in each round while messages of multiple lanes are queued. Must be > 0")
+	fs.Bool(ConsensusTraceEnabledKey, false, "If true, the polls of the blocks processing in each snowman chain are recorded and served by the chain's debug API")
+	fs.Int(ConsensusTraceMaxBlocksKey, 1024, "Max number of blocks whose poll history is kept for each chain. Must be > 0")
+	fs.Int(ConsensusTraceMaxPollsPerBlockKey, 256, "Max number of polls kept in the history of a block. The oldest polls of a block are dropped first. Must be > 0")
+	fs.Duration(ConsensusTraceRetentionKey, 5*time.Minute, "Amount of time the poll history of a block is kept after the block is decided")
 	fs.Uint(ConsensusGossipAcceptedFrontierValidatorSizeKey, 0, "Number of validators to gossip to when gossiping accepted frontier")
 	fs.Uint(ConsensusGossipAcceptedFrontierNonValidatorSizeKey, 0, "Number of non-validators to gossip to when gossiping accepted frontier")
 	fs.Uint(ConsensusGossipAcceptedFrontierPeerSizeKey, 35, "Number of peers to gossip to when gossiping accepted frontier")
@@ -204,6 +229,10 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Uint64(OutboundThrottlerAtLargeAllocSizeKey, 6*units.MiB, "Size, in bytes, of at-large byte allocation in outbound message throttler")
 	fs.Uint64(OutboundThrottlerVdrAllocSizeKey, 32*units.MiB, "Size, in bytes, of validator byte allocation in outbound message throttler")
 	fs.Uint64(OutboundThrottlerNodeMaxAtLargeBytesKey, constants.DefaultMaxMessageSize, "Max number of bytes a node can take from the outbound message throttler's at-large allocation.  Must be at least the max message size")
+	fs.Uint64(OutboundThrottlerBandwidthRefillRateKey, 0, "Max average outbound bandwidth usage to a peer, in bytes per second. If 0, the outbound bandwidth to each peer isn't limited. See OutboundBandwidthThrottler")
+	fs.Uint64(OutboundThrottlerBandwidthMaxBurstSizeKey, constants.DefaultMaxMessageSize, "Max outbound bandwidth that can be used to a peer at once. Must be at least the max message size. See OutboundBandwidthThrottler")
+	fs.Uint64(OutboundThrottlerNodeBandwidthRefillRateKey, 0, "Max average outbound bandwidth usage to all peers combined, in bytes per second. If 0, the outbound bandwidth of this node isn't limited. See OutboundBandwidthThrottler")
+	fs.Uint64(OutboundThrottlerNodeBandwidthMaxBurstSizeKey, constants.DefaultMaxMessageSize, "Max outbound bandwidth that can be used to all peers combined at once. Must be at least the max message size. See OutboundBandwidthThrottler")
 
 	// HTTP APIs
 	fs.String(HTTPHostKey, "127.0.0.1", "Address of the HTTP server")
@@ -228,6 +257,7 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Bool(KeystoreAPIEnabledKey, true, "If true, this node exposes the Keystore API")
 	fs.Bool(MetricsAPIEnabledKey, true, "If true, this node exposes the Metrics API")
 	fs.Bool(HealthAPIEnabledKey, true, "If true, this node exposes the Health API")
+	fs.Bool(SubscriptionsAPIEnabledKey, false, "If true, this node exposes a websocket API that streams accepted blocks, vertices and transactions")
 	fs.Bool(IpcAPIEnabledKey, false, "If true, IPCs can be opened")
 
 	// Health Checks
@@ -288,6 +318,10 @@ func addNodeFlags(fs *flag.FlagSet) {
 	fs.Duration(BootstrapMaxTimeGetAncestorsKey, 50*time.Millisecond, "Max Time to spend fetching a container and its ancestors when responding to a GetAncestors")
 	fs.Uint(BootstrapAncestorsMaxContainersSentKey, 2000, "Max number of containers in an Ancestors message sent by this node")
 	fs.Uint(BootstrapAncestorsMaxContainersReceivedKey, 2000, "This node reads at most this many containers from an incoming Ancestors message")
+	fs.Uint(BootstrapPreVerificationWorkersKey, 0, "Number of workers that pre-verify upcoming blocks, by running the checks that don't depend on the chain's state, while bootstrapped blocks are executed. Only applies to VMs whose blocks support pre-verification. If 0, blocks aren't pre-verified")
+	fs.String(BootstrapCheckpointFileKey, "", "Path to a JSON file of a signed checkpoint of a snowman chain. If given, the chain is bootstrapped up to the checkpoint from the archive at --bootstrap-checkpoint-archive-file before it's bootstrapped from peers")
+	fs.String(BootstrapCheckpointArchiveFileKey, "", "Path to an archive, produced by the indexer, of the blocks of the chain of --bootstrap-checkpoint-file up to the checkpoint")
+	fs.String(BootstrapCheckpointSignersKey, "", "Comma separated list of the addresses of the keys that are trusted to sign checkpoints. Example: 6Y3kysjF9jnHnYkdS9yGAuoHyae2eNmeV")
 
 	// Consensus
 	fs.Int(SnowSampleSizeKey, 20, "Number of nodes to query for each network poll")
@@ -310,6 +344,8 @@ func addNodeFlags(fs *flag.FlagSet) {
 	// IPC
 	fs.String(IpcsChainIDsKey, "", "Comma separated list of chain ids to add to the IPC engine. Example: 11111111111111111111111111111111LpoYY,4R5p2RXDGLqaifZE4hHWH9owe34pfoBULn1DrQTWivjg8o4aH")
 	fs.String(IpcsPathKey, "", "The directory (Unix) or named pipe name prefix (Windows) for IPC sockets")
+	fs.String(IpcsSinksFileKey, "", fmt.Sprintf("Specifies a JSON file that maps chain ids to the sinks (socket, file, webhook or kafka) that their accepted containers are delivered to. Ignored if %s is specified", IpcsSinksContentKey))
+	fs.String(IpcsSinksContentKey, "", "Specifies base64 encoded map of chain ids to the sinks that their accepted containers are delivered to")
 
 	// Indexer
 	fs.Bool(IndexEnabledKey, false, "If true, index all accepted containers and transactions and expose them via an API")
@@ -360,6 +396,16 @@ func BuildFlagSet() *flag.FlagSet {
 	return fs
 }
 
+// BuildArchiveFlagSet returns the flags of the export and import subcommands,
+// which are the flags of avalanchego and the flags of the archive
+func BuildArchiveFlagSet() *flag.FlagSet {
+	fs := BuildFlagSet()
+	fs.String(ArchiveFileKey, "", "Path of the archive that is written by export or read by import. Imported blocks are also accepted by the chain's VM the next time the node runs")
+	fs.String(ArchiveChainIDKey, "", "ID of the chain to export. Ignored by import, which reads the chain from the archive")
+	fs.String(ArchiveContainerTypeKey, string(indexer.BlockType), fmt.Sprintf("Type of the indexed containers to export or import. Should be one of {%s, %s, %s}", indexer.BlockType, indexer.VertexType, indexer.TxType))
+	return fs
+}
+
 // GetExpandedArg gets the string in viper corresponding to [key] and expands
 // any variables using the OS env. If the [AvalancheGoDataDirVar] var is used,
 // we expand the value of the variable with the string in viper corresponding to
@@ -17,6 +17,8 @@ const (
 	CreateAssetTxFeeKey                                = "create-asset-tx-fee"
 	CreateSubnetTxFeeKey                               = "create-subnet-tx-fee"
 	CreateBlockchainTxFeeKey                           = "create-blockchain-tx-fee"
+	MempoolFeePriorityKey                              = "mempool-fee-priority"
+	StateHistoryRetentionKey                           = "state-history-retention"
 	UptimeRequirementKey                               = "uptime-requirement"
 	MinValidatorStakeKey                               = "min-validator-stake"
 	MaxValidatorStakeKey                               = "max-validator-stake"
@@ -34,13 +36,19 @@ const (
 	DBPathKey                                          = "db-dir"
 	DBConfigFileKey                                    = "db-config-file"
 	DBConfigContentKey                                 = "db-config-file-content"
+	DBRestoreDirKey                                    = "db-restore-dir"
 	PublicIPKey                                        = "public-ip"
 	DynamicUpdateDurationKey                           = "dynamic-update-duration"
 	DynamicPublicIPResolverKey                         = "dynamic-public-ip"
+	PublicIPv6Key                                      = "public-ipv6"
+	DynamicPublicIPv6ResolverKey                       = "dynamic-public-ipv6"
 	InboundConnUpgradeThrottlerCooldownKey             = "inbound-connection-throttling-cooldown"
 	InboundThrottlerMaxConnsPerSecKey                  = "inbound-connection-throttling-max-conns-per-sec"
 	OutboundConnectionThrottlingRps                    = "outbound-connection-throttling-rps"
 	OutboundConnectionTimeout                          = "outbound-connection-timeout"
+	ProxyAddressKey                                    = "proxy-address"
+	ProxyUsernameKey                                   = "proxy-username"
+	ProxyPasswordKey                                   = "proxy-password"
 	HTTPHostKey                                        = "http-host"
 	HTTPPortKey                                        = "http-port"
 	HTTPSEnabledKey                                    = "http-tls-enabled"
@@ -90,11 +98,19 @@ const (
 	NetworkPingFrequencyKey                            = "network-ping-frequency"
 	NetworkMaxReconnectDelayKey                        = "network-max-reconnect-delay"
 	NetworkCompressionEnabledKey                       = "network-compression-enabled"
+	NetworkProtoCodecEnabledKey                        = "network-proto-codec-enabled"
+	NetworkCompressionZstdEnabledKey                   = "network-compression-zstd-enabled"
 	NetworkMaxClockDifferenceKey                       = "network-max-clock-difference"
 	NetworkAllowPrivateIPsKey                          = "network-allow-private-ips"
 	NetworkRequireValidatorToConnectKey                = "network-require-validator-to-connect"
 	NetworkPeerReadBufferSizeKey                       = "network-peer-read-buffer-size"
 	NetworkPeerWriteBufferSizeKey                      = "network-peer-write-buffer-size"
+	NetworkPeerFilterFileKey                           = "network-peer-filter-file"
+	NetworkPeerFilterReloadFrequencyKey                = "network-peer-filter-reload-frequency"
+	NetworkCaptureFileKey                              = "network-capture-file"
+	NetworkCaptureMaxFileSizeKey                       = "network-capture-max-file-size"
+	NetworkCaptureMaxFilesKey                          = "network-capture-max-files"
+	NetworkPeerMetricsMaxPeersKey                      = "network-peer-metrics-max-peers"
 	BenchlistFailThresholdKey                          = "benchlist-fail-threshold"
 	BenchlistDurationKey                               = "benchlist-duration"
 	BenchlistMinFailingDurationKey                     = "benchlist-min-failing-duration"
@@ -126,9 +142,12 @@ const (
 	KeystoreAPIEnabledKey                              = "api-keystore-enabled"
 	MetricsAPIEnabledKey                               = "api-metrics-enabled"
 	HealthAPIEnabledKey                                = "api-health-enabled"
+	SubscriptionsAPIEnabledKey                         = "api-subscriptions-enabled"
 	IpcAPIEnabledKey                                   = "api-ipcs-enabled"
 	IpcsChainIDsKey                                    = "ipcs-chain-ids"
 	IpcsPathKey                                        = "ipcs-path"
+	IpcsSinksFileKey                                   = "ipcs-sinks-file"
+	IpcsSinksContentKey                                = "ipcs-sinks-file-content"
 	MeterVMsEnabledKey                                 = "meter-vms-enabled"
 	ConsensusGossipFrequencyKey                        = "consensus-gossip-frequency"
 	ConsensusGossipAcceptedFrontierValidatorSizeKey    = "consensus-accepted-frontier-gossip-validator-size"
@@ -141,6 +160,12 @@ const (
 	AppGossipNonValidatorSizeKey                       = "consensus-app-gossip-non-validator-size"
 	AppGossipPeerSizeKey                               = "consensus-app-gossip-peer-size"
 	ConsensusShutdownTimeoutKey                        = "consensus-shutdown-timeout"
+	HandlerConsensusLaneWeightKey                      = "handler-consensus-lane-weight"
+	HandlerBootstrappingLaneWeightKey                  = "handler-bootstrapping-lane-weight"
+	ConsensusTraceEnabledKey                           = "consensus-trace-enabled"
+	ConsensusTraceMaxBlocksKey                         = "consensus-trace-max-blocks"
+	ConsensusTraceMaxPollsPerBlockKey                  = "consensus-trace-max-polls-per-block"
+	ConsensusTraceRetentionKey                         = "consensus-trace-retention"
 	FdLimitKey                                         = "fd-limit"
 	IndexEnabledKey                                    = "index-enabled"
 	IndexAllowIncompleteKey                            = "index-allow-incomplete"
@@ -155,6 +180,10 @@ const (
 	BootstrapMaxTimeGetAncestorsKey                    = "boostrap-max-time-get-ancestors"
 	BootstrapAncestorsMaxContainersSentKey             = "bootstrap-ancestors-max-containers-sent"
 	BootstrapAncestorsMaxContainersReceivedKey         = "bootstrap-ancestors-max-containers-received"
+	BootstrapPreVerificationWorkersKey                 = "bootstrap-pre-verification-workers"
+	BootstrapCheckpointFileKey                         = "bootstrap-checkpoint-file"
+	BootstrapCheckpointArchiveFileKey                  = "bootstrap-checkpoint-archive-file"
+	BootstrapCheckpointSignersKey                      = "bootstrap-checkpoint-signers"
 	ChainConfigDirKey                                  = "chain-config-dir"
 	ChainConfigContentKey                              = "chain-config-content"
 	SubnetConfigDirKey                                 = "subnet-config-dir"
@@ -184,7 +213,14 @@ const (
 	OutboundThrottlerAtLargeAllocSizeKey               = "throttler-outbound-at-large-alloc-size"
 	OutboundThrottlerVdrAllocSizeKey                   = "throttler-outbound-validator-alloc-size"
 	OutboundThrottlerNodeMaxAtLargeBytesKey            = "throttler-outbound-node-max-at-large-bytes"
+	OutboundThrottlerBandwidthRefillRateKey            = "throttler-outbound-bandwidth-refill-rate"
+	OutboundThrottlerBandwidthMaxBurstSizeKey          = "throttler-outbound-bandwidth-max-burst-size"
+	OutboundThrottlerNodeBandwidthRefillRateKey        = "throttler-outbound-node-bandwidth-refill-rate"
+	OutboundThrottlerNodeBandwidthMaxBurstSizeKey      = "throttler-outbound-node-bandwidth-max-burst-size"
 	UptimeMetricFreqKey                                = "uptime-metric-freq"
 	VMAliasesFileKey                                   = "vm-aliases-file"
 	VMAliasesContentKey                                = "vm-aliases-file-content"
+	ArchiveFileKey                                     = "archive-file"
+	ArchiveChainIDKey                                  = "archive-chain-id"
+	ArchiveContainerTypeKey                            = "archive-container-type"
 )
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
 )
 
 var errWrongSize = errors.New("value has unexpected size")
@@ -17,6 +18,10 @@ var errWrongSize = errors.New("value has unexpected size")
 const (
 	// kvPairOverhead is an estimated overhead for a kv pair in a database.
 	kvPairOverhead = 8 // bytes
+
+	// copyBatchSize is the number of bytes that are buffered before they are
+	// written during a Copy.
+	copyBatchSize = 4 * units.MiB
 )
 
 func PutID(db KeyValueWriter, key []byte, val ids.ID) error {
@@ -176,3 +181,29 @@ func ClearPrefix(readerDB Iteratee, deleterDB KeyValueDeleter, prefix []byte) er
 	}
 	return iterator.Error()
 }
+
+// Copy writes every key-value pair in [src] into [dst]. All the pairs are read
+// from a single iterator, so [dst] receives a consistent view of [src] as long
+// as the iterators of [src] are snapshots of the database.
+func Copy(src Iteratee, dst Batcher) error {
+	iterator := src.NewIterator()
+	defer iterator.Release()
+
+	batch := dst.NewBatch()
+	for iterator.Next() {
+		if err := batch.Put(iterator.Key(), iterator.Value()); err != nil {
+			return err
+		}
+		if batch.Size() < copyBatchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
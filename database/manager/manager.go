@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/meterdb"
+	"github.com/ava-labs/avalanchego/database/pebble"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/database/rocksdb"
 	"github.com/ava-labs/avalanchego/utils"
@@ -112,6 +113,25 @@ func NewLevelDB(
 	)
 }
 
+// NewPebbleDB creates a database manager of pebble databases at [filePath] by
+// creating a database instance from each directory with a version <=
+// [currentVersion]. If [includePreviousVersions], opens previous database
+// versions and includes them in the returned Manager.
+func NewPebbleDB(
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+	currentVersion version.Version,
+) (Manager, error) {
+	return new(
+		pebble.New,
+		dbDirPath,
+		dbConfig,
+		log,
+		currentVersion,
+	)
+}
+
 // new creates a database manager at [filePath] by creating a database instance
 // from each directory with a version <= [currentVersion]. If
 // [includePreviousVersions], opens previous database versions and includes them
@@ -213,6 +233,37 @@ func NewMemDB(currentVersion version.Version) Manager {
 	}
 }
 
+// NewByName creates a database manager of the databases of type [name], as
+// given by the db-type config, at [dbDirPath]. RocksDBs and pebble databases
+// are stored in a subdirectory of [dbDirPath] named after the database type.
+func NewByName(
+	name string,
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+	currentVersion version.Version,
+) (Manager, error) {
+	switch name {
+	case rocksdb.Name:
+		return NewRocksDB(filepath.Join(dbDirPath, rocksdb.Name), dbConfig, log, currentVersion)
+	case leveldb.Name:
+		return NewLevelDB(dbDirPath, dbConfig, log, currentVersion)
+	case pebble.Name:
+		return NewPebbleDB(filepath.Join(dbDirPath, pebble.Name), dbConfig, log, currentVersion)
+	case memdb.Name:
+		return NewMemDB(currentVersion), nil
+	default:
+		return nil, fmt.Errorf(
+			"db-type was %q but should have been one of {%s, %s, %s, %s}",
+			name,
+			leveldb.Name,
+			rocksdb.Name,
+			pebble.Name,
+			memdb.Name,
+		)
+	}
+}
+
 // NewManagerFromDBs
 func NewManagerFromDBs(dbs []*VersionedDatabase) (Manager, error) {
 	if len(dbs) == 0 {
@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/meterdb"
+	"github.com/ava-labs/avalanchego/database/pebble"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/version"
@@ -55,6 +56,41 @@ func TestNewSingleLevelDB(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewSinglePebbleDB(t *testing.T) {
+	dir := t.TempDir()
+
+	v1 := version.DefaultVersion1_0_0
+
+	dbPath := filepath.Join(dir, v1.String())
+	db, err := pebble.New(dbPath, nil, logging.NoLog{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewPebbleDB(dir, nil, logging.NoLog{}, v1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	semDB := manager.Current()
+	cmp := semDB.Version.Compare(v1)
+	assert.Equal(t, 0, cmp, "incorrect version on current database")
+
+	_, exists := manager.Previous()
+	assert.False(t, exists, "there should be no previous database")
+
+	dbs := manager.GetDatabases()
+	assert.Len(t, dbs, 1)
+
+	err = manager.Close()
+	assert.NoError(t, err)
+}
+
 func TestNewCreatesSingleDB(t *testing.T) {
 	dir := t.TempDir()
 
@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/pebble"
+	"github.com/ava-labs/avalanchego/database/rocksdb"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/utils/storage"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+const (
+	// restoredSnapshotFileName is the name of the file, in a restored database
+	// directory, that holds the path of the snapshot it was restored from
+	restoredSnapshotFileName = "restored-snapshot"
+
+	// restoringDirSuffix is appended to the path of the database directory to
+	// get the directory that a snapshot is restored into before it replaces
+	// the database
+	restoringDirSuffix = ".restoring"
+)
+
+var (
+	errSnapshotExists        = errors.New("snapshot already exists")
+	errSnapshotNotFound      = errors.New("snapshot not found")
+	errUnsupportedSnapshotDB = errors.New("database type doesn't support snapshots")
+)
+
+// NewDatabaseFunc returns the function that creates a persistent database of
+// type [name]. An error is returned if [name] isn't a persistent database.
+func NewDatabaseFunc(name string) (func(string, []byte, logging.Logger) (database.Database, error), error) {
+	switch name {
+	case leveldb.Name:
+		return leveldb.New, nil
+	case rocksdb.Name:
+		return rocksdb.New, nil
+	case pebble.Name:
+		return pebble.New, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnsupportedSnapshotDB, name)
+	}
+}
+
+// Snapshot writes a consistent copy of the current database of [m] into a new
+// database created by [newDB] at [dbDirPath]/[version]. The resulting
+// directory has the same layout as a database directory, so it can be passed
+// to Restore or used directly as the database directory of a node.
+//
+// The snapshot is taken while [m] is in use, so the copy reflects the state of
+// the database at the moment the snapshot started.
+func Snapshot(
+	m Manager,
+	newDB func(string, []byte, logging.Logger) (database.Database, error),
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+) error {
+	current := m.Current()
+	snapshotPath := filepath.Join(dbDirPath, current.Version.String())
+	exists, err := storage.FolderExists(snapshotPath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("%w at %s", errSnapshotExists, snapshotPath)
+	}
+
+	log.Info("writing database snapshot to %s", snapshotPath)
+	startTime := time.Now()
+	if err := copyInto(current.Database, newDB, snapshotPath, dbConfig, log); err != nil {
+		// Don't leave a partial snapshot behind, as it would otherwise look
+		// like a valid snapshot.
+		if removeErr := os.RemoveAll(snapshotPath); removeErr != nil {
+			log.Warn("failed to remove partial snapshot at %s: %s", snapshotPath, removeErr)
+		}
+		return fmt.Errorf("couldn't write snapshot to %s: %w", snapshotPath, err)
+	}
+	log.Info("wrote database snapshot to %s in %s", snapshotPath, time.Since(startTime))
+	return nil
+}
+
+// Restore replaces the database at [dbDirPath] with the snapshot at
+// [snapshotDirPath] that was previously written by Snapshot. Only the
+// [currentVersion] of the database is restored. If a database already exists
+// at [dbDirPath], it is moved aside rather than deleted and the path it was
+// moved to is returned.
+//
+// The snapshot is copied next to [dbDirPath] before it replaces the database,
+// so a failed restore leaves the existing database in place. The restored
+// database records which snapshot it was restored from, and restoring the same
+// snapshot into it again is skipped, so the restore only happens once.
+//
+// Restore must be called before the database at [dbDirPath] is opened.
+func Restore(
+	newDB func(string, []byte, logging.Logger) (database.Database, error),
+	snapshotDirPath string,
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+	currentVersion version.Version,
+) (string, error) {
+	snapshotPath, err := filepath.Abs(filepath.Join(snapshotDirPath, currentVersion.String()))
+	if err != nil {
+		return "", err
+	}
+	exists, err := storage.FolderExists(snapshotPath)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("%w at %s", errSnapshotNotFound, snapshotPath)
+	}
+
+	markerPath := filepath.Join(dbDirPath, restoredSnapshotFileName)
+	restoredSnapshot, err := os.ReadFile(markerPath)
+	switch {
+	case err == nil:
+		if string(restoredSnapshot) == snapshotPath {
+			log.Info("database snapshot at %s was already restored, skipping the restore", snapshotPath)
+			return "", nil
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return "", fmt.Errorf("couldn't read restored snapshot marker: %w", err)
+	}
+
+	// Restore the snapshot next to the database, so that the existing database
+	// is only replaced once the snapshot was fully copied.
+	restoringPath := dbDirPath + restoringDirSuffix
+	if err := os.RemoveAll(restoringPath); err != nil {
+		return "", fmt.Errorf("couldn't remove partial restore at %s: %w", restoringPath, err)
+	}
+	if err := restoreInto(newDB, snapshotPath, restoringPath, dbConfig, log, currentVersion); err != nil {
+		if removeErr := os.RemoveAll(restoringPath); removeErr != nil {
+			log.Warn("failed to remove partial restore at %s: %s", restoringPath, removeErr)
+		}
+		return "", err
+	}
+
+	backupPath := ""
+	exists, err = storage.FolderExists(dbDirPath)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		backupPath = fmt.Sprintf("%s.pre-restore-%d", dbDirPath, time.Now().Unix())
+		log.Info("moving existing database from %s to %s", dbDirPath, backupPath)
+		if err := os.Rename(dbDirPath, backupPath); err != nil {
+			return "", fmt.Errorf("couldn't move existing database aside: %w", err)
+		}
+	}
+	if err := os.Rename(restoringPath, dbDirPath); err != nil {
+		if backupPath != "" {
+			if restoreErr := os.Rename(backupPath, dbDirPath); restoreErr != nil {
+				log.Error("failed to move the existing database back from %s: %s", backupPath, restoreErr)
+				return backupPath, fmt.Errorf("couldn't move restored database into place: %w", err)
+			}
+		}
+		return "", fmt.Errorf("couldn't move restored database into place: %w", err)
+	}
+	return backupPath, nil
+}
+
+// restoreInto copies the snapshot at [snapshotPath] into a new database
+// directory at [dbDirPath] and records that it was restored from
+// [snapshotPath].
+func restoreInto(
+	newDB func(string, []byte, logging.Logger) (database.Database, error),
+	snapshotPath string,
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+	currentVersion version.Version,
+) error {
+	src, err := newDB(snapshotPath, dbConfig, log)
+	if err != nil {
+		return fmt.Errorf("couldn't open snapshot at %s: %w", snapshotPath, err)
+	}
+
+	dstPath := filepath.Join(dbDirPath, currentVersion.String())
+	log.Info("restoring database snapshot from %s to %s", snapshotPath, dstPath)
+	errs := wrappers.Errs{}
+	errs.Add(
+		copyInto(src, newDB, dstPath, dbConfig, log),
+		src.Close(),
+	)
+	if errs.Errored() {
+		return errs.Err
+	}
+
+	markerPath := filepath.Join(dbDirPath, restoredSnapshotFileName)
+	return perms.WriteFile(markerPath, []byte(snapshotPath), perms.ReadWrite)
+}
+
+// copyInto copies [src] into a new database created by [newDB] at [path].
+func copyInto(
+	src database.Iteratee,
+	newDB func(string, []byte, logging.Logger) (database.Database, error),
+	path string,
+	dbConfig []byte,
+	log logging.Logger,
+) error {
+	dst, err := newDB(path, dbConfig, log)
+	if err != nil {
+		return err
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		database.Copy(src, dst),
+		dst.Close(),
+	)
+	return errs.Err
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manager
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/storage"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	assert := assert.New(t)
+
+	v1 := version.DefaultVersion1_0_0
+	dbDir := t.TempDir()
+	snapshotDir := filepath.Join(t.TempDir(), "snapshot")
+
+	m, err := NewLevelDB(dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+
+	db := m.Current().Database
+	assert.NoError(db.Put([]byte("key1"), []byte("value1")))
+	assert.NoError(db.Put([]byte("key2"), []byte("value2")))
+
+	err = Snapshot(m, leveldb.New, snapshotDir, nil, logging.NoLog{})
+	assert.NoError(err)
+
+	// Writes after the snapshot shouldn't be included in the snapshot
+	assert.NoError(db.Put([]byte("key3"), []byte("value3")))
+
+	// Snapshots shouldn't overwrite existing snapshots
+	err = Snapshot(m, leveldb.New, snapshotDir, nil, logging.NoLog{})
+	assert.True(errors.Is(err, errSnapshotExists))
+
+	assert.NoError(m.Close())
+
+	backupPath, err := Restore(leveldb.New, snapshotDir, dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+	assert.NotEmpty(backupPath)
+
+	restored, err := NewLevelDB(dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+
+	restoredDB := restored.Current().Database
+	value, err := restoredDB.Get([]byte("key1"))
+	assert.NoError(err)
+	assert.Equal([]byte("value1"), value)
+	value, err = restoredDB.Get([]byte("key2"))
+	assert.NoError(err)
+	assert.Equal([]byte("value2"), value)
+	has, err := restoredDB.Has([]byte("key3"))
+	assert.NoError(err)
+	assert.False(has)
+	assert.NoError(restored.Close())
+
+	// The previous database should have been moved aside
+	previous, err := NewLevelDB(backupPath, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+	has, err = previous.Current().Database.Has([]byte("key3"))
+	assert.NoError(err)
+	assert.True(has)
+	assert.NoError(previous.Close())
+
+	// Restoring the same snapshot again is skipped, so writes after the
+	// restore are kept
+	restored, err = NewLevelDB(dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+	assert.NoError(restored.Current().Database.Put([]byte("key4"), []byte("value4")))
+	assert.NoError(restored.Close())
+
+	backupPath, err = Restore(leveldb.New, snapshotDir, dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+	assert.Empty(backupPath)
+
+	restored, err = NewLevelDB(dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+	has, err = restored.Current().Database.Has([]byte("key4"))
+	assert.NoError(err)
+	assert.True(has)
+	assert.NoError(restored.Close())
+}
+
+func TestRestoreFailureKeepsDatabase(t *testing.T) {
+	assert := assert.New(t)
+
+	v1 := version.DefaultVersion1_0_0
+	dbDir := t.TempDir()
+	snapshotDir := filepath.Join(t.TempDir(), "snapshot")
+
+	m, err := NewLevelDB(dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+	assert.NoError(m.Current().Database.Put([]byte("key"), []byte("value")))
+	assert.NoError(Snapshot(m, leveldb.New, snapshotDir, nil, logging.NoLog{}))
+	assert.NoError(m.Close())
+
+	// Fail to create the restored database
+	errCreate := errors.New("failed to create database")
+	newDB := func(path string, config []byte, log logging.Logger) (database.Database, error) {
+		if strings.HasPrefix(path, dbDir) {
+			return nil, errCreate
+		}
+		return leveldb.New(path, config, log)
+	}
+	backupPath, err := Restore(newDB, snapshotDir, dbDir, nil, logging.NoLog{}, v1)
+	assert.ErrorIs(err, errCreate)
+	assert.Empty(backupPath)
+
+	// The existing database is left in place, without a partial restore
+	exists, err := storage.FolderExists(dbDir + restoringDirSuffix)
+	assert.NoError(err)
+	assert.False(exists)
+
+	m, err = NewLevelDB(dbDir, nil, logging.NoLog{}, v1)
+	assert.NoError(err)
+	value, err := m.Current().Database.Get([]byte("key"))
+	assert.NoError(err)
+	assert.Equal([]byte("value"), value)
+	assert.NoError(m.Close())
+}
+
+func TestRestoreMissingSnapshot(t *testing.T) {
+	_, err := Restore(leveldb.New, t.TempDir(), t.TempDir(), nil, logging.NoLog{}, version.DefaultVersion1_0_0)
+	assert.True(t, errors.Is(err, errSnapshotNotFound))
+}
+
+func TestNewDatabaseFuncUnsupported(t *testing.T) {
+	_, err := NewDatabaseFunc(memdb.Name)
+	assert.True(t, errors.Is(err, errUnsupportedSnapshotDB))
+}
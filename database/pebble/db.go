@@ -0,0 +1,550 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/nodb"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	// Name is the name of this database for database switches
+	Name = "pebble"
+
+	// CacheSize is the number of bytes to use for block caching in pebble.
+	CacheSize = 512 * units.MiB
+
+	// MemTableSize is the number of bytes to use for each memtable in pebble.
+	MemTableSize = 64 * units.MiB
+
+	// MaxOpenFiles is the number of file descriptors to cap pebble to use.
+	MaxOpenFiles = 1024
+
+	// MaxConcurrentCompactions is the maximum number of compactions pebble
+	// will run at the same time.
+	MaxConcurrentCompactions = 1
+
+	// MetricsProperty is the only property supported by Stat. It returns a
+	// human readable dump of the pebble metrics.
+	MetricsProperty = "pebble.metrics"
+
+	// pebbleByteOverhead is the number of bytes of constant overhead that
+	// should be added to a batch size per operation.
+	pebbleByteOverhead = 8
+)
+
+var (
+	errInvalidOperation = errors.New("invalid batch operation")
+
+	_ database.Database = &Database{}
+	_ database.Batch    = &batch{}
+	_ database.Iterator = &iter{}
+)
+
+// Database is a persistent key-value store. Apart from basic data storage
+// functionality it also supports batch writes and iterating over the keyspace
+// in binary-alphabetical order.
+type Database struct {
+	// lock is held for reading during every operation on [db] and is held for
+	// writing when [db] is closed.
+	lock         sync.RWMutex
+	db           *pebble.DB
+	closed       bool
+	writeOptions *pebble.WriteOptions
+	// openIterators is the set of iterators that have not been released yet.
+	// They are closed before [db] is closed because pebble errors when it is
+	// closed with outstanding iterators.
+	openIterators map[*iter]struct{}
+}
+
+type config struct {
+	// CacheSize is the number of bytes of the shared block cache.
+	//
+	// The default value is 512MiB.
+	CacheSize int64 `json:"cacheSize"`
+	// BytesPerSync is the number of bytes written to an sstable before it is
+	// periodically synced.
+	//
+	// The default value is 512KiB.
+	BytesPerSync int `json:"bytesPerSync"`
+	// WALBytesPerSync is the number of bytes written to the WAL before it is
+	// periodically synced. Zero disables the periodic syncing.
+	//
+	// The default value is 0.
+	WALBytesPerSync int `json:"walBytesPerSync"`
+	// MemTableStopWritesThreshold is the hard limit on the number of queued
+	// memtables before writes are stopped.
+	//
+	// The default value is 8.
+	MemTableStopWritesThreshold int `json:"memTableStopWritesThreshold"`
+	// MemTableSize is the size of a single memtable.
+	//
+	// The default value is 64MiB.
+	MemTableSize int `json:"memTableSize"`
+	// MaxOpenFiles is the soft limit on the number of open files that can be
+	// used by the database.
+	//
+	// The default value is 1024.
+	MaxOpenFiles int `json:"maxOpenFiles"`
+	// MaxConcurrentCompactions is the maximum number of concurrent
+	// compactions.
+	//
+	// The default value is 1.
+	MaxConcurrentCompactions int `json:"maxConcurrentCompactions"`
+	// Sync specifies whether writes should be synced to disk before they are
+	// acknowledged.
+	//
+	// The default is true.
+	Sync bool `json:"sync"`
+}
+
+// New returns a wrapped pebble object.
+func New(file string, configBytes []byte, log logging.Logger) (database.Database, error) {
+	parsedConfig := config{
+		CacheSize:                   CacheSize,
+		BytesPerSync:                512 * units.KiB,
+		MemTableStopWritesThreshold: 8,
+		MemTableSize:                MemTableSize,
+		MaxOpenFiles:                MaxOpenFiles,
+		MaxConcurrentCompactions:    MaxConcurrentCompactions,
+		Sync:                        true,
+	}
+	if len(configBytes) > 0 {
+		if err := json.Unmarshal(configBytes, &parsedConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse db config: %w", err)
+		}
+	}
+	configJSON, err := json.Marshal(&parsedConfig)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("pebble config: %s", string(configJSON))
+
+	cache := pebble.NewCache(parsedConfig.CacheSize)
+	// The cache is reference counted. Pebble takes its own reference when the
+	// database is opened, so we release ours once the database is open.
+	defer cache.Unref()
+
+	opts := &pebble.Options{
+		Cache:                       cache,
+		BytesPerSync:                parsedConfig.BytesPerSync,
+		WALBytesPerSync:             parsedConfig.WALBytesPerSync,
+		MemTableStopWritesThreshold: parsedConfig.MemTableStopWritesThreshold,
+		MemTableSize:                parsedConfig.MemTableSize,
+		MaxOpenFiles:                parsedConfig.MaxOpenFiles,
+		MaxConcurrentCompactions:    parsedConfig.MaxConcurrentCompactions,
+	}
+	opts.EnsureDefaults()
+
+	db, err := pebble.Open(file, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	writeOptions := pebble.NoSync
+	if parsedConfig.Sync {
+		writeOptions = pebble.Sync
+	}
+	return &Database{
+		db:            db,
+		writeOptions:  writeOptions,
+		openIterators: make(map[*iter]struct{}),
+	}, nil
+}
+
+// Has returns if the key is set in the database
+func (db *Database) Has(key []byte) (bool, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return false, database.ErrClosed
+	}
+
+	_, closer, err := db.db.Get(key)
+	switch err {
+	case nil:
+		return true, closer.Close()
+	case pebble.ErrNotFound:
+		return false, nil
+	default:
+		return false, updateError(err)
+	}
+}
+
+// Get returns the value the key maps to in the database
+func (db *Database) Get(key []byte) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	value, closer, err := db.db.Get(key)
+	if err != nil {
+		return nil, updateError(err)
+	}
+	// The returned value is only valid until [closer] is closed, so it must be
+	// copied.
+	value = utils.CopyBytes(value)
+	return value, closer.Close()
+}
+
+// Put sets the value of the provided key to the provided value
+func (db *Database) Put(key []byte, value []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	return updateError(db.db.Set(key, value, db.writeOptions))
+}
+
+// Delete removes the key from the database
+func (db *Database) Delete(key []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	return updateError(db.db.Delete(key, db.writeOptions))
+}
+
+// NewBatch creates a write/delete-only buffer that is atomically committed to
+// the database when write is called
+func (db *Database) NewBatch() database.Batch {
+	return &batch{
+		db:    db,
+		batch: db.db.NewBatch(),
+	}
+}
+
+// NewIterator creates a lexicographically ordered iterator over the database
+func (db *Database) NewIterator() database.Iterator {
+	return db.newIter(&pebble.IterOptions{})
+}
+
+// NewIteratorWithStart creates a lexicographically ordered iterator over the
+// database starting at the provided key
+func (db *Database) NewIteratorWithStart(start []byte) database.Iterator {
+	return db.newIter(&pebble.IterOptions{
+		LowerBound: start,
+	})
+}
+
+// NewIteratorWithPrefix creates a lexicographically ordered iterator over the
+// database ignoring keys that do not start with the provided prefix
+func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return db.newIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixToUpperBound(prefix),
+	})
+}
+
+// NewIteratorWithStartAndPrefix creates a lexicographically ordered iterator
+// over the database starting at start and ignoring keys that do not start with
+// the provided prefix
+func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	lowerBound := prefix
+	if bytes.Compare(start, prefix) == 1 {
+		lowerBound = start
+	}
+	return db.newIter(&pebble.IterOptions{
+		LowerBound: lowerBound,
+		UpperBound: prefixToUpperBound(prefix),
+	})
+}
+
+func (db *Database) newIter(opts *pebble.IterOptions) database.Iterator {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return &nodb.Iterator{Err: database.ErrClosed}
+	}
+
+	it := &iter{
+		db:   db,
+		iter: db.db.NewIter(opts),
+	}
+	db.openIterators[it] = struct{}{}
+	return it
+}
+
+// Stat returns a particular internal stat of the database. The only supported
+// property is [MetricsProperty].
+func (db *Database) Stat(property string) (string, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	switch {
+	case db.closed:
+		return "", database.ErrClosed
+	case property != MetricsProperty:
+		return "", database.ErrNotFound
+	default:
+		return db.db.Metrics().String(), nil
+	}
+}
+
+// Compact the underlying DB for the given key range.
+// Specifically, deleted and overwritten versions are discarded,
+// and the data is rearranged to reduce the cost of operations
+// needed to access the data. This operation should typically only
+// be invoked by users who understand the underlying implementation.
+//
+// A nil start is treated as a key before all keys in the DB.
+// And a nil limit is treated as a key after all keys in the DB.
+// Therefore if both are nil then it will compact entire DB.
+func (db *Database) Compact(start []byte, limit []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	if limit == nil {
+		// Pebble treats a nil limit as a key before all keys, and the limit is
+		// exclusive, so the key right after the last key in the database is
+		// used as the limit instead.
+		it := db.db.NewIter(&pebble.IterOptions{})
+		if !it.Last() {
+			// The database is empty, so there is nothing to compact.
+			err := it.Error()
+			if closeErr := it.Close(); err == nil {
+				err = closeErr
+			}
+			return updateError(err)
+		}
+		lastKey := it.Key()
+		limit = make([]byte, len(lastKey)+1)
+		copy(limit, lastKey)
+		if err := it.Close(); err != nil {
+			return updateError(err)
+		}
+	}
+
+	if bytes.Compare(start, limit) >= 0 {
+		// Pebble errors if the range is empty, so there is nothing to do.
+		return nil
+	}
+	return updateError(db.db.Compact(start, limit, true))
+}
+
+func (db *Database) Close() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	db.closed = true
+
+	errs := wrappers.Errs{}
+	for it := range db.openIterators {
+		errs.Add(it.iter.Close())
+		it.closed = true
+	}
+	db.openIterators = nil
+	errs.Add(db.db.Close())
+	return updateError(errs.Err)
+}
+
+// batch is a wrapper around a pebble batch to contain sizes.
+type batch struct {
+	db    *Database
+	batch *pebble.Batch
+	size  int
+
+	// written is true if [batch] has already been committed. Pebble doesn't
+	// allow a batch to be committed more than once, so a committed batch is
+	// copied into a fresh batch before it is written again.
+	written bool
+}
+
+// Put the value into the batch for later writing
+func (b *batch) Put(key, value []byte) error {
+	b.size += len(key) + len(value) + pebbleByteOverhead
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete the key during writing
+func (b *batch) Delete(key []byte) error {
+	b.size += len(key) + pebbleByteOverhead
+	return b.batch.Delete(key, nil)
+}
+
+// Size retrieves the amount of data queued up for writing.
+func (b *batch) Size() int { return b.size }
+
+// Write flushes any accumulated data to disk.
+func (b *batch) Write() error {
+	b.db.lock.RLock()
+	defer b.db.lock.RUnlock()
+
+	if b.db.closed {
+		return database.ErrClosed
+	}
+
+	if b.written {
+		newBatch := b.db.db.NewBatch()
+		if err := newBatch.Apply(b.batch, nil); err != nil {
+			return err
+		}
+		b.batch = newBatch
+	}
+
+	b.written = true
+	return updateError(b.batch.Commit(b.db.writeOptions))
+}
+
+// Reset resets the batch for reuse.
+func (b *batch) Reset() {
+	b.batch.Reset()
+	b.written = false
+	b.size = 0
+}
+
+// Replay the batch contents.
+func (b *batch) Replay(w database.KeyValueWriterDeleter) error {
+	reader := b.batch.Reader()
+	for {
+		kind, key, value, ok := reader.Next()
+		if !ok {
+			return nil
+		}
+		switch kind {
+		case pebble.InternalKeyKindSet:
+			if err := w.Put(key, value); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindDelete:
+			if err := w.Delete(key); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: %s", errInvalidOperation, kind)
+		}
+	}
+}
+
+// Inner returns itself
+func (b *batch) Inner() database.Batch { return b }
+
+type iter struct {
+	db   *Database
+	iter *pebble.Iterator
+
+	started  bool
+	closed   bool
+	key, val []byte
+	err      error
+}
+
+func (it *iter) Next() bool {
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	// Short-circuit and set an error if the underlying database or this
+	// iterator has been closed.
+	if it.db.closed || it.closed {
+		it.key = nil
+		it.val = nil
+		it.err = database.ErrClosed
+		return false
+	}
+
+	var hasNext bool
+	if it.started {
+		hasNext = it.iter.Next()
+	} else {
+		hasNext = it.iter.First()
+		it.started = true
+	}
+	if hasNext {
+		it.key = utils.CopyBytes(it.iter.Key())
+		it.val = utils.CopyBytes(it.iter.Value())
+	} else {
+		it.key = nil
+		it.val = nil
+	}
+	return hasNext
+}
+
+func (it *iter) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	if it.closed {
+		return nil
+	}
+	return updateError(it.iter.Error())
+}
+
+func (it *iter) Key() []byte { return it.key }
+
+func (it *iter) Value() []byte { return it.val }
+
+func (it *iter) Release() {
+	it.db.lock.Lock()
+	defer it.db.lock.Unlock()
+
+	if it.closed {
+		return
+	}
+
+	delete(it.db.openIterators, it)
+	it.closed = true
+	if err := it.iter.Close(); err != nil && it.err == nil {
+		it.err = updateError(err)
+	}
+}
+
+// prefixToUpperBound returns the smallest key that is larger than every key
+// with the provided prefix. If no such key exists, nil is returned.
+func prefixToUpperBound(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xFF {
+			upperBound := make([]byte, i+1)
+			copy(upperBound, prefix)
+			upperBound[i]++
+			return upperBound
+		}
+	}
+	return nil
+}
+
+func updateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, pebble.ErrClosed):
+		return database.ErrClosed
+	case errors.Is(err, pebble.ErrNotFound):
+		return database.ErrNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func TestInterface(t *testing.T) {
+	for _, test := range database.Tests {
+		folder := t.TempDir()
+		db, err := New(folder, nil, logging.NoLog{})
+		if err != nil {
+			t.Fatalf("pebble.New(%q, logging.NoLog{}) errored with %s", folder, err)
+		}
+
+		test(t, db)
+
+		// The database may have been closed by the test, so we don't care if it
+		// errors here.
+		_ = db.Close()
+	}
+}
+
+func TestPrefixToUpperBound(t *testing.T) {
+	tests := []struct {
+		prefix   []byte
+		expected []byte
+	}{
+		{prefix: nil, expected: nil},
+		{prefix: []byte{}, expected: nil},
+		{prefix: []byte{0x00}, expected: []byte{0x01}},
+		{prefix: []byte{0x01, 0xFF}, expected: []byte{0x02}},
+		{prefix: []byte{0xFF, 0xFF}, expected: nil},
+		{prefix: []byte{0x01, 0x02}, expected: []byte{0x01, 0x03}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, prefixToUpperBound(test.prefix))
+	}
+}
+
+func TestCompactNilLimitIncludesLastKey(t *testing.T) {
+	assert := assert.New(t)
+
+	db, err := New(t.TempDir(), nil, logging.NoLog{})
+	assert.NoError(err)
+	defer db.Close()
+
+	key := []byte("key")
+	assert.NoError(db.Put(key, []byte("value")))
+
+	// The range starting at the last key isn't empty, so the key is flushed
+	// out of the memtable and compacted.
+	assert.NoError(db.Compact(key, nil))
+	assert.Positive(db.(*Database).db.Metrics().Flush.Count)
+}
+
+func BenchmarkInterface(b *testing.B) {
+	for _, size := range database.BenchmarkSizes {
+		keys, values := database.SetupBenchmark(b, size[0], size[1], size[2])
+		for _, bench := range database.Benchmarks {
+			folder := b.TempDir()
+
+			db, err := New(folder, nil, logging.NoLog{})
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			bench(b, db, "pebble", keys, values)
+
+			// The database may have been closed by the test, so we don't care if it
+			// errors here.
+			_ = db.Close()
+		}
+	}
+}
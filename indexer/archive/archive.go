@@ -0,0 +1,62 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package archive implements a portable file format for the accepted
+// containers of a chain, in their order of acceptance.
+//
+// An archive is laid out as:
+//
+//	header:    magic [8]byte | version uint16 | chain ID [32]byte
+//	container: length uint32 | index uint64 | ID [32]byte | timestamp int64 | bytes
+//	...
+//	trailer:   0 uint32 | number of containers uint64 | checksum [32]byte
+//
+// Integers are big-endian. The length of a container is the number of bytes
+// that follow it in the container, so it's never 0. The checksum is the
+// SHA256 hash of everything that precedes it.
+package archive
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	// Version is the version of the archives that are written
+	Version uint16 = 0
+
+	// MaxContainerSize is the max size of the bytes of a container
+	MaxContainerSize = 16 * 1024 * 1024
+
+	magicLen  = 8
+	idLen     = 32
+	headerLen = magicLen + wrappers.ShortLen + idLen
+	// Length of the fields of a container other than its bytes
+	containerFieldsLen = wrappers.LongLen + idLen + wrappers.LongLen
+)
+
+var (
+	magic = [magicLen]byte{'a', 'v', 'a', 'x', 'a', 'r', 'c', 0}
+
+	errNotArchive         = errors.New("not an archive")
+	errUnknownVersion     = errors.New("unknown archive version")
+	errContainerTooLarge  = errors.New("container is too large")
+	errNonSequentialIndex = errors.New("container index isn't sequential")
+	errWrongCount         = errors.New("archive trailer has the wrong number of containers")
+	errChecksumMismatch   = errors.New("archive checksum mismatch")
+	errClosed             = errors.New("archive writer is closed")
+)
+
+// Container is an accepted container of a chain
+type Container struct {
+	// Index of the container in the order of acceptance of the chain
+	Index uint64
+	// ID of the container
+	ID ids.ID
+	// Unix time, in nanoseconds, at which the container was accepted
+	Timestamp int64
+	// Byte representation of the container
+	Bytes []byte
+}
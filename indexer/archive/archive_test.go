@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+func writeTestArchive(t *testing.T, chainID ids.ID, containers []Container) []byte {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, chainID)
+	assert.NoError(t, err)
+	for _, container := range containers {
+		assert.NoError(t, w.Write(container))
+	}
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func testContainers(startIndex uint64, numContainers int) []Container {
+	containers := make([]Container, numContainers)
+	for i := range containers {
+		containers[i] = Container{
+			Index:     startIndex + uint64(i),
+			ID:        ids.GenerateTestID(),
+			Timestamp: int64(i) * 1000,
+			Bytes:     []byte{byte(i), 1, 2, 3},
+		}
+	}
+	return containers
+}
+
+func TestArchive(t *testing.T) {
+	assert := assert.New(t)
+
+	chainID := ids.GenerateTestID()
+	containers := testContainers(5, 3)
+	archiveBytes := writeTestArchive(t, chainID, containers)
+
+	r, err := NewReader(bytes.NewReader(archiveBytes))
+	assert.NoError(err)
+	assert.Equal(chainID, r.ChainID())
+	for _, expected := range containers {
+		container, err := r.Next()
+		assert.NoError(err)
+		assert.Equal(expected, container)
+	}
+	_, err = r.Next()
+	assert.Equal(io.EOF, err)
+	_, err = r.Next()
+	assert.Equal(io.EOF, err)
+}
+
+func TestArchiveEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	archiveBytes := writeTestArchive(t, ids.GenerateTestID(), nil)
+
+	r, err := NewReader(bytes.NewReader(archiveBytes))
+	assert.NoError(err)
+	_, err = r.Next()
+	assert.Equal(io.EOF, err)
+}
+
+func TestArchiveCorrupted(t *testing.T) {
+	assert := assert.New(t)
+
+	archiveBytes := writeTestArchive(t, ids.GenerateTestID(), testContainers(0, 2))
+	// Flip a bit in the bytes of the last container
+	archiveBytes[len(archiveBytes)-hashing.HashLen-wrappers.IntLen-wrappers.LongLen-1] ^= 1
+
+	r, err := NewReader(bytes.NewReader(archiveBytes))
+	assert.NoError(err)
+	for i := 0; i < 2; i++ {
+		_, err := r.Next()
+		assert.NoError(err)
+	}
+	_, err = r.Next()
+	assert.ErrorIs(err, errChecksumMismatch)
+}
+
+func TestArchiveTruncated(t *testing.T) {
+	assert := assert.New(t)
+
+	archiveBytes := writeTestArchive(t, ids.GenerateTestID(), testContainers(0, 2))
+	archiveBytes = archiveBytes[:len(archiveBytes)-1]
+
+	r, err := NewReader(bytes.NewReader(archiveBytes))
+	assert.NoError(err)
+	for i := 0; i < 2; i++ {
+		_, err := r.Next()
+		assert.NoError(err)
+	}
+	_, err = r.Next()
+	assert.ErrorIs(err, io.ErrUnexpectedEOF)
+}
+
+func TestArchiveNotArchive(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("not an archive, just some bytes")))
+	assert.ErrorIs(t, err, errNotArchive)
+}
+
+func TestWriterNonSequentialIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	w, err := NewWriter(io.Discard, ids.GenerateTestID())
+	assert.NoError(err)
+	assert.NoError(w.Write(Container{Index: 1}))
+	assert.ErrorIs(w.Write(Container{Index: 3}), errNonSequentialIndex)
+}
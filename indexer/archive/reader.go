@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// Reader reads the containers of an archive, in their order of acceptance
+type Reader struct {
+	buffer *bufio.Reader
+	hash   hash.Hash
+	// Reads from [buffer] and writes what it read to [hash]
+	in io.Reader
+
+	chainID       ids.ID
+	numContainers uint64
+	nextIndex     uint64
+	done          bool
+}
+
+// NewReader reads the header of the archive in [r] and returns a Reader of
+// its containers.
+func NewReader(r io.Reader) (*Reader, error) {
+	buffer := bufio.NewReader(r)
+	hash := sha256.New()
+	ar := &Reader{
+		buffer: buffer,
+		hash:   hash,
+		in:     io.TeeReader(buffer, hash),
+	}
+
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(ar.in, header); err != nil {
+		return nil, fmt.Errorf("%w: couldn't read header: %s", errNotArchive, err)
+	}
+	if !bytes.Equal(header[:magicLen], magic[:]) {
+		return nil, errNotArchive
+	}
+	if version := binary.BigEndian.Uint16(header[magicLen:]); version != Version {
+		return nil, fmt.Errorf("%w: %d", errUnknownVersion, version)
+	}
+	copy(ar.chainID[:], header[magicLen+wrappers.ShortLen:])
+	return ar, nil
+}
+
+// ChainID returns the ID of the chain whose containers are in the archive
+func (r *Reader) ChainID() ids.ID { return r.chainID }
+
+// Next returns the next container of the archive. Once all the containers
+// were read, the checksum of the archive is verified and io.EOF is returned.
+// Because the checksum is only verified at the end of the archive, the
+// containers must not be trusted until io.EOF is returned.
+func (r *Reader) Next() (Container, error) {
+	if r.done {
+		return Container{}, io.EOF
+	}
+
+	lengthBytes := make([]byte, wrappers.IntLen)
+	if err := r.read(lengthBytes); err != nil {
+		return Container{}, err
+	}
+	length := binary.BigEndian.Uint32(lengthBytes)
+	if length == 0 {
+		return Container{}, r.readTrailer()
+	}
+	if length < containerFieldsLen || length-containerFieldsLen > MaxContainerSize {
+		return Container{}, fmt.Errorf("%w: %d bytes", errContainerTooLarge, length)
+	}
+
+	fields := make([]byte, length)
+	if err := r.read(fields); err != nil {
+		return Container{}, err
+	}
+	container := Container{
+		Index:     binary.BigEndian.Uint64(fields),
+		Timestamp: int64(binary.BigEndian.Uint64(fields[wrappers.LongLen+idLen:])),
+		Bytes:     fields[containerFieldsLen:],
+	}
+	copy(container.ID[:], fields[wrappers.LongLen:])
+
+	if r.numContainers > 0 && container.Index != r.nextIndex {
+		return Container{}, fmt.Errorf("%w: expected %d but got %d", errNonSequentialIndex, r.nextIndex, container.Index)
+	}
+	r.numContainers++
+	r.nextIndex = container.Index + 1
+	return container, nil
+}
+
+func (r *Reader) readTrailer() error {
+	countBytes := make([]byte, wrappers.LongLen)
+	if err := r.read(countBytes); err != nil {
+		return err
+	}
+	if count := binary.BigEndian.Uint64(countBytes); count != r.numContainers {
+		return fmt.Errorf("%w: read %d but trailer has %d", errWrongCount, r.numContainers, count)
+	}
+
+	// The checksum doesn't cover itself, so it's read without hashing it
+	checksum := make([]byte, hashing.HashLen)
+	if _, err := io.ReadFull(r.buffer, checksum); err != nil {
+		return unexpectedEOF(err)
+	}
+	if !bytes.Equal(checksum, r.hash.Sum(nil)) {
+		return errChecksumMismatch
+	}
+	r.done = true
+	return io.EOF
+}
+
+func (r *Reader) read(b []byte) error {
+	_, err := io.ReadFull(r.in, b)
+	return unexpectedEOF(err)
+}
+
+// unexpectedEOF returns io.ErrUnexpectedEOF if [err] is io.EOF, as the archive
+// can only end after its trailer
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archive
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// Writer writes the containers of a chain to an archive
+type Writer struct {
+	buffer *bufio.Writer
+	hash   hash.Hash
+	// Writes to both [buffer] and [hash]
+	out io.Writer
+
+	numContainers uint64
+	nextIndex     uint64
+	closed        bool
+}
+
+// NewWriter writes the header of an archive of the containers of [chainID] to
+// [w] and returns a Writer that writes the containers to [w].
+func NewWriter(w io.Writer, chainID ids.ID) (*Writer, error) {
+	buffer := bufio.NewWriter(w)
+	hash := sha256.New()
+	aw := &Writer{
+		buffer: buffer,
+		hash:   hash,
+		out:    io.MultiWriter(buffer, hash),
+	}
+
+	header := make([]byte, headerLen)
+	copy(header, magic[:])
+	binary.BigEndian.PutUint16(header[magicLen:], Version)
+	copy(header[magicLen+wrappers.ShortLen:], chainID[:])
+	if _, err := aw.out.Write(header); err != nil {
+		return nil, fmt.Errorf("couldn't write archive header: %w", err)
+	}
+	return aw, nil
+}
+
+// Write appends [container] to the archive. The indices of the containers
+// must be sequential.
+func (w *Writer) Write(container Container) error {
+	if w.closed {
+		return errClosed
+	}
+	if w.numContainers > 0 && container.Index != w.nextIndex {
+		return fmt.Errorf("%w: expected %d but got %d", errNonSequentialIndex, w.nextIndex, container.Index)
+	}
+	if len(container.Bytes) > MaxContainerSize {
+		return fmt.Errorf("%w: %s has %d bytes", errContainerTooLarge, container.ID, len(container.Bytes))
+	}
+
+	fields := make([]byte, wrappers.IntLen+containerFieldsLen)
+	binary.BigEndian.PutUint32(fields, uint32(containerFieldsLen+len(container.Bytes)))
+	binary.BigEndian.PutUint64(fields[wrappers.IntLen:], container.Index)
+	copy(fields[wrappers.IntLen+wrappers.LongLen:], container.ID[:])
+	binary.BigEndian.PutUint64(fields[wrappers.IntLen+wrappers.LongLen+idLen:], uint64(container.Timestamp))
+	if _, err := w.out.Write(fields); err != nil {
+		return fmt.Errorf("couldn't write container %s: %w", container.ID, err)
+	}
+	if _, err := w.out.Write(container.Bytes); err != nil {
+		return fmt.Errorf("couldn't write container %s: %w", container.ID, err)
+	}
+
+	w.numContainers++
+	w.nextIndex = container.Index + 1
+	return nil
+}
+
+// Close writes the trailer of the archive and flushes it to the underlying
+// writer, which isn't closed.
+func (w *Writer) Close() error {
+	if w.closed {
+		return errClosed
+	}
+	w.closed = true
+
+	trailer := make([]byte, wrappers.IntLen+wrappers.LongLen)
+	binary.BigEndian.PutUint64(trailer[wrappers.IntLen:], w.numContainers)
+	if _, err := w.out.Write(trailer); err != nil {
+		return fmt.Errorf("couldn't write archive trailer: %w", err)
+	}
+	if _, err := w.buffer.Write(w.hash.Sum(nil)); err != nil {
+		return fmt.Errorf("couldn't write archive checksum: %w", err)
+	}
+	return w.buffer.Flush()
+}
@@ -5,6 +5,15 @@ package indexer
 
 import "github.com/ava-labs/avalanchego/ids"
 
+// ContainerType is the kind of container that an index contains
+type ContainerType string
+
+const (
+	BlockType  ContainerType = "block"
+	VertexType ContainerType = "vtx"
+	TxType     ContainerType = "tx"
+)
+
 // Container is something that gets accepted
 // (a block, transaction or vertex)
 type Container struct {
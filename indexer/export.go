@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer/archive"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// Number of containers imported between commits
+const importCommitFrequency = 1024
+
+var (
+	errIndexNotEmpty      = errors.New("index isn't empty")
+	errArchiveNotComplete = errors.New("archive doesn't start at the first accepted container")
+	errDuplicateContainer = errors.New("container is in the archive more than once")
+	errWrongContainerID   = errors.New("container ID isn't the hash of its bytes")
+	errArchiveModified    = errors.New("archive was modified after it was verified")
+)
+
+// Returns the index of the containers of type [containerType] of chain
+// [chainID] in [db], which is the database of an indexer.
+// Closing the index doesn't close [db].
+func openIndex(db database.Database, log logging.Logger, chainID ids.ID, containerType ContainerType) (*index, error) {
+	prefixEnd, err := containerTypePrefix(containerType)
+	if err != nil {
+		return nil, err
+	}
+	c := codec.NewManager(codecMaxSize)
+	if err := registerCodec(c); err != nil {
+		return nil, err
+	}
+	indexDB := prefixdb.New(indexPrefix(chainID, prefixEnd), db)
+	idx, err := newIndex(indexDB, log, c, mockable.Clock{})
+	if err != nil {
+		_ = indexDB.Close()
+		return nil, err
+	}
+	return idx.(*index), nil
+}
+
+// Export writes the containers of type [containerType] of chain [chainID],
+// indexed in the indexer database [db], to [w] as an archive. Returns the
+// number of containers written.
+func Export(
+	db database.Database,
+	log logging.Logger,
+	chainID ids.ID,
+	containerType ContainerType,
+	w io.Writer,
+) (uint64, error) {
+	idx, err := openIndex(db, log, chainID, containerType)
+	if err != nil {
+		return 0, err
+	}
+	defer idx.Close()
+
+	lastAcceptedIndex, ok := idx.lastAcceptedIndex()
+	if !ok {
+		return 0, fmt.Errorf("couldn't export chain %s: %w", chainID, ErrNoneAccepted)
+	}
+	aw, err := archive.NewWriter(w, chainID)
+	if err != nil {
+		return 0, err
+	}
+
+	var numContainers uint64
+	for numContainers <= lastAcceptedIndex {
+		containers, err := idx.GetContainerRange(numContainers, MaxFetchedByRange)
+		if err != nil {
+			return numContainers, err
+		}
+		for _, container := range containers {
+			err := aw.Write(archive.Container{
+				Index:     numContainers,
+				ID:        container.ID,
+				Timestamp: container.Timestamp,
+				Bytes:     container.Bytes,
+			})
+			if err != nil {
+				return numContainers, err
+			}
+			numContainers++
+		}
+	}
+	return numContainers, aw.Close()
+}
+
+// Import indexes the containers of type [containerType] of the archive read
+// from [r] in the indexer database [db], and marks the chain of the archive as
+// indexed. The index of the chain must be empty. The containers keep the index
+// and timestamp they have in the archive. Returns the ID of the chain of the
+// archive and the number of containers imported.
+//
+// [r] is read twice. The first time, the whole archive is verified, including
+// its checksum, before anything is written to [db]. The second time, the
+// containers are indexed in batches. If the second read fails, such as
+// because the archive was modified after it was verified, the containers
+// indexed so far are removed, so that the import can be retried.
+func Import(
+	db database.Database,
+	log logging.Logger,
+	containerType ContainerType,
+	r io.ReadSeeker,
+) (ids.ID, uint64, error) {
+	chainID, numContainers, err := verifyArchive(r)
+	if err != nil {
+		return ids.ID{}, 0, fmt.Errorf("couldn't verify archive: %w", err)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return ids.ID{}, 0, err
+	}
+
+	idx, err := openIndex(db, log, chainID, containerType)
+	if err != nil {
+		return ids.ID{}, 0, err
+	}
+	defer idx.Close()
+
+	if idx.nextAcceptedIndex != 0 {
+		return ids.ID{}, 0, fmt.Errorf("%w: %d containers of chain %s are indexed", errIndexNotEmpty, idx.nextAcceptedIndex, chainID)
+	}
+
+	if err := importContainers(idx, r, chainID, numContainers); err != nil {
+		if clearErr := clearIndex(idx); clearErr != nil {
+			return ids.ID{}, 0, fmt.Errorf("couldn't remove partially imported containers: %s, after import failed with: %w", clearErr, err)
+		}
+		return ids.ID{}, 0, err
+	}
+
+	// Running the node with indexing disabled would now make the index
+	// incomplete
+	key := indexPrefix(chainID, previouslyIndexedPrefix)
+	return chainID, numContainers, db.Put(key, nil)
+}
+
+// LastAccepted returns the last accepted container of type [containerType] of
+// chain [chainID] that is indexed in the indexer database [db].
+func LastAccepted(
+	db database.Database,
+	log logging.Logger,
+	chainID ids.ID,
+	containerType ContainerType,
+) (Container, error) {
+	idx, err := openIndex(db, log, chainID, containerType)
+	if err != nil {
+		return Container{}, err
+	}
+	defer idx.Close()
+
+	return idx.GetLastAccepted()
+}
+
+// Returns the chain ID and number of containers of the archive read from [r],
+// if it's valid
+func verifyArchive(r io.Reader) (ids.ID, uint64, error) {
+	ar, err := archive.NewReader(r)
+	if err != nil {
+		return ids.ID{}, 0, err
+	}
+	numContainers := uint64(0)
+	for {
+		container, err := ar.Next()
+		if err == io.EOF {
+			return ar.ChainID(), numContainers, nil
+		}
+		if err != nil {
+			return ids.ID{}, 0, err
+		}
+		if err := verifyArchivedContainer(container, numContainers); err != nil {
+			return ids.ID{}, 0, err
+		}
+		numContainers++
+	}
+}
+
+// Returns nil iff [container] is a valid container at index [expectedIndex]
+func verifyArchivedContainer(container archive.Container, expectedIndex uint64) error {
+	if container.Index != expectedIndex {
+		return fmt.Errorf("%w: container %d has index %d", errArchiveNotComplete, expectedIndex, container.Index)
+	}
+	if containerID := ids.ID(hashing.ComputeHash256Array(container.Bytes)); containerID != container.ID {
+		return fmt.Errorf("%w: container %d has ID %s but its bytes hash to %s", errWrongContainerID, container.Index, container.ID, containerID)
+	}
+	return nil
+}
+
+// Indexes the containers of the archive read from [r] in [idx], which is
+// empty. [chainID] and [numContainers] are the chain ID and number of
+// containers that the archive had when it was verified.
+func importContainers(idx *index, r io.Reader, chainID ids.ID, numContainers uint64) error {
+	ar, err := archive.NewReader(r)
+	if err != nil {
+		return err
+	}
+	if ar.ChainID() != chainID {
+		return fmt.Errorf("%w: chain changed from %s to %s", errArchiveModified, chainID, ar.ChainID())
+	}
+
+	for {
+		container, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := verifyArchivedContainer(container, idx.nextAcceptedIndex); err != nil {
+			return err
+		}
+		if has, err := idx.containerToIndex.Has(container.ID[:]); err != nil {
+			return err
+		} else if has {
+			return fmt.Errorf("%w: %s", errDuplicateContainer, container.ID)
+		}
+
+		err = idx.put(Container{
+			ID:        container.ID,
+			Bytes:     container.Bytes,
+			Timestamp: container.Timestamp,
+		})
+		if err != nil {
+			return err
+		}
+		if idx.nextAcceptedIndex%importCommitFrequency == 0 {
+			if err := idx.vDB.Commit(); err != nil {
+				return err
+			}
+		}
+	}
+	if idx.nextAcceptedIndex != numContainers {
+		return fmt.Errorf("%w: number of containers changed from %d to %d", errArchiveModified, numContainers, idx.nextAcceptedIndex)
+	}
+	return idx.vDB.Commit()
+}
+
+// Removes all the containers of [idx]
+func clearIndex(idx *index) error {
+	idx.vDB.Abort()
+	idx.nextAcceptedIndex = 0
+
+	batch := idx.baseDB.NewBatch()
+	it := idx.baseDB.NewIterator()
+	defer it.Release()
+	for numDeleted := 1; it.Next(); numDeleted++ {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+		if numDeleted%importCommitFrequency == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
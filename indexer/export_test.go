@@ -0,0 +1,196 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer/archive"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportImport(t *testing.T) {
+	assert := assert.New(t)
+	ctx := snow.DefaultConsensusContextTest()
+	chainID := ids.GenerateTestID()
+
+	// Index more containers than fit in a page or an import batch
+	numContainers := uint64(MaxFetchedByRange + importCommitFrequency + 1)
+	srcDB := memdb.New()
+	srcIndex, err := openIndex(srcDB, logging.NoLog{}, chainID, BlockType)
+	assert.NoError(err)
+	now := time.Unix(1000, 0)
+	for i := uint64(0); i < numContainers; i++ {
+		srcIndex.clock.Set(now.Add(time.Duration(i) * time.Second))
+		containerBytes := utils.RandomBytes(32)
+		assert.NoError(srcIndex.Accept(ctx, hashing.ComputeHash256Array(containerBytes), containerBytes))
+	}
+	assert.NoError(srcIndex.Close())
+
+	buf := &bytes.Buffer{}
+	numExported, err := Export(srcDB, logging.NoLog{}, chainID, BlockType, buf)
+	assert.NoError(err)
+	assert.Equal(numContainers, numExported)
+
+	dstDB := memdb.New()
+	importedChainID, numImported, err := Import(dstDB, logging.NoLog{}, BlockType, bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Equal(chainID, importedChainID)
+	assert.Equal(numContainers, numImported)
+
+	srcIndex, err = openIndex(srcDB, logging.NoLog{}, chainID, BlockType)
+	assert.NoError(err)
+	dstIndex, err := openIndex(dstDB, logging.NoLog{}, chainID, BlockType)
+	assert.NoError(err)
+	assert.Equal(numContainers, dstIndex.nextAcceptedIndex)
+	for i := uint64(0); i < numContainers; i++ {
+		expected, err := srcIndex.GetContainerByIndex(i)
+		assert.NoError(err)
+		got, err := dstIndex.GetContainerByIndex(i)
+		assert.NoError(err)
+		assert.Equal(expected, got)
+
+		gotIndex, err := dstIndex.GetIndex(expected.ID)
+		assert.NoError(err)
+		assert.Equal(i, gotIndex)
+	}
+
+	lastAccepted, err := LastAccepted(dstDB, logging.NoLog{}, chainID, BlockType)
+	assert.NoError(err)
+	expectedLastAccepted, err := srcIndex.GetLastAccepted()
+	assert.NoError(err)
+	assert.Equal(expectedLastAccepted, lastAccepted)
+
+	previouslyIndexed, err := (&indexer{db: dstDB}).previouslyIndexed(chainID)
+	assert.NoError(err)
+	assert.True(previouslyIndexed)
+
+	// Importing into a non-empty index fails
+	_, _, err = Import(dstDB, logging.NoLog{}, BlockType, bytes.NewReader(buf.Bytes()))
+	assert.ErrorIs(err, errIndexNotEmpty)
+}
+
+func TestExportEmpty(t *testing.T) {
+	_, err := Export(memdb.New(), logging.NoLog{}, ids.GenerateTestID(), TxType, &bytes.Buffer{})
+	assert.ErrorIs(t, err, ErrNoneAccepted)
+}
+
+// Returns an archive of containers whose IDs are the hashes of their bytes
+func testArchive(t *testing.T, chainID ids.ID, numContainers int) []byte {
+	buf := &bytes.Buffer{}
+	w, err := archive.NewWriter(buf, chainID)
+	assert.NoError(t, err)
+	for i := 0; i < numContainers; i++ {
+		containerBytes := utils.RandomBytes(32)
+		assert.NoError(t, w.Write(archive.Container{
+			Index: uint64(i),
+			ID:    hashing.ComputeHash256Array(containerBytes),
+			Bytes: containerBytes,
+		}))
+	}
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestImportIncompleteArchive(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	w, err := archive.NewWriter(buf, ids.GenerateTestID())
+	assert.NoError(err)
+	containerBytes := []byte{1}
+	assert.NoError(w.Write(archive.Container{Index: 1, ID: hashing.ComputeHash256Array(containerBytes), Bytes: containerBytes}))
+	assert.NoError(w.Close())
+
+	_, _, err = Import(memdb.New(), logging.NoLog{}, VertexType, bytes.NewReader(buf.Bytes()))
+	assert.ErrorIs(err, errArchiveNotComplete)
+}
+
+func TestImportWrongContainerID(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	w, err := archive.NewWriter(buf, ids.GenerateTestID())
+	assert.NoError(err)
+	assert.NoError(w.Write(archive.Container{Index: 0, ID: ids.GenerateTestID(), Bytes: []byte{1}}))
+	assert.NoError(w.Close())
+
+	_, _, err = Import(memdb.New(), logging.NoLog{}, TxType, bytes.NewReader(buf.Bytes()))
+	assert.ErrorIs(err, errWrongContainerID)
+}
+
+func TestImportCorruptedArchive(t *testing.T) {
+	assert := assert.New(t)
+
+	chainID := ids.GenerateTestID()
+	numContainers := 2*importCommitFrequency + 1
+	archiveBytes := testArchive(t, chainID, numContainers)
+	corruptedBytes := make([]byte, len(archiveBytes))
+	copy(corruptedBytes, archiveBytes)
+	// Corrupt the checksum, which is only read after all the containers
+	corruptedBytes[len(corruptedBytes)-1] ^= 1
+
+	db := memdb.New()
+	_, _, err := Import(db, logging.NoLog{}, BlockType, bytes.NewReader(corruptedBytes))
+	assert.Error(err)
+
+	// Nothing was written, so the import can be retried
+	isEmpty, err := database.IsEmpty(db)
+	assert.NoError(err)
+	assert.True(isEmpty)
+	_, numImported, err := Import(db, logging.NoLog{}, BlockType, bytes.NewReader(archiveBytes))
+	assert.NoError(err)
+	assert.EqualValues(numContainers, numImported)
+}
+
+// modifyingReader reads [modified] once it was seeked, which simulates an
+// archive that is modified after it was verified
+type modifyingReader struct {
+	*bytes.Reader
+	modified []byte
+}
+
+func (r *modifyingReader) Seek(offset int64, whence int) (int64, error) {
+	if r.modified != nil {
+		r.Reader = bytes.NewReader(r.modified)
+		r.modified = nil
+	}
+	return r.Reader.Seek(offset, whence)
+}
+
+func TestImportArchiveModifiedAfterVerification(t *testing.T) {
+	assert := assert.New(t)
+
+	chainID := ids.GenerateTestID()
+	numContainers := 2*importCommitFrequency + 1
+	archiveBytes := testArchive(t, chainID, numContainers)
+	modifiedBytes := make([]byte, len(archiveBytes))
+	copy(modifiedBytes, archiveBytes)
+	modifiedBytes[len(modifiedBytes)-1] ^= 1
+
+	db := memdb.New()
+	_, _, err := Import(db, logging.NoLog{}, BlockType, &modifyingReader{
+		Reader:   bytes.NewReader(archiveBytes),
+		modified: modifiedBytes,
+	})
+	assert.Error(err)
+
+	// The containers that were committed before the modification was noticed
+	// were removed, so the import can be retried
+	isEmpty, err := database.IsEmpty(db)
+	assert.NoError(err)
+	assert.True(isEmpty)
+	_, numImported, err := Import(db, logging.NoLog{}, BlockType, bytes.NewReader(archiveBytes))
+	assert.NoError(err)
+	assert.EqualValues(numContainers, numImported)
+}
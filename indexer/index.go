@@ -32,7 +32,7 @@ var (
 	nextAcceptedIndexKey   = []byte{0x00}
 	indexToContainerPrefix = []byte{0x01}
 	containerToIDPrefix    = []byte{0x02}
-	errNoneAccepted        = errors.New("no containers have been accepted")
+	ErrNoneAccepted        = errors.New("no containers have been accepted")
 	errNumToFetchZero      = fmt.Errorf("numToFetch must be in [1,%d]", MaxFetchedByRange)
 
 	_ Index = &index{}
@@ -140,13 +140,27 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 	}
 
 	ctx.Log.Debug("indexing %d --> container %s", i.nextAcceptedIndex, containerID)
-	// Persist index --> Container
-	nextAcceptedIndexBytes := database.PackUInt64(i.nextAcceptedIndex)
-	bytes, err := i.codec.Marshal(codecVersion, Container{
+	err = i.put(Container{
 		ID:        containerID,
 		Bytes:     containerBytes,
 		Timestamp: i.clock.Time().UnixNano(),
 	})
+	if err != nil {
+		return err
+	}
+
+	// Atomically commit [i.vDB], [i.indexToContainer], [i.containerToIndex] to [i.baseDB]
+	return i.vDB.Commit()
+}
+
+// put indexes [container] at the next accepted index, without committing.
+// Assumes [i.lock] is held.
+func (i *index) put(container Container) error {
+	containerID := container.ID
+
+	// Persist index --> Container
+	nextAcceptedIndexBytes := database.PackUInt64(i.nextAcceptedIndex)
+	bytes, err := i.codec.Marshal(codecVersion, container)
 	if err != nil {
 		return fmt.Errorf("couldn't serialize container %s: %w", containerID, err)
 	}
@@ -164,9 +178,7 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 	if err := database.PutUInt64(i.vDB, nextAcceptedIndexKey, i.nextAcceptedIndex); err != nil {
 		return fmt.Errorf("couldn't put accepted container %s into index: %w", containerID, err)
 	}
-
-	// Atomically commit [i.vDB], [i.indexToContainer], [i.containerToIndex] to [i.baseDB]
-	return i.vDB.Commit()
+	return nil
 }
 
 // Returns the ID of the [index]th accepted container and the container itself.
@@ -222,7 +234,7 @@ func (i *index) GetContainerRange(startIndex, numToFetch uint64) ([]Container, e
 
 	lastAcceptedIndex, ok := i.lastAcceptedIndex()
 	if !ok {
-		return nil, errNoneAccepted
+		return nil, ErrNoneAccepted
 	} else if startIndex > lastAcceptedIndex {
 		return nil, fmt.Errorf("start index (%d) > last accepted index (%d)", startIndex, lastAcceptedIndex)
 	}
@@ -273,7 +285,7 @@ func (i *index) GetLastAccepted() (Container, error) {
 
 	lastAcceptedIndex, exists := i.lastAcceptedIndex()
 	if !exists {
-		return Container{}, errNoneAccepted
+		return Container{}, ErrNoneAccepted
 	}
 	return i.getContainerByIndex(lastAcceptedIndex)
 }
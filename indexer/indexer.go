@@ -4,6 +4,7 @@
 package indexer
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -43,6 +44,8 @@ const (
 )
 
 var (
+	errUnknownContainerType = errors.New("unknown container type")
+
 	txPrefix                = byte(0x01)
 	vtxPrefix               = byte(0x02)
 	blockPrefix             = byte(0x03)
@@ -71,6 +74,10 @@ type Config struct {
 // Indexer is threadsafe.
 type Indexer interface {
 	chains.Registrant
+	// GetIndex returns the index of the containers of type [containerType]
+	// that were accepted on chain [chainID]. Returns false if those containers
+	// aren't being indexed.
+	GetIndex(chainID ids.ID, containerType ContainerType) (Index, bool)
 	// Close will do nothing and return nil after the first call
 	io.Closer
 }
@@ -92,11 +99,8 @@ func NewIndexer(config Config) (Indexer, error) {
 		shutdownF:              config.ShutdownF,
 	}
 
-	if err := indexer.codec.RegisterCodec(
-		codecVersion,
-		linearcodec.NewCustomMaxLength(math.MaxUint32),
-	); err != nil {
-		return nil, fmt.Errorf("couldn't register codec: %w", err)
+	if err := registerCodec(indexer.codec); err != nil {
+		return nil, err
 	}
 	hasRun, err := indexer.hasRun()
 	if err != nil {
@@ -225,7 +229,7 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 
 	switch engine.(type) {
 	case snowman.Engine:
-		index, err := i.registerChainHelper(chainID, blockPrefix, name, "block", i.consensusAcceptorGroup)
+		index, err := i.registerChainHelper(chainID, blockPrefix, name, BlockType, i.consensusAcceptorGroup)
 		if err != nil {
 			i.log.Fatal("couldn't create block index for %s: %s", name, err)
 			if err := i.close(); err != nil {
@@ -235,7 +239,7 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 		}
 		i.blockIndices[chainID] = index
 	case avalanche.Engine:
-		vtxIndex, err := i.registerChainHelper(chainID, vtxPrefix, name, "vtx", i.consensusAcceptorGroup)
+		vtxIndex, err := i.registerChainHelper(chainID, vtxPrefix, name, VertexType, i.consensusAcceptorGroup)
 		if err != nil {
 			i.log.Fatal("couldn't create vertex index for %s: %s", name, err)
 			if err := i.close(); err != nil {
@@ -245,7 +249,7 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 		}
 		i.vtxIndices[chainID] = vtxIndex
 
-		txIndex, err := i.registerChainHelper(chainID, txPrefix, name, "tx", i.decisionAcceptorGroup)
+		txIndex, err := i.registerChainHelper(chainID, txPrefix, name, TxType, i.decisionAcceptorGroup)
 		if err != nil {
 			i.log.Fatal("couldn't create tx index for %s: %s", name, err)
 			if err := i.close(); err != nil {
@@ -266,13 +270,11 @@ func (i *indexer) RegisterChain(name string, engine common.Engine) {
 func (i *indexer) registerChainHelper(
 	chainID ids.ID,
 	prefixEnd byte,
-	name, endpoint string,
+	name string,
+	containerType ContainerType,
 	acceptorGroup snow.AcceptorGroup,
 ) (Index, error) {
-	prefix := make([]byte, hashing.HashLen+wrappers.ByteLen)
-	copy(prefix, chainID[:])
-	prefix[hashing.HashLen] = prefixEnd
-	indexDB := prefixdb.New(prefix, i.db)
+	indexDB := prefixdb.New(indexPrefix(chainID, prefixEnd), i.db)
 	index, err := newIndex(indexDB, i.log, i.codec, i.clock)
 	if err != nil {
 		_ = indexDB.Close()
@@ -295,13 +297,33 @@ func (i *indexer) registerChainHelper(
 		return nil, err
 	}
 	handler := &common.HTTPHandler{LockOptions: common.NoLock, Handler: apiServer}
-	if err := i.pathAdder.AddRoute(handler, &sync.RWMutex{}, "index/"+name, "/"+endpoint); err != nil {
+	if err := i.pathAdder.AddRoute(handler, &sync.RWMutex{}, "index/"+name, "/"+string(containerType)); err != nil {
 		_ = index.Close()
 		return nil, err
 	}
 	return index, nil
 }
 
+func (i *indexer) GetIndex(chainID ids.ID, containerType ContainerType) (Index, bool) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	if i.closed {
+		return nil, false
+	}
+
+	var index Index
+	switch containerType {
+	case BlockType:
+		index = i.blockIndices[chainID]
+	case VertexType:
+		index = i.vtxIndices[chainID]
+	case TxType:
+		index = i.txIndices[chainID]
+	}
+	return index, index != nil
+}
+
 // Close this indexer. Stops indexing all chains.
 // Closes [i.db]. Assumes Close is only called after
 // the node is done making decisions.
@@ -374,6 +396,41 @@ func (i *indexer) previouslyIndexed(chainID ids.ID) (bool, error) {
 	return i.db.Has(key)
 }
 
+// Returns the prefix of the database of the index of chain [chainID] whose
+// type is identified by [prefixEnd]
+func indexPrefix(chainID ids.ID, prefixEnd byte) []byte {
+	prefix := make([]byte, hashing.HashLen+wrappers.ByteLen)
+	copy(prefix, chainID[:])
+	prefix[hashing.HashLen] = prefixEnd
+	return prefix
+}
+
+// Returns the prefix that identifies the indices of containers of type
+// [containerType]
+func containerTypePrefix(containerType ContainerType) (byte, error) {
+	switch containerType {
+	case BlockType:
+		return blockPrefix, nil
+	case VertexType:
+		return vtxPrefix, nil
+	case TxType:
+		return txPrefix, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", errUnknownContainerType, containerType)
+	}
+}
+
+// Registers the codec used to serialize indexed containers in [c]
+func registerCodec(c codec.Manager) error {
+	if err := c.RegisterCodec(
+		codecVersion,
+		linearcodec.NewCustomMaxLength(math.MaxUint32),
+	); err != nil {
+		return fmt.Errorf("couldn't register codec: %w", err)
+	}
+	return nil
+}
+
 // Mark that the node has run at least once
 func (i *indexer) markHasRun() error {
 	return i.db.Put(hasRunKey, nil)
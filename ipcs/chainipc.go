@@ -31,21 +31,30 @@ type context struct {
 // ChainIPCs maintains IPCs for a set of chains
 type ChainIPCs struct {
 	context
-	chains                 map[ids.ID]*EventSockets
+	chains                 map[ids.ID]*EventSinks
 	consensusAcceptorGroup snow.AcceptorGroup
 	decisionAcceptorGroup  snow.AcceptorGroup
 }
 
 // NewChainIPCs creates a new *ChainIPCs that writes consensus and decision
-// events to IPC sockets
-func NewChainIPCs(log logging.Logger, path string, networkID uint32, consensusAcceptorGroup, decisionAcceptorGroup snow.AcceptorGroup, defaultChainIDs []ids.ID) (*ChainIPCs, error) {
+// events to IPC sockets for [defaultChainIDs] and to the sinks described by
+// [sinks] for the chains it contains
+func NewChainIPCs(
+	log logging.Logger,
+	path string,
+	networkID uint32,
+	consensusAcceptorGroup,
+	decisionAcceptorGroup snow.AcceptorGroup,
+	defaultChainIDs []ids.ID,
+	sinks map[ids.ID][]SinkConfig,
+) (*ChainIPCs, error) {
 	cipcs := &ChainIPCs{
 		context: context{
 			log:       log,
 			networkID: networkID,
 			path:      path,
 		},
-		chains:                 make(map[ids.ID]*EventSockets),
+		chains:                 make(map[ids.ID]*EventSinks),
 		consensusAcceptorGroup: consensusAcceptorGroup,
 		decisionAcceptorGroup:  decisionAcceptorGroup,
 	}
@@ -54,24 +63,47 @@ func NewChainIPCs(log logging.Logger, path string, networkID uint32, consensusAc
 			return nil, err
 		}
 	}
+	for chainID, configs := range sinks {
+		if _, err := cipcs.PublishToSinks(chainID, configs); err != nil {
+			return nil, err
+		}
+	}
 	return cipcs, nil
 }
 
-// Publish creates a set of eventSockets for the given chainID
-func (cipcs *ChainIPCs) Publish(chainID ids.ID) (*EventSockets, error) {
-	if es, ok := cipcs.chains[chainID]; ok {
+// Publish creates a set of IPC sockets for the given chainID
+func (cipcs *ChainIPCs) Publish(chainID ids.ID) (*EventSinks, error) {
+	if es, ok := cipcs.chains[chainID]; ok && es.hasSocket() {
 		cipcs.log.Info("returning existing blockchainID %s", chainID.String())
 		return es, nil
 	}
 
-	es, err := newEventSockets(cipcs.context, chainID, cipcs.consensusAcceptorGroup, cipcs.decisionAcceptorGroup)
-	if err != nil {
-		cipcs.log.Error("can't create ipcs: %s", err)
-		return nil, err
+	return cipcs.PublishToSinks(chainID, []SinkConfig{{Type: SocketSinkType}})
+}
+
+// PublishToSinks starts delivering the events of the given chainID to the
+// sinks described by [configs], in addition to the sinks that the chain is
+// already published to
+func (cipcs *ChainIPCs) PublishToSinks(chainID ids.ID, configs []SinkConfig) (*EventSinks, error) {
+	es, exists := cipcs.chains[chainID]
+	if !exists {
+		es = newEventSinks(chainID, cipcs.consensusAcceptorGroup, cipcs.decisionAcceptorGroup)
+	}
+
+	for _, config := range configs {
+		if err := es.add(cipcs.context, config); err != nil {
+			cipcs.log.Error("can't create ipcs: %s", err)
+			if !exists {
+				if stopErr := es.stop(); stopErr != nil {
+					cipcs.log.Warn("failed to stop ipcs: %s", stopErr)
+				}
+			}
+			return nil, err
+		}
 	}
 
 	cipcs.chains[chainID] = es
-	cipcs.log.Info("created IPC sockets for blockchain %s at %s and %s", chainID.String(), es.ConsensusURL(), es.DecisionsURL())
+	cipcs.log.Info("publishing blockchain %s to %v", chainID, es.URLs())
 	return es, nil
 }
 
@@ -108,5 +140,9 @@ func (cipcs *ChainIPCs) Shutdown() error {
 }
 
 func ipcURL(ctx context, chainID ids.ID, eventType string) string {
-	return filepath.Join(ctx.path, fmt.Sprintf("%d-%s-%s", ctx.networkID, chainID.String(), eventType))
+	return filepath.Join(ctx.path, ipcName(ctx, chainID, eventType))
+}
+
+func ipcName(ctx context, chainID ids.ID, eventType string) string {
+	return fmt.Sprintf("%d-%s-%s", ctx.networkID, chainID.String(), eventType)
 }
@@ -0,0 +1,170 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ipcs
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var _ snow.Acceptor = &EventSinks{}
+
+// EventSinks is the set of sinks that the events of a chain are delivered to
+type EventSinks struct {
+	chainID                ids.ID
+	consensusAcceptorGroup snow.AcceptorGroup
+	decisionAcceptorGroup  snow.AcceptorGroup
+
+	consensusSinks []*eventSink
+	decisionsSinks []*eventSink
+}
+
+func newEventSinks(chainID ids.ID, consensusAcceptorGroup, decisionAcceptorGroup snow.AcceptorGroup) *EventSinks {
+	return &EventSinks{
+		chainID:                chainID,
+		consensusAcceptorGroup: consensusAcceptorGroup,
+		decisionAcceptorGroup:  decisionAcceptorGroup,
+	}
+}
+
+// add creates the consensus and decisions sinks described by [config]
+func (es *EventSinks) add(ctx context, config SinkConfig) error {
+	if config.Type == "" {
+		config.Type = SocketSinkType
+	}
+	// The socket sinks keep the acceptor names they had before other sink
+	// types were supported.
+	suffix := ""
+	if config.Type != SocketSinkType {
+		suffix = fmt.Sprintf("-%s-%d", config.Type, len(es.consensusSinks))
+	}
+
+	consensusSink, err := newEventSink(ctx, es.chainID, ipcConsensusIdentifier, suffix, config, es.consensusAcceptorGroup)
+	if err != nil {
+		return err
+	}
+
+	decisionsSink, err := newEventSink(ctx, es.chainID, ipcDecisionsIdentifier, suffix, config, es.decisionAcceptorGroup)
+	if err != nil {
+		if stopErr := consensusSink.stop(); stopErr != nil {
+			ctx.log.Warn("failed to stop consensus sink: %s", stopErr)
+		}
+		return err
+	}
+
+	es.consensusSinks = append(es.consensusSinks, consensusSink)
+	es.decisionsSinks = append(es.decisionsSinks, decisionsSink)
+	return nil
+}
+
+// Accept delivers a message to the underlying eventSinks
+func (es *EventSinks) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container []byte) error {
+	for _, sinks := range [][]*eventSink{es.consensusSinks, es.decisionsSinks} {
+		for _, sink := range sinks {
+			if err := sink.Accept(ctx, containerID, container); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// stop closes the underlying eventSinks
+func (es *EventSinks) stop() error {
+	errs := wrappers.Errs{}
+	for _, sinks := range [][]*eventSink{es.consensusSinks, es.decisionsSinks} {
+		for _, sink := range sinks {
+			errs.Add(sink.stop())
+		}
+	}
+	return errs.Err
+}
+
+// hasSocket returns true if the events are written to IPC sockets
+func (es *EventSinks) hasSocket() bool {
+	return socketURL(es.consensusSinks) != ""
+}
+
+// ConsensusURL returns the URL of socket receiving consensus events
+func (es *EventSinks) ConsensusURL() string {
+	return socketURL(es.consensusSinks)
+}
+
+// DecisionsURL returns the URL of socket receiving decisions events
+func (es *EventSinks) DecisionsURL() string {
+	return socketURL(es.decisionsSinks)
+}
+
+// URLs returns the URLs of all the sinks
+func (es *EventSinks) URLs() []string {
+	urls := make([]string, 0, len(es.consensusSinks)+len(es.decisionsSinks))
+	for _, sinks := range [][]*eventSink{es.consensusSinks, es.decisionsSinks} {
+		for _, sink := range sinks {
+			urls = append(urls, sink.sink.URL())
+		}
+	}
+	return urls
+}
+
+func socketURL(sinks []*eventSink) string {
+	for _, sink := range sinks {
+		if sink.sinkType == SocketSinkType {
+			return sink.sink.URL()
+		}
+	}
+	return ""
+}
+
+// eventSink delivers a single type of events of a single chain to a sink
+type eventSink struct {
+	log          logging.Logger
+	sinkType     string
+	sink         Sink
+	unregisterFn func() error
+}
+
+// newEventSink creates an *eventSink for the given chain that delivers the
+// containers accepted by [acceptorGroup] to the sink described by [config]
+func newEventSink(ctx context, chainID ids.ID, name, suffix string, config SinkConfig, acceptorGroup snow.AcceptorGroup) (*eventSink, error) {
+	sink, err := newSink(ctx, chainID, name, config)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptorName := ipcIdentifierPrefix + "-" + name + suffix
+	es := &eventSink{
+		log:      ctx.log,
+		sinkType: config.Type,
+		sink:     sink,
+		unregisterFn: func() error {
+			return acceptorGroup.DeregisterAcceptor(chainID, acceptorName)
+		},
+	}
+
+	if err := acceptorGroup.RegisterAcceptor(chainID, acceptorName, es, false); err != nil {
+		if err := sink.Close(); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+
+	return es, nil
+}
+
+// Accept delivers a message to the sink
+func (es *eventSink) Accept(_ *snow.ConsensusContext, containerID ids.ID, container []byte) error {
+	return es.sink.Send(containerID, container)
+}
+
+// stop unregisters the event handler and closes the sink
+func (es *eventSink) stop() error {
+	es.log.Info("closing chain IPC %s", es.sink.URL())
+	errs := wrappers.Errs{}
+	errs.Add(es.unregisterFn(), es.sink.Close())
+	return errs.Err
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ipcs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/filesystem"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const fileSinkPerms = 0o644
+
+var (
+	errFileSinkClosed = errors.New("file sink closed")
+
+	_ Sink = &fileSink{}
+)
+
+// fileSink appends containers to a file. Each container is prefixed with its
+// length as an 8 byte big endian integer, which is the same framing that is
+// used by the IPC sockets.
+//
+// Once the file would grow larger than [maxFileSize], it's renamed to
+// [path].1, the previously rotated files are renamed to [path].2 and so on,
+// and only the [maxFiles] most recent rotated files are kept.
+type fileSink struct {
+	path        string
+	maxFileSize uint64
+	maxFiles    int
+
+	lock sync.Mutex
+	// nil once the sink is closed
+	file *os.File
+	size uint64
+}
+
+func newFileSink(path string, maxFileSize uint64, maxFiles int) (*fileSink, error) {
+	s := &fileSink{
+		path:        path,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+	return s, s.open()
+}
+
+func (s *fileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, fileSinkPerms)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	s.file = file
+	s.size = uint64(info.Size())
+	return nil
+}
+
+func (s *fileSink) Send(_ ids.ID, container []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return errFileSinkClosed
+	}
+
+	recordSize := uint64(wrappers.LongLen + len(container))
+	if s.maxFileSize > 0 && s.size > 0 && s.size+recordSize > s.maxFileSize {
+		if err := s.rotate(); err != nil {
+			return fmt.Errorf("couldn't rotate %s: %w", s.path, err)
+		}
+	}
+
+	record := make([]byte, recordSize)
+	binary.BigEndian.PutUint64(record, uint64(len(container)))
+	copy(record[wrappers.LongLen:], container)
+	n, err := s.file.Write(record)
+	s.size += uint64(n)
+	return err
+}
+
+// rotate assumes [s.lock] is held
+func (s *fileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	s.file = nil
+
+	if err := filesystem.Rotate(s.path, s.maxFiles); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+func (s *fileSink) URL() string { return s.path }
+
+func (s *fileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
@@ -0,0 +1,314 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package kafka
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	// DefaultTimeout is used if the config doesn't specify a timeout
+	DefaultTimeout = 10 * time.Second
+
+	// RequireLeaderAck waits for the partition leader to write the records
+	RequireLeaderAck int16 = 1
+	// RequireAllAcks waits for all in-sync replicas to write the records
+	RequireAllAcks int16 = -1
+)
+
+var (
+	errNoBrokers           = errors.New("no brokers specified")
+	errNoTopic             = errors.New("no topic specified")
+	errUnsupportedAcks     = errors.New("unsupported required acks")
+	errNoRecords           = errors.New("no records to produce")
+	errUnexpectedResponse  = errors.New("unexpected response")
+	errPartitionNotFound   = errors.New("partition not found")
+	errLeaderNotAvailable  = errors.New("partition leader not available")
+	errUnknownLeaderBroker = errors.New("partition leader isn't a known broker")
+)
+
+// Config describes the topic partition that records are produced to
+type Config struct {
+	// Addresses of the brokers used to find the leader of the partition
+	Brokers []string
+	Topic   string
+	// Partition that all the records are produced to. Producing to a single
+	// partition keeps the records in the order they were produced.
+	Partition int32
+	// Identifies the producer in the broker logs
+	ClientID string
+	// RequireLeaderAck or RequireAllAcks. Defaults to RequireLeaderAck.
+	RequiredAcks int16
+	// Timeout of connecting to a broker and of each request. Defaults to
+	// DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Producer writes records to a topic partition using the Kafka protocol. It
+// only relies on request versions that are supported by Kafka and by the
+// brokers that implement its protocol.
+//
+// Producer isn't safe for concurrent use.
+type Producer struct {
+	config        Config
+	correlationID uint32
+	// Connection to the partition leader. nil if not connected.
+	conn net.Conn
+}
+
+// NewProducer returns a producer that connects to the partition leader once
+// records are produced.
+func NewProducer(config Config) (*Producer, error) {
+	switch {
+	case len(config.Brokers) == 0:
+		return nil, errNoBrokers
+	case config.Topic == "":
+		return nil, errNoTopic
+	}
+	switch config.RequiredAcks {
+	case 0:
+		config.RequiredAcks = RequireLeaderAck
+	case RequireLeaderAck, RequireAllAcks:
+	default:
+		return nil, fmt.Errorf("%w: %d", errUnsupportedAcks, config.RequiredAcks)
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+	return &Producer{config: config}, nil
+}
+
+// Produce writes [records] to the partition. If the records can't be written,
+// the connection to the broker is dropped and re-established by the next call.
+func (p *Producer) Produce(records []Record) error {
+	if len(records) == 0 {
+		return errNoRecords
+	}
+	batch, err := recordBatch(records)
+	if err != nil {
+		return err
+	}
+
+	if p.conn == nil {
+		conn, err := p.connectToLeader()
+		if err != nil {
+			return err
+		}
+		p.conn = conn
+	}
+	if err := p.produce(batch); err != nil {
+		_ = p.Close()
+		return err
+	}
+	return nil
+}
+
+// Close the connection to the broker, if any
+func (p *Producer) Close() error {
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn = nil
+	return err
+}
+
+// connectToLeader asks the brokers for the leader of the partition and
+// connects to it.
+func (p *Producer) connectToLeader() (net.Conn, error) {
+	var lastErr error
+	for _, broker := range p.config.Brokers {
+		conn, err := net.DialTimeout("tcp", broker, p.config.Timeout)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		leader, err := p.getLeader(conn)
+		_ = conn.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		conn, err = net.DialTimeout("tcp", leader, p.config.Timeout)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return conn, nil
+	}
+	return nil, fmt.Errorf("couldn't connect to the leader of %s/%d: %w", p.config.Topic, p.config.Partition, lastErr)
+}
+
+// getLeader returns the address of the partition leader according to the
+// broker connected to by [conn].
+func (p *Producer) getLeader(conn net.Conn) (string, error) {
+	body := newPacker()
+	body.PackInt(1) // number of topics
+	body.PackStr(p.config.Topic)
+
+	resp, err := p.roundTrip(conn, metadataAPIKey, metadataAPIVersion, body)
+	if err != nil {
+		return "", err
+	}
+
+	numBrokers := resp.UnpackInt()
+	brokers := make(map[uint32]string)
+	for i := uint32(0); i < numBrokers && !resp.Errored(); i++ {
+		nodeID := resp.UnpackInt()
+		host := resp.UnpackStr()
+		port := resp.UnpackInt()
+		unpackNullableString(resp) // rack
+		brokers[nodeID] = net.JoinHostPort(host, strconv.Itoa(int(int32(port))))
+	}
+	_ = resp.UnpackInt() // controller ID
+
+	numTopics := resp.UnpackInt()
+	for i := uint32(0); i < numTopics && !resp.Errored(); i++ {
+		topicErr := resp.UnpackShort()
+		topic := resp.UnpackStr()
+		_ = resp.UnpackBool() // is internal
+		numPartitions := resp.UnpackInt()
+		for j := uint32(0); j < numPartitions && !resp.Errored(); j++ {
+			partitionErr := resp.UnpackShort()
+			partition := int32(resp.UnpackInt())
+			leader := resp.UnpackInt()
+			unpackInts(resp) // replicas
+			unpackInts(resp) // in-sync replicas
+			if resp.Errored() || topic != p.config.Topic || partition != p.config.Partition {
+				continue
+			}
+
+			if partitionErr != noneErrorCode {
+				return "", ErrorCode(partitionErr)
+			}
+			if int32(leader) < 0 {
+				return "", errLeaderNotAvailable
+			}
+			addr, ok := brokers[leader]
+			if !ok {
+				return "", fmt.Errorf("%w: %d", errUnknownLeaderBroker, int32(leader))
+			}
+			return addr, nil
+		}
+		if !resp.Errored() && topic == p.config.Topic && topicErr != noneErrorCode {
+			return "", ErrorCode(topicErr)
+		}
+	}
+	if resp.Errored() {
+		return "", fmt.Errorf("%w: %s", errUnexpectedResponse, resp.Err)
+	}
+	return "", fmt.Errorf("%w: %s/%d", errPartitionNotFound, p.config.Topic, p.config.Partition)
+}
+
+// produce writes [batch] to the partition leader
+func (p *Producer) produce(batch []byte) error {
+	body := newPacker()
+	packNullableString(body, "") // transactional ID
+	body.PackShort(uint16(p.config.RequiredAcks))
+	body.PackInt(uint32(p.config.Timeout.Milliseconds()))
+	body.PackInt(1) // number of topics
+	body.PackStr(p.config.Topic)
+	body.PackInt(1) // number of partitions
+	body.PackInt(uint32(p.config.Partition))
+	body.PackBytes(batch)
+
+	resp, err := p.roundTrip(p.conn, produceAPIKey, produceAPIVersion, body)
+	if err != nil {
+		return err
+	}
+
+	numTopics := resp.UnpackInt()
+	for i := uint32(0); i < numTopics && !resp.Errored(); i++ {
+		_ = resp.UnpackStr() // topic
+		numPartitions := resp.UnpackInt()
+		for j := uint32(0); j < numPartitions && !resp.Errored(); j++ {
+			_ = resp.UnpackInt() // partition
+			errCode := resp.UnpackShort()
+			_ = resp.UnpackLong() // base offset
+			_ = resp.UnpackLong() // log append time
+			if !resp.Errored() && errCode != noneErrorCode {
+				return ErrorCode(errCode)
+			}
+		}
+	}
+	if resp.Errored() {
+		return fmt.Errorf("%w: %s", errUnexpectedResponse, resp.Err)
+	}
+	return nil
+}
+
+// roundTrip sends a request with [body] over [conn] and returns the body of
+// the response.
+func (p *Producer) roundTrip(conn net.Conn, apiKey, apiVersion uint16, body *wrappers.Packer) (*wrappers.Packer, error) {
+	if body.Errored() {
+		return nil, errMessageTooLarge
+	}
+
+	p.correlationID++
+	correlationID := p.correlationID
+	req := requestHeader(apiKey, apiVersion, correlationID, p.config.ClientID)
+	req.PackFixedBytes(body.Bytes[:body.Offset])
+	if req.Errored() {
+		return nil, errMessageTooLarge
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(p.config.Timeout)); err != nil {
+		return nil, err
+	}
+
+	sizeBytes := [wrappers.IntLen]byte{}
+	binary.BigEndian.PutUint32(sizeBytes[:], uint32(req.Offset))
+	if _, err := conn.Write(append(sizeBytes[:], req.Bytes[:req.Offset]...)); err != nil {
+		return nil, err
+	}
+
+	if _, err := io.ReadFull(conn, sizeBytes[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(sizeBytes[:])
+	if size > maxMessageSize {
+		return nil, fmt.Errorf("%w: %d bytes", errMessageTooLarge, size)
+	}
+	respBytes := make([]byte, size)
+	if _, err := io.ReadFull(conn, respBytes); err != nil {
+		return nil, err
+	}
+
+	resp := &wrappers.Packer{Bytes: respBytes}
+	if respCorrelationID := resp.UnpackInt(); respCorrelationID != correlationID {
+		return nil, fmt.Errorf("%w: expected correlation ID %d but got %d", errUnexpectedResponse, correlationID, respCorrelationID)
+	}
+	return resp, nil
+}
+
+func unpackNullableString(p *wrappers.Packer) string {
+	size := p.UnpackShort()
+	if size == math.MaxUint16 {
+		return ""
+	}
+	return string(p.UnpackFixedBytes(int(size)))
+}
+
+func unpackInts(p *wrappers.Packer) []uint32 {
+	num := p.UnpackInt()
+	if p.Errored() || int(num) > len(p.Bytes)/wrappers.IntLen {
+		p.Add(errUnexpectedResponse)
+		return nil
+	}
+	ints := make([]uint32, num)
+	for i := range ints {
+		ints[i] = p.UnpackInt()
+	}
+	return ints
+}
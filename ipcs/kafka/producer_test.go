@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package kafka
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// testBroker is a single broker that leads every partition and stores the
+// records produced to it.
+type testBroker struct {
+	t        *testing.T
+	listener net.Listener
+	records  chan Record
+	// Error code returned by produce requests
+	produceErr uint16
+}
+
+func newTestBroker(t *testing.T) *testBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	b := &testBroker{
+		t:        t,
+		listener: listener,
+		records:  make(chan Record, 16),
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	go b.serve()
+	return b
+}
+
+func (b *testBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		go b.handle(conn)
+	}
+}
+
+func (b *testBroker) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		sizeBytes := [wrappers.IntLen]byte{}
+		if _, err := io.ReadFull(conn, sizeBytes[:]); err != nil {
+			return
+		}
+		req := make([]byte, binary.BigEndian.Uint32(sizeBytes[:]))
+		if _, err := io.ReadFull(conn, req); err != nil {
+			return
+		}
+
+		p := &wrappers.Packer{Bytes: req}
+		apiKey := p.UnpackShort()
+		apiVersion := p.UnpackShort()
+		correlationID := p.UnpackInt()
+		_ = unpackNullableString(p) // client ID
+
+		resp := newPacker()
+		resp.PackInt(correlationID)
+		switch apiKey {
+		case metadataAPIKey:
+			assert.EqualValues(b.t, metadataAPIVersion, apiVersion)
+			b.metadata(p, resp)
+		case produceAPIKey:
+			assert.EqualValues(b.t, produceAPIVersion, apiVersion)
+			b.produce(p, resp)
+		default:
+			b.t.Errorf("unexpected api key %d", apiKey)
+			return
+		}
+		assert.NoError(b.t, p.Err)
+
+		binary.BigEndian.PutUint32(sizeBytes[:], uint32(resp.Offset))
+		if _, err := conn.Write(append(sizeBytes[:], resp.Bytes[:resp.Offset]...)); err != nil {
+			return
+		}
+	}
+}
+
+func (b *testBroker) metadata(req, resp *wrappers.Packer) {
+	topics := make([]string, req.UnpackInt())
+	for i := range topics {
+		topics[i] = req.UnpackStr()
+	}
+
+	host, portStr, err := net.SplitHostPort(b.listener.Addr().String())
+	assert.NoError(b.t, err)
+	port, err := strconv.Atoi(portStr)
+	assert.NoError(b.t, err)
+
+	resp.PackInt(1) // number of brokers
+	resp.PackInt(7) // node ID
+	resp.PackStr(host)
+	resp.PackInt(uint32(port))
+	resp.PackShort(math.MaxUint16) // rack
+	resp.PackInt(7)                // controller ID
+	resp.PackInt(uint32(len(topics)))
+	for _, topic := range topics {
+		resp.PackShort(noneErrorCode)
+		resp.PackStr(topic)
+		resp.PackBool(false)
+		resp.PackInt(1) // number of partitions
+		resp.PackShort(noneErrorCode)
+		resp.PackInt(0) // partition
+		resp.PackInt(7) // leader
+		resp.PackInt(1) // replicas
+		resp.PackInt(7)
+		resp.PackInt(1) // in-sync replicas
+		resp.PackInt(7)
+	}
+}
+
+func (b *testBroker) produce(req, resp *wrappers.Packer) {
+	_ = unpackNullableString(req) // transactional ID
+	_ = req.UnpackShort()         // acks
+	_ = req.UnpackInt()           // timeout
+	assert.EqualValues(b.t, 1, req.UnpackInt())
+	topic := req.UnpackStr()
+	assert.EqualValues(b.t, 1, req.UnpackInt())
+	partition := req.UnpackInt()
+	b.readBatch(req.UnpackBytes())
+
+	resp.PackInt(1)
+	resp.PackStr(topic)
+	resp.PackInt(1)
+	resp.PackInt(partition)
+	resp.PackShort(b.produceErr)
+	resp.PackLong(0)              // base offset
+	resp.PackLong(math.MaxUint64) // log append time
+	resp.PackInt(0)               // throttle time
+}
+
+func (b *testBroker) readBatch(batch []byte) {
+	assert := assert.New(b.t)
+
+	p := &wrappers.Packer{Bytes: batch}
+	assert.Zero(p.UnpackLong()) // base offset
+	assert.EqualValues(len(batch)-wrappers.LongLen-wrappers.IntLen, p.UnpackInt())
+	_ = p.UnpackInt() // partition leader epoch
+	assert.EqualValues(recordBatchMagic, p.UnpackByte())
+	crc := p.UnpackInt()
+	assert.Equal(crc32.Checksum(batch[p.Offset:], castagnoli), crc)
+	assert.Zero(p.UnpackShort()) // attributes
+	_ = p.UnpackInt()            // last offset delta
+	firstTimestamp := int64(p.UnpackLong())
+	_ = p.UnpackLong()  // max timestamp
+	_ = p.UnpackLong()  // producer ID
+	_ = p.UnpackShort() // producer epoch
+	_ = p.UnpackInt()   // base sequence
+	numRecords := p.UnpackInt()
+	assert.NoError(p.Err)
+
+	r := batch[p.Offset:]
+	varint := func() int64 {
+		v, n := binary.Varint(r)
+		assert.Positive(n)
+		r = r[n:]
+		return v
+	}
+	varintBytes := func() []byte {
+		size := varint()
+		if size < 0 {
+			return nil
+		}
+		bytes := r[:size]
+		r = r[size:]
+		return bytes
+	}
+	for i := uint32(0); i < numRecords; i++ {
+		_ = varint() // length
+		r = r[1:]    // attributes
+		record := Record{Timestamp: firstTimestamp + varint()}
+		assert.EqualValues(i, varint()) // offset delta
+		record.Key = varintBytes()
+		record.Value = varintBytes()
+		numHeaders := varint()
+		for j := int64(0); j < numHeaders; j++ {
+			record.Headers = append(record.Headers, Header{
+				Key:   string(varintBytes()),
+				Value: varintBytes(),
+			})
+		}
+		b.records <- record
+	}
+	assert.Empty(r)
+}
+
+func TestProducer(t *testing.T) {
+	assert := assert.New(t)
+
+	broker := newTestBroker(t)
+	producer, err := NewProducer(Config{
+		Brokers: []string{broker.listener.Addr().String()},
+		Topic:   "accepted",
+	})
+	assert.NoError(err)
+	defer producer.Close()
+
+	records := []Record{
+		{
+			Key:       []byte{1},
+			Value:     []byte{2, 3},
+			Headers:   []Header{{Key: "eventType", Value: []byte("consensus")}},
+			Timestamp: 1000,
+		},
+		{
+			Value:     []byte{4},
+			Timestamp: 1005,
+		},
+	}
+	assert.NoError(producer.Produce(records))
+	for _, expected := range records {
+		assert.Equal(expected, <-broker.records)
+	}
+
+	// The connection to the leader is reused
+	assert.NoError(producer.Produce(records[1:]))
+	assert.Equal(records[1], <-broker.records)
+}
+
+func TestProducerError(t *testing.T) {
+	assert := assert.New(t)
+
+	broker := newTestBroker(t)
+	broker.produceErr = 6 // NOT_LEADER_OR_FOLLOWER
+	producer, err := NewProducer(Config{
+		Brokers: []string{broker.listener.Addr().String()},
+		Topic:   "accepted",
+	})
+	assert.NoError(err)
+
+	err = producer.Produce([]Record{{Value: []byte{1}}})
+	assert.Equal(ErrorCode(6), err)
+	<-broker.records
+
+	// The connection is dropped so that the leader is looked up again
+	assert.Nil(producer.conn)
+}
+
+func TestNewProducerInvalidConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewProducer(Config{Topic: "accepted"})
+	assert.ErrorIs(err, errNoBrokers)
+
+	_, err = NewProducer(Config{Brokers: []string{"127.0.0.1:9092"}})
+	assert.ErrorIs(err, errNoTopic)
+
+	_, err = NewProducer(Config{
+		Brokers:      []string{"127.0.0.1:9092"},
+		Topic:        "accepted",
+		RequiredAcks: 2,
+	})
+	assert.ErrorIs(err, errUnsupportedAcks)
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package kafka
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// The subset of the Kafka protocol that is needed to produce records. See
+// https://kafka.apache.org/protocol for the message formats.
+const (
+	produceAPIKey  = 0
+	metadataAPIKey = 3
+
+	// Produce v3 is the first version to support record batches (magic 2),
+	// which are the only record format accepted by all current brokers.
+	produceAPIVersion  = 3
+	metadataAPIVersion = 1
+
+	recordBatchMagic = 2
+
+	noneErrorCode = 0
+
+	maxMessageSize = 64 * 1024 * 1024 // bytes
+)
+
+var (
+	errMessageTooLarge = errors.New("message too large")
+
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// Header is a key value pair that is attached to a record
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Record is a single message written to a topic partition
+type Record struct {
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Timestamp int64 // Unix time, in milliseconds
+}
+
+// ErrorCode is an error returned by a broker
+type ErrorCode int16
+
+func (e ErrorCode) Error() string {
+	return fmt.Sprintf("kafka error code %d", int16(e))
+}
+
+func newPacker() *wrappers.Packer {
+	return &wrappers.Packer{MaxSize: maxMessageSize}
+}
+
+// packNullableString packs [str] as a nullable string, where the empty string
+// is encoded as null.
+func packNullableString(p *wrappers.Packer, str string) {
+	if str == "" {
+		p.PackShort(math.MaxUint16) // -1
+		return
+	}
+	p.PackStr(str)
+}
+
+func packVarint(p *wrappers.Packer, val int64) {
+	buf := [binary.MaxVarintLen64]byte{}
+	n := binary.PutVarint(buf[:], val)
+	p.PackFixedBytes(buf[:n])
+}
+
+// packVarintBytes packs [bytes] prefixed with its length as a varint. A nil
+// slice is encoded as null.
+func packVarintBytes(p *wrappers.Packer, bytes []byte) {
+	if bytes == nil {
+		packVarint(p, -1)
+		return
+	}
+	packVarint(p, int64(len(bytes)))
+	p.PackFixedBytes(bytes)
+}
+
+// requestHeader packs a request header (v1) for [apiKey] at [apiVersion].
+func requestHeader(apiKey, apiVersion uint16, correlationID uint32, clientID string) *wrappers.Packer {
+	p := newPacker()
+	p.PackShort(apiKey)
+	p.PackShort(apiVersion)
+	p.PackInt(correlationID)
+	packNullableString(p, clientID)
+	return p
+}
+
+// recordBatch encodes [records] as a record batch (magic 2).
+func recordBatch(records []Record) ([]byte, error) {
+	firstTimestamp := records[0].Timestamp
+	maxTimestamp := firstTimestamp
+
+	recordsPacker := newPacker()
+	for i, record := range records {
+		if record.Timestamp > maxTimestamp {
+			maxTimestamp = record.Timestamp
+		}
+
+		r := newPacker()
+		r.PackByte(0) // attributes
+		packVarint(r, record.Timestamp-firstTimestamp)
+		packVarint(r, int64(i)) // offset delta
+		packVarintBytes(r, record.Key)
+		packVarintBytes(r, record.Value)
+		packVarint(r, int64(len(record.Headers)))
+		for _, header := range record.Headers {
+			packVarintBytes(r, []byte(header.Key))
+			packVarintBytes(r, header.Value)
+		}
+
+		if r.Errored() {
+			return nil, errMessageTooLarge
+		}
+
+		packVarint(recordsPacker, int64(r.Offset))
+		recordsPacker.PackFixedBytes(r.Bytes[:r.Offset])
+	}
+	if recordsPacker.Errored() {
+		return nil, errMessageTooLarge
+	}
+
+	// The fields that are covered by the CRC
+	crcPacker := newPacker()
+	crcPacker.PackShort(0)                      // attributes: no compression
+	crcPacker.PackInt(uint32(len(records) - 1)) // last offset delta
+	crcPacker.PackLong(uint64(firstTimestamp))  // first timestamp
+	crcPacker.PackLong(uint64(maxTimestamp))    // max timestamp
+	crcPacker.PackLong(math.MaxUint64)          // producer ID: none
+	crcPacker.PackShort(math.MaxUint16)         // producer epoch: none
+	crcPacker.PackInt(math.MaxUint32)           // base sequence: none
+	crcPacker.PackInt(uint32(len(records)))     // number of records
+	crcPacker.PackFixedBytes(recordsPacker.Bytes[:recordsPacker.Offset])
+	if crcPacker.Errored() {
+		return nil, errMessageTooLarge
+	}
+	crcBytes := crcPacker.Bytes[:crcPacker.Offset]
+
+	p := newPacker()
+	p.PackLong(0) // base offset
+	// batch length: everything after this field
+	p.PackInt(uint32(wrappers.IntLen + wrappers.ByteLen + wrappers.IntLen + len(crcBytes)))
+	p.PackInt(math.MaxUint32) // partition leader epoch: none
+	p.PackByte(recordBatchMagic)
+	p.PackInt(crc32.Checksum(crcBytes, castagnoli))
+	p.PackFixedBytes(crcBytes)
+	if p.Errored() {
+		return nil, errMessageTooLarge
+	}
+	return p.Bytes[:p.Offset], nil
+}
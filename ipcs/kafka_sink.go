@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ipcs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/ipcs/kafka"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+// Record headers that describe the container in each Kafka record
+const (
+	chainIDRecordHeader   = "chainID"
+	eventTypeRecordHeader = "eventType"
+)
+
+// newKafkaSink returns a sink that produces each container to
+// [config.Topic]/[config.Partition]. Records are keyed by the container ID and
+// their headers describe the chain and the event type.
+func newKafkaSink(ctx context, chainID ids.ID, eventType string, config SinkConfig) (*queuedSink, error) {
+	producer, err := kafka.NewProducer(kafka.Config{
+		Brokers:   config.Brokers,
+		Topic:     config.Topic,
+		Partition: config.Partition,
+		ClientID:  constants.AppName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headers := []kafka.Header{
+		{Key: chainIDRecordHeader, Value: []byte(chainID.String())},
+		{Key: eventTypeRecordHeader, Value: []byte(eventType)},
+	}
+	deliver := func(containerID ids.ID, container []byte) error {
+		return producer.Produce([]kafka.Record{{
+			Key:       containerID[:],
+			Value:     container,
+			Headers:   headers,
+			Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+		}})
+	}
+	url := fmt.Sprintf("kafka://%s/%s/%d", strings.Join(config.Brokers, ","), config.Topic, config.Partition)
+	return newQueuedSink(ctx.log, url, config.QueueSize, config.MaxRetries, deliver, producer.Close), nil
+}
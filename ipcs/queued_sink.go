@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ipcs
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	defaultQueueSize = 1024
+
+	initialRetryDelay = 100 * time.Millisecond
+	maxRetryDelay     = 30 * time.Second
+)
+
+var (
+	errQueueFull   = errors.New("sink queue is full")
+	errSinkStopped = errors.New("sink stopped")
+
+	_ Sink = &queuedSink{}
+)
+
+type queuedContainer struct {
+	id    ids.ID
+	bytes []byte
+}
+
+// queuedSink delivers containers from a background goroutine, so that slow or
+// unavailable remote endpoints don't block the acceptance of containers.
+// Containers that can't be delivered are retried with exponential backoff.
+type queuedSink struct {
+	log        logging.Logger
+	url        string
+	maxRetries int
+	// Delivers a container. Only called from the delivery goroutine.
+	deliver func(containerID ids.ID, container []byte) error
+	// Called once the delivery goroutine has exited
+	onClose func() error
+
+	queue     chan queuedContainer
+	quit      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newQueuedSink(
+	log logging.Logger,
+	url string,
+	queueSize int,
+	maxRetries int,
+	deliver func(containerID ids.ID, container []byte) error,
+	onClose func() error,
+) *queuedSink {
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	s := &queuedSink{
+		log:        log,
+		url:        url,
+		maxRetries: maxRetries,
+		deliver:    deliver,
+		onClose:    onClose,
+		queue:      make(chan queuedContainer, queueSize),
+		quit:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Send queues [container] to be delivered. If the queue is full, the container
+// is dropped and an error is returned.
+func (s *queuedSink) Send(containerID ids.ID, container []byte) error {
+	select {
+	case <-s.quit:
+		return errSinkStopped
+	default:
+	}
+
+	select {
+	case s.queue <- queuedContainer{id: containerID, bytes: container}:
+		return nil
+	default:
+		return errQueueFull
+	}
+}
+
+func (s *queuedSink) run() {
+	defer close(s.done)
+
+	for {
+		select {
+		case container := <-s.queue:
+			s.deliverWithRetries(container)
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+func (s *queuedSink) deliverWithRetries(container queuedContainer) {
+	delay := initialRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := s.deliver(container.id, container.bytes)
+		if err == nil {
+			return
+		}
+		if attempt >= s.maxRetries {
+			s.log.Warn("dropping container %s after failing to deliver it to %s %d times: %s",
+				container.id, s.url, attempt+1, err)
+			return
+		}
+		s.log.Debug("failed to deliver container %s to %s, retrying in %s: %s",
+			container.id, s.url, delay, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-s.quit:
+			timer.Stop()
+			return
+		}
+
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+func (s *queuedSink) URL() string { return s.url }
+
+// Close stops delivering containers. Containers that are still queued are
+// dropped.
+func (s *queuedSink) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.quit)
+		<-s.done
+		if s.onClose != nil {
+			err = s.onClose()
+		}
+	})
+	return err
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ipcs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/ipcs/socket"
+)
+
+const (
+	// SocketSinkType writes containers to a local IPC socket
+	SocketSinkType = "socket"
+	// FileSinkType appends containers to rotating files
+	FileSinkType = "file"
+	// WebhookSinkType posts containers to an HTTP endpoint
+	WebhookSinkType = "webhook"
+	// KafkaSinkType produces containers to a Kafka topic partition
+	KafkaSinkType = "kafka"
+)
+
+var (
+	errUnknownSinkType = errors.New("unknown sink type")
+	errMissingURL      = errors.New("missing url")
+
+	_ Sink = &socketSink{}
+)
+
+// Sink delivers the containers accepted by a chain to an external consumer
+type Sink interface {
+	// Send delivers [container], which has ID [containerID]
+	Send(containerID ids.ID, container []byte) error
+
+	// URL describes where the containers are delivered to
+	URL() string
+
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// SinkConfig describes a sink that the accepted containers of a chain are
+// delivered to. A sink is created for both the consensus and the decisions
+// events of the chain.
+type SinkConfig struct {
+	// One of "socket", "file", "webhook" or "kafka"
+	Type string `json:"type"`
+
+	// File sink: directory to write the files to. Defaults to the IPC path.
+	Path string `json:"path"`
+	// File sink: size in bytes after which the file is rotated. If 0, the file
+	// is never rotated.
+	MaxFileSize uint64 `json:"maxFileSize"`
+	// File sink: number of rotated files to keep
+	MaxFiles int `json:"maxFiles"`
+
+	// Webhook sink: endpoint that containers are posted to
+	URL string `json:"url"`
+	// Webhook sink: additional headers of the requests
+	Headers map[string]string `json:"headers"`
+
+	// Kafka sink: addresses of the brokers used to find the partition leader
+	Brokers []string `json:"brokers"`
+	// Kafka sink: topic that containers are produced to
+	Topic string `json:"topic"`
+	// Kafka sink: partition that containers are produced to
+	Partition int32 `json:"partition"`
+
+	// Webhook and Kafka sinks: number of times delivering a container is
+	// retried before it's dropped
+	MaxRetries int `json:"maxRetries"`
+	// Webhook and Kafka sinks: number of containers that can be waiting to be
+	// delivered. Containers accepted while the queue is full are dropped.
+	QueueSize int `json:"queueSize"`
+}
+
+// newSink creates the sink described by [config] for the [eventType] events
+// of [chainID].
+func newSink(ctx context, chainID ids.ID, eventType string, config SinkConfig) (Sink, error) {
+	switch config.Type {
+	case SocketSinkType, "":
+		return newSocketSink(ctx, ipcURL(ctx, chainID, eventType))
+	case FileSinkType:
+		dir := config.Path
+		if dir == "" {
+			dir = ctx.path
+		}
+		path := filepath.Join(dir, ipcName(ctx, chainID, eventType))
+		return newFileSink(path, config.MaxFileSize, config.MaxFiles)
+	case WebhookSinkType:
+		if config.URL == "" {
+			return nil, errMissingURL
+		}
+		return newWebhookSink(ctx, chainID, eventType, config), nil
+	case KafkaSinkType:
+		return newKafkaSink(ctx, chainID, eventType, config)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownSinkType, config.Type)
+	}
+}
+
+// socketSink writes containers to a local IPC socket
+type socketSink struct {
+	url    string
+	socket *socket.Socket
+}
+
+func newSocketSink(ctx context, url string) (*socketSink, error) {
+	err := os.Remove(url)
+	if err != nil && !errors.Is(err, syscall.ENOENT) {
+		return nil, err
+	}
+
+	s := &socketSink{
+		url:    url,
+		socket: socket.NewSocket(url, ctx.log),
+	}
+	if err := s.socket.Listen(); err != nil {
+		if err := s.socket.Close(); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *socketSink) Send(_ ids.ID, container []byte) error {
+	s.socket.Send(container)
+	return nil
+}
+
+func (s *socketSink) URL() string { return s.url }
+
+func (s *socketSink) Close() error { return s.socket.Close() }
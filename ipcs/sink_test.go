@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ipcs
+
+import (
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// readRecords returns the length prefixed records in the file at [path]
+func readRecords(t *testing.T, path string) [][]byte {
+	assert := assert.New(t)
+
+	bytes, err := os.ReadFile(path)
+	assert.NoError(err)
+
+	records := [][]byte{}
+	for len(bytes) > 0 {
+		size := binary.BigEndian.Uint64(bytes)
+		bytes = bytes[wrappers.LongLen:]
+		records = append(records, bytes[:size])
+		bytes = bytes[size:]
+	}
+	return records
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "chain-consensus")
+	// Fits 2 records of 2 bytes
+	sink, err := newFileSink(path, 2*(wrappers.LongLen+2), 2)
+	assert.NoError(err)
+
+	for i := byte(0); i < 7; i++ {
+		assert.NoError(sink.Send(ids.GenerateTestID(), []byte{i, i}))
+	}
+	assert.NoError(sink.Close())
+	assert.ErrorIs(sink.Send(ids.Empty, nil), errFileSinkClosed)
+
+	assert.Equal([][]byte{{6, 6}}, readRecords(t, path))
+	assert.Equal([][]byte{{4, 4}, {5, 5}}, readRecords(t, path+".1"))
+	assert.Equal([][]byte{{2, 2}, {3, 3}}, readRecords(t, path+".2"))
+	// Only [maxFiles] rotated files are kept
+	_, err = os.Stat(path + ".3")
+	assert.True(os.IsNotExist(err))
+
+	// Reopening the sink appends to the existing file
+	sink, err = newFileSink(path, 2*(wrappers.LongLen+2), 2)
+	assert.NoError(err)
+	assert.NoError(sink.Send(ids.GenerateTestID(), []byte{7, 7}))
+	assert.NoError(sink.Close())
+	assert.Equal([][]byte{{6, 6}, {7, 7}}, readRecords(t, path))
+}
+
+func TestWebhookSinkRetries(t *testing.T) {
+	assert := assert.New(t)
+
+	var (
+		lock     sync.Mutex
+		attempts int
+		received = make(chan []byte, 1)
+	)
+	chainID := ids.GenerateTestID()
+	containerID := ids.GenerateTestID()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		attempts++
+		attempt := attempts
+		lock.Unlock()
+
+		// Fail the first attempt
+		if attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		assert.Equal("secret", r.Header.Get("Authorization"))
+		assert.Equal(chainID.String(), r.Header.Get(ChainIDHeader))
+		assert.Equal(ipcDecisionsIdentifier, r.Header.Get(EventTypeHeader))
+		assert.Equal(containerID.String(), r.Header.Get(ContainerIDHeader))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(err)
+		received <- body
+	}))
+	defer server.Close()
+
+	ctx := context{log: logging.NoLog{}}
+	sink, err := newSink(ctx, chainID, ipcDecisionsIdentifier, SinkConfig{
+		Type:       WebhookSinkType,
+		URL:        server.URL,
+		Headers:    map[string]string{"Authorization": "secret"},
+		MaxRetries: 1,
+	})
+	assert.NoError(err)
+	defer sink.Close()
+
+	assert.NoError(sink.Send(containerID, []byte{1, 2, 3}))
+	select {
+	case body := <-received:
+		assert.Equal([]byte{1, 2, 3}, body)
+	case <-time.After(5 * time.Second):
+		t.Fatal("container wasn't delivered")
+	}
+}
+
+func TestQueuedSinkFull(t *testing.T) {
+	assert := assert.New(t)
+
+	unblock := make(chan struct{})
+	closed := false
+	sink := newQueuedSink(
+		logging.NoLog{},
+		"test",
+		1,
+		0,
+		func(ids.ID, []byte) error {
+			<-unblock
+			return nil
+		},
+		func() error {
+			closed = true
+			return nil
+		},
+	)
+
+	// The first container is being delivered and the second one is queued
+	assert.NoError(sink.Send(ids.GenerateTestID(), nil))
+	assert.Eventually(func() bool { return len(sink.queue) == 0 }, 5*time.Second, time.Millisecond)
+	assert.NoError(sink.Send(ids.GenerateTestID(), nil))
+	assert.ErrorIs(sink.Send(ids.GenerateTestID(), nil), errQueueFull)
+
+	close(unblock)
+	assert.NoError(sink.Close())
+	assert.True(closed)
+	assert.ErrorIs(sink.Send(ids.GenerateTestID(), nil), errSinkStopped)
+}
+
+func TestChainIPCsSinks(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	consensusAcceptorGroup := snow.NewAcceptorGroup(logging.NoLog{})
+	decisionAcceptorGroup := snow.NewAcceptorGroup(logging.NoLog{})
+	chainID := ids.GenerateTestID()
+	cipcs, err := NewChainIPCs(
+		logging.NoLog{},
+		dir,
+		1,
+		consensusAcceptorGroup,
+		decisionAcceptorGroup,
+		nil,
+		map[ids.ID][]SinkConfig{
+			chainID: {{Type: FileSinkType}},
+		},
+	)
+	assert.NoError(err)
+	assert.Equal([]ids.ID{chainID}, cipcs.GetPublishedBlockchains())
+
+	ctx := snow.DefaultConsensusContextTest()
+	ctx.ChainID = chainID
+	assert.NoError(consensusAcceptorGroup.Accept(ctx, ids.GenerateTestID(), []byte{1}))
+	assert.NoError(decisionAcceptorGroup.Accept(ctx, ids.GenerateTestID(), []byte{2}))
+
+	ok, err := cipcs.Unpublish(chainID)
+	assert.True(ok)
+	assert.NoError(err)
+
+	// Unpublishing deregisters the acceptors
+	assert.NoError(consensusAcceptorGroup.Accept(ctx, ids.GenerateTestID(), []byte{3}))
+
+	ipcCtx := context{networkID: 1}
+	assert.Equal([][]byte{{1}}, readRecords(t, filepath.Join(dir, ipcName(ipcCtx, chainID, ipcConsensusIdentifier))))
+	assert.Equal([][]byte{{2}}, readRecords(t, filepath.Join(dir, ipcName(ipcCtx, chainID, ipcDecisionsIdentifier))))
+
+	_, err = NewChainIPCs(
+		logging.NoLog{},
+		dir,
+		1,
+		consensusAcceptorGroup,
+		decisionAcceptorGroup,
+		nil,
+		map[ids.ID][]SinkConfig{
+			chainID: {{Type: "carrier-pigeon"}},
+		},
+	)
+	assert.ErrorIs(err, errUnknownSinkType)
+}
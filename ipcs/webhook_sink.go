@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package ipcs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+const (
+	webhookTimeout = 10 * time.Second
+
+	// Headers that describe the container in each webhook request
+	ChainIDHeader     = "Avalanche-Chain-ID"
+	EventTypeHeader   = "Avalanche-Event-Type"
+	ContainerIDHeader = "Avalanche-Container-ID"
+)
+
+// newWebhookSink returns a sink that posts each container to [config.URL]. The
+// body of the request is the container and its headers describe the chain,
+// the event type and the container ID. Any response other than 2xx is retried.
+func newWebhookSink(ctx context, chainID ids.ID, eventType string, config SinkConfig) *queuedSink {
+	client := &http.Client{Timeout: webhookTimeout}
+	deliver := func(containerID ids.ID, container []byte) error {
+		req, err := http.NewRequest(http.MethodPost, config.URL, bytes.NewReader(container))
+		if err != nil {
+			return err
+		}
+		for key, value := range config.Headers {
+			req.Header.Set(key, value)
+		}
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set(ChainIDHeader, chainID.String())
+		req.Header.Set(EventTypeHeader, eventType)
+		req.Header.Set(ContainerIDHeader, containerID.String())
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		// Drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("webhook responded with status %s", resp.Status)
+		}
+		return nil
+	}
+	return newQueuedSink(ctx.log, config.URL, config.QueueSize, config.MaxRetries, deliver, nil)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/ava-labs/avalanchego/app/chainarchive"
 	"github.com/ava-labs/avalanchego/app/runner"
 	"github.com/ava-labs/avalanchego/config"
 	"github.com/ava-labs/avalanchego/version"
@@ -15,6 +16,13 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch command := os.Args[1]; command {
+		case chainarchive.ExportCommand, chainarchive.ImportCommand:
+			os.Exit(runArchiveCommand(command, os.Args[2:]))
+		}
+	}
+
 	fs := config.BuildFlagSet()
 	v, err := config.BuildViper(fs, os.Args[1:])
 
@@ -46,3 +54,43 @@ func main() {
 
 	runner.Run(runnerConfig, nodeConfig)
 }
+
+// runArchiveCommand runs the export or import subcommand [command] with the
+// flags [args] and returns the exit code
+func runArchiveCommand(command string, args []string) int {
+	fs := config.BuildArchiveFlagSet()
+	v, err := config.BuildViper(fs, args)
+
+	if errors.Is(err, pflag.ErrHelp) {
+		return 0
+	}
+
+	if err != nil {
+		fmt.Printf("couldn't configure flags: %s\n", err)
+		return 1
+	}
+
+	archiveConfig, err := config.GetChainArchiveConfig(v, command)
+	if err != nil {
+		fmt.Printf("couldn't load %s config: %s\n", command, err)
+		return 1
+	}
+
+	switch command {
+	case chainarchive.ExportCommand:
+		numContainers, err := chainarchive.Export(archiveConfig)
+		if err != nil {
+			fmt.Printf("couldn't export chain %s: %s\n", archiveConfig.ChainID, err)
+			return 1
+		}
+		fmt.Printf("exported %d containers of chain %s to %s\n", numContainers, archiveConfig.ChainID, archiveConfig.ArchivePath)
+	case chainarchive.ImportCommand:
+		chainID, numContainers, err := chainarchive.Import(archiveConfig)
+		if err != nil {
+			fmt.Printf("couldn't import %s: %s\n", archiveConfig.ArchivePath, err)
+			return 1
+		}
+		fmt.Printf("imported %d containers of chain %s from %s\n", numContainers, chainID, archiveConfig.ArchivePath)
+	}
+	return 0
+}
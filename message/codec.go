@@ -60,8 +60,12 @@ type codec struct {
 
 	compressTimeMetrics   map[Op]metric.Averager
 	decompressTimeMetrics map[Op]metric.Averager
-	compressor            compression.Compressor
+	compressors           *compressors
 	maxMessageTimeout     time.Duration
+
+	// Used to parse protobuf encoded messages and to lazily encode outbound
+	// messages as protobufs
+	proto *protoCodec
 }
 
 func NewCodecWithMemoryPool(namespace string, metrics prometheus.Registerer, maxMessageSize int64, maxMessageTimeout time.Duration) (Codec, error) {
@@ -73,10 +77,21 @@ func NewCodecWithMemoryPool(namespace string, metrics prometheus.Registerer, max
 		},
 		compressTimeMetrics:   make(map[Op]metric.Averager, len(ExternalOps)),
 		decompressTimeMetrics: make(map[Op]metric.Averager, len(ExternalOps)),
-		compressor:            compression.NewGzipCompressor(maxMessageSize),
 		maxMessageTimeout:     maxMessageTimeout,
 	}
 
+	compressors, err := newCompressors(namespace, metrics, maxMessageSize)
+	if err != nil {
+		return nil, err
+	}
+	c.compressors = compressors
+
+	proto, err := newProtoCodec(namespace, metrics, compressors, maxMessageTimeout)
+	if err != nil {
+		return nil, err
+	}
+	c.proto = proto
+
 	errs := wrappers.Errs{}
 	for _, op := range ExternalOps {
 		if !op.Compressible() {
@@ -103,14 +118,13 @@ func NewCodecWithMemoryPool(namespace string, metrics prometheus.Registerer, max
 
 func (c *codec) SetTime(t time.Time) {
 	c.clock.Set(t)
+	c.proto.SetTime(t)
 }
 
 // Pack attempts to pack a map of fields into a message.
 // The first byte of the message is the opcode of the message.
-// Uses [buffer] to hold the message's byte repr.
-// [buffer]'s contents may be overwritten by this method.
-// [buffer] may be nil.
-// If [compress], compress the payload.
+// If [compress], compress the payload with gzip. The message can be
+// re-encoded with another compression algorithm by EncodedBytes.
 // If [bypassThrottling], mark the message to avoid outbound throttling checks.
 func (c *codec) Pack(
 	op Op,
@@ -118,68 +132,120 @@ func (c *codec) Pack(
 	compress bool,
 	bypassThrottling bool,
 ) (OutboundMessage, error) {
+	compress = compress && op.Compressible()
+	compressionType := compression.TypeNone
+	if compress {
+		compressionType = compression.TypeGzip
+	}
+
+	buffer := c.byteSlicePool.Get().([]byte)
+	bytes, bytesSaved, err := c.pack(op, fieldValues, compressionType, buffer[:0])
+	if err != nil {
+		return nil, err
+	}
+	return &outboundMessage{
+		bytes:                 bytes,
+		bytesSavedCompression: bytesSaved,
+		op:                    op,
+		bypassThrottling:      bypassThrottling,
+		encoding:              PackerEncoding,
+		compressionType:       compressionType,
+		encoder:               c,
+		fields:                fieldValues,
+		compress:              compress,
+		refs:                  1,
+		c:                     c,
+	}, nil
+}
+
+func (c *codec) encode(
+	op Op,
+	fieldValues map[Field]interface{},
+	encoding Encoding,
+	compressionType compression.Type,
+) ([]byte, int, error) {
+	switch encoding {
+	case PackerEncoding:
+		return c.pack(op, fieldValues, compressionType, nil)
+	default:
+		return c.proto.encode(op, fieldValues, encoding, compressionType)
+	}
+}
+
+// pack returns the packed message and the number of bytes that were saved by
+// compressing it.
+// Uses [buffer] to hold the message's byte repr.
+// [buffer]'s contents may be overwritten by this method.
+// [buffer] may be nil.
+func (c *codec) pack(
+	op Op,
+	fieldValues map[Field]interface{},
+	compressionType compression.Type,
+	buffer []byte,
+) ([]byte, int, error) {
 	msgFields, ok := messages[op]
 	if !ok {
-		return nil, errBadOp
+		return nil, 0, errBadOp
 	}
 
-	buffer := c.byteSlicePool.Get().([]byte)
 	p := wrappers.Packer{
 		MaxSize: math.MaxInt32,
-		Bytes:   buffer[:0],
+		Bytes:   buffer,
 	}
 	// Pack the op code (message type)
 	p.PackByte(byte(op))
 
-	// Optionally, pack whether the payload is compressed
+	// Optionally, pack how the payload is compressed. Peers that only support
+	// gzip parse this as a bool.
 	if op.Compressible() {
-		p.PackBool(compress)
+		p.PackByte(byte(compressionType))
+	} else {
+		compressionType = compression.TypeNone
 	}
 
 	// Pack the uncompressed payload
 	for _, field := range msgFields {
 		data, ok := fieldValues[field]
 		if !ok {
-			return nil, errMissingField
+			return nil, 0, errMissingField
 		}
 		field.Packer()(&p, data)
 	}
 	if p.Err != nil {
-		return nil, p.Err
+		return nil, 0, p.Err
 	}
-	msg := &outboundMessage{
-		op:               op,
-		bytes:            p.Bytes,
-		refs:             1,
-		c:                c,
-		bypassThrottling: bypassThrottling,
-	}
-	if !compress {
-		return msg, nil
+	if compressionType == compression.TypeNone {
+		return p.Bytes, 0, nil
 	}
 
-	// If [compress], compress the payload (not the op code, not isCompressed).
+	// Compress the payload (not the op code, not the compression type).
 	// The slice below is guaranteed to be in-bounds because [p.Err] == nil
-	// implies that len(msg.bytes) >= 2
-	payloadBytes := msg.bytes[wrappers.BoolLen+wrappers.ByteLen:]
+	// implies that len(p.Bytes) >= 2
+	payloadBytes := p.Bytes[wrappers.ByteLen+wrappers.ByteLen:]
 	startTime := time.Now()
-	compressedPayloadBytes, err := c.compressor.Compress(payloadBytes)
+	compressedPayloadBytes, err := c.compressors.compress(compressionType, payloadBytes)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't compress payload of %s message: %w", op, err)
+		return nil, 0, fmt.Errorf("couldn't compress payload of %s message: %w", op, err)
 	}
 	c.compressTimeMetrics[op].Observe(float64(time.Since(startTime)))
-	msg.bytesSavedCompression = len(payloadBytes) - len(compressedPayloadBytes) // may be negative
-	// Remove the uncompressed payload (keep just the message type and isCompressed)
-	msg.bytes = msg.bytes[:wrappers.BoolLen+wrappers.ByteLen]
+	bytesSaved := len(payloadBytes) - len(compressedPayloadBytes) // may be negative
+	// Remove the uncompressed payload (keep just the message type and the
+	// compression type)
+	bytes := p.Bytes[:wrappers.ByteLen+wrappers.ByteLen]
 	// Attach the compressed payload
-	msg.bytes = append(msg.bytes, compressedPayloadBytes...)
-	return msg, nil
+	bytes = append(bytes, compressedPayloadBytes...)
+	return bytes, bytesSaved, nil
 }
 
 // Parse attempts to convert bytes into a message.
-// The first byte of the message is the opcode of the message.
+// The first byte of the message is the opcode of the message. Protobuf encoded
+// messages are told apart by their first byte and are parsed by [c.proto].
 // Overrides client specified deadline in a message to maxDeadlineDuration
 func (c *codec) Parse(bytes []byte, nodeID ids.NodeID, onFinishedHandling func()) (InboundMessage, error) {
+	if IsProto(bytes) {
+		return c.proto.Parse(bytes, nodeID, onFinishedHandling)
+	}
+
 	p := wrappers.Packer{Bytes: bytes}
 
 	// Unpack the op code (message type)
@@ -191,9 +257,9 @@ func (c *codec) Parse(bytes []byte, nodeID ids.NodeID, onFinishedHandling func()
 	}
 
 	// See if messages of this type may be compressed
-	compressed := false
+	compressionType := compression.TypeNone
 	if op.Compressible() {
-		compressed = p.UnpackBool()
+		compressionType = compression.Type(p.UnpackByte())
 	}
 	if p.Err != nil {
 		return nil, p.Err
@@ -202,21 +268,22 @@ func (c *codec) Parse(bytes []byte, nodeID ids.NodeID, onFinishedHandling func()
 	bytesSaved := 0
 
 	// If the payload is compressed, decompress it
-	if compressed {
+	if compressionType != compression.TypeNone {
 		// The slice below is guaranteed to be in-bounds because [p.Err] == nil
-		compressedPayloadBytes := p.Bytes[wrappers.ByteLen+wrappers.BoolLen:]
+		compressedPayloadBytes := p.Bytes[wrappers.ByteLen+wrappers.ByteLen:]
 		startTime := time.Now()
-		payloadBytes, err := c.compressor.Decompress(compressedPayloadBytes)
+		payloadBytes, err := c.compressors.decompress(compressionType, compressedPayloadBytes)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't decompress payload of %s message: %w", op, err)
 		}
 		c.decompressTimeMetrics[op].Observe(float64(time.Since(startTime)))
 		// Replace the compressed payload with the decompressed payload.
-		// Remove the compressed payload and isCompressed; keep just the message type
+		// Remove the compressed payload and the compression type; keep just
+		// the message type
 		p.Bytes = p.Bytes[:wrappers.ByteLen]
-		// Rewind offset by 1 because we removed the bool flag
+		// Rewind offset by 1 because we removed the compression type
 		// since the data now is uncompressed
-		p.Offset -= wrappers.BoolLen
+		p.Offset -= wrappers.ByteLen
 		// Attach the decompressed payload.
 		p.Bytes = append(p.Bytes, payloadBytes...)
 		bytesSaved = len(payloadBytes) - len(compressedPayloadBytes)
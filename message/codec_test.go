@@ -15,6 +15,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/units"
 )
@@ -60,6 +61,14 @@ func TestCodecParseExtraSpace(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCodecParseUnknownCompression(t *testing.T) {
+	codec, err := NewCodecWithMemoryPool("", prometheus.NewRegistry(), 2*units.MiB, 10*time.Second)
+	assert.NoError(t, err)
+
+	_, err = codec.Parse([]byte{byte(Put), math.MaxUint8, 0x00}, dummyNodeID, dummyOnFinishedHandling)
+	assert.ErrorIs(t, err, errUnsupportedCompression)
+}
+
 func TestDeadlineOverride(t *testing.T) {
 	c, err := NewCodecWithMemoryPool("", prometheus.NewRegistry(), 2*units.MiB, 10*time.Second)
 	assert.NoError(t, err)
@@ -234,3 +243,42 @@ func TestCodecPackParseGzip(t *testing.T) {
 		assert.EqualValues(t, len(m.fields), len(unpacked.fields))
 	}
 }
+
+// Test packing and then parsing messages
+// when using a zstd compressor
+func TestCodecPackParseZstd(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := NewCodecWithMemoryPool("", prometheus.NewRegistry(), 2*units.MiB, 10*time.Second)
+	assert.NoError(err)
+
+	id := ids.GenerateTestID()
+	fields := map[Field]interface{}{
+		ChainID:        id[:],
+		RequestID:      uint32(1337),
+		ContainerID:    id[:],
+		ContainerBytes: make([]byte, 1024),
+	}
+	msg, err := c.Pack(Put, fields, true, false)
+	assert.NoError(err)
+
+	zstdBytes, bytesSaved, err := msg.EncodedBytes(PackerEncoding, compression.TypeZstd)
+	assert.NoError(err)
+	assert.Positive(bytesSaved)
+	assert.Equal(byte(Put), zstdBytes[0])
+	assert.Equal(byte(compression.TypeZstd), zstdBytes[1])
+
+	parsedMsg, err := c.Parse(zstdBytes, dummyNodeID, dummyOnFinishedHandling)
+	assert.NoError(err)
+	assert.Equal(Put, parsedMsg.Op())
+	assert.Equal(fields, parsedMsg.(*inboundMessage).fields)
+	assert.Equal(bytesSaved, parsedMsg.BytesSavedCompression())
+
+	// Messages that shouldn't be compressed aren't compressed with zstd
+	msg, err = c.Pack(Put, fields, false, false)
+	assert.NoError(err)
+	uncompressedBytes, bytesSaved, err := msg.EncodedBytes(PackerEncoding, compression.TypeZstd)
+	assert.NoError(err)
+	assert.Zero(bytesSaved)
+	assert.Equal(msg.Bytes(), uncompressedBytes)
+}
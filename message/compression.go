@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/metric"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	errUnsupportedCompression = errors.New("unsupported compression type")
+
+	// Compression algorithms that messages may be compressed with, from most
+	// to least preferred
+	compressionPreference = []compression.Type{
+		compression.TypeZstd,
+		compression.TypeGzip,
+	}
+)
+
+// SelectCompression returns the most preferred compression algorithm that is
+// advertised by both [capabilities] and [peerCapabilities]. Compression
+// algorithms are advertised by name. Gzip is supported by every peer, even if
+// it isn't advertised.
+func SelectCompression(capabilities, peerCapabilities []string) compression.Type {
+	for _, compressionType := range compressionPreference {
+		name := compressionType.String()
+		if contains(capabilities, name) && contains(peerCapabilities, name) {
+			return compressionType
+		}
+	}
+	return compression.TypeGzip
+}
+
+func contains(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// compressors compresses and decompresses messages with every supported
+// compression algorithm and tracks how each algorithm performs.
+type compressors struct {
+	compressors             map[compression.Type]compression.Compressor
+	compressTimeMetrics     map[compression.Type]metric.Averager
+	decompressTimeMetrics   map[compression.Type]metric.Averager
+	compressionRatioMetrics map[compression.Type]metric.Averager
+}
+
+func newCompressors(namespace string, metrics prometheus.Registerer, maxMessageSize int64) (*compressors, error) {
+	zstdCompressor, err := compression.NewZstdCompressor(maxMessageSize)
+	if err != nil {
+		return nil, err
+	}
+	c := &compressors{
+		compressors: map[compression.Type]compression.Compressor{
+			compression.TypeGzip: compression.NewGzipCompressor(maxMessageSize),
+			compression.TypeZstd: zstdCompressor,
+		},
+		compressTimeMetrics:     make(map[compression.Type]metric.Averager, len(compressionPreference)),
+		decompressTimeMetrics:   make(map[compression.Type]metric.Averager, len(compressionPreference)),
+		compressionRatioMetrics: make(map[compression.Type]metric.Averager, len(compressionPreference)),
+	}
+
+	errs := wrappers.Errs{}
+	for compressionType := range c.compressors {
+		c.compressTimeMetrics[compressionType] = metric.NewAveragerWithErrs(
+			namespace,
+			fmt.Sprintf("%s_compress_time", compressionType),
+			fmt.Sprintf("time (in ns) to compress messages with %s", compressionType),
+			metrics,
+			&errs,
+		)
+		c.decompressTimeMetrics[compressionType] = metric.NewAveragerWithErrs(
+			namespace,
+			fmt.Sprintf("%s_decompress_time", compressionType),
+			fmt.Sprintf("time (in ns) to decompress messages with %s", compressionType),
+			metrics,
+			&errs,
+		)
+		c.compressionRatioMetrics[compressionType] = metric.NewAveragerWithErrs(
+			namespace,
+			fmt.Sprintf("%s_compression_ratio", compressionType),
+			fmt.Sprintf("size of messages compressed with %s relative to their uncompressed size", compressionType),
+			metrics,
+			&errs,
+		)
+	}
+	return c, errs.Err
+}
+
+func (c *compressors) compress(compressionType compression.Type, msg []byte) ([]byte, error) {
+	compressor, ok := c.compressors[compressionType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedCompression, compressionType)
+	}
+
+	startTime := time.Now()
+	compressed, err := compressor.Compress(msg)
+	if err != nil {
+		return nil, err
+	}
+	c.compressTimeMetrics[compressionType].Observe(float64(time.Since(startTime)))
+	if len(msg) > 0 {
+		c.compressionRatioMetrics[compressionType].Observe(float64(len(compressed)) / float64(len(msg)))
+	}
+	return compressed, nil
+}
+
+func (c *compressors) decompress(compressionType compression.Type, msg []byte) ([]byte, error) {
+	compressor, ok := c.compressors[compressionType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedCompression, compressionType)
+	}
+
+	startTime := time.Now()
+	decompressed, err := compressor.Decompress(msg)
+	if err != nil {
+		return nil, err
+	}
+	c.decompressTimeMetrics[compressionType].Observe(float64(time.Since(startTime)))
+	return decompressed, nil
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/utils/compression"
+)
+
+func TestSelectCompression(t *testing.T) {
+	tests := map[string]struct {
+		capabilities     []string
+		peerCapabilities []string
+		expected         compression.Type
+	}{
+		"nothing advertised": {
+			expected: compression.TypeGzip,
+		},
+		"both support zstd": {
+			capabilities:     []string{"gzip", "zstd"},
+			peerCapabilities: []string{"proto", "zstd", "gzip"},
+			expected:         compression.TypeZstd,
+		},
+		"peer doesn't support zstd": {
+			capabilities:     []string{"gzip", "zstd"},
+			peerCapabilities: []string{"gzip"},
+			expected:         compression.TypeGzip,
+		},
+		"zstd disabled": {
+			capabilities:     []string{"gzip"},
+			peerCapabilities: []string{"gzip", "zstd"},
+			expected:         compression.TypeGzip,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, SelectCompression(test.capabilities, test.peerCapabilities))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/compression"
 )
 
 var (
@@ -97,7 +98,13 @@ func (inMsg *inboundMessage) String() string {
 type OutboundMessage interface {
 	BytesSavedCompression() int
 	Bytes() []byte
+	// EncodedBytes returns this message in [encoding], compressed with
+	// [compressionType] if this message should be compressed, and the number
+	// of bytes that were saved by compressing it. The returned bytes follow
+	// the same reference counting rules as the bytes returned by Bytes.
+	EncodedBytes(encoding Encoding, compressionType compression.Type) ([]byte, int, error)
 	Op() Op
+	Get(Field) interface{}
 	BypassThrottling() bool
 
 	AddRef()
@@ -109,18 +116,93 @@ type outboundMessage struct {
 	bytesSavedCompression int
 	op                    Op
 	bypassThrottling      bool
+	// Encoding and compression of [bytes]
+	encoding        Encoding
+	compressionType compression.Type
+
+	// Used to lazily re-encode the message in other formats. nil if the
+	// message can't be re-encoded.
+	encoder encoder
+	fields  map[Field]interface{}
+	// True if the message should be compressed
+	compress    bool
+	formatsLock sync.Mutex
+	formats     map[format]*encodedMessage
 
 	refLock sync.Mutex
 	refs    int
-	c       *codec
+	// nil if [bytes] wasn't taken from a pool
+	c *codec
+}
+
+// encoder encodes the fields of a message in a given format
+type encoder interface {
+	encode(
+		op Op,
+		fieldValues map[Field]interface{},
+		encoding Encoding,
+		compressionType compression.Type,
+	) ([]byte, int, error)
+}
+
+// format is a way that an outbound message can be sent over the wire
+type format struct {
+	encoding        Encoding
+	compressionType compression.Type
+}
+
+// encodedMessage is an outbound message that was lazily re-encoded in another
+// format
+type encodedMessage struct {
+	once       sync.Once
+	bytes      []byte
+	bytesSaved int
+	err        error
 }
 
 // Op returns the value of the specified operation in this message
 func (outMsg *outboundMessage) Op() Op { return outMsg.op }
 
+// Get returns the value of the specified field in this message
+func (outMsg *outboundMessage) Get(field Field) interface{} { return outMsg.fields[field] }
+
 // Bytes returns this message in bytes
 func (outMsg *outboundMessage) Bytes() []byte { return outMsg.bytes }
 
+// EncodedBytes returns this message in [encoding] and [compressionType]. Each
+// format is only computed once, no matter how many peers it's sent to.
+func (outMsg *outboundMessage) EncodedBytes(encoding Encoding, compressionType compression.Type) ([]byte, int, error) {
+	if !outMsg.compress {
+		compressionType = compression.TypeNone
+	}
+	if encoding == outMsg.encoding && compressionType == outMsg.compressionType {
+		return outMsg.bytes, outMsg.bytesSavedCompression, nil
+	}
+	if outMsg.encoder == nil {
+		return nil, 0, fmt.Errorf("%w: %s", errUnsupportedEncoding, encoding)
+	}
+
+	f := format{
+		encoding:        encoding,
+		compressionType: compressionType,
+	}
+	outMsg.formatsLock.Lock()
+	if outMsg.formats == nil {
+		outMsg.formats = make(map[format]*encodedMessage)
+	}
+	msg, ok := outMsg.formats[f]
+	if !ok {
+		msg = &encodedMessage{}
+		outMsg.formats[f] = msg
+	}
+	outMsg.formatsLock.Unlock()
+
+	msg.once.Do(func() {
+		msg.bytes, msg.bytesSaved, msg.err = outMsg.encoder.encode(outMsg.op, outMsg.fields, encoding, compressionType)
+	})
+	return msg.bytes, msg.bytesSaved, msg.err
+}
+
 // BytesSavedCompression returns the number of bytes this message saved due to
 // compression. That is, the number of bytes we did not send over the
 // network due to the message being compressed. 0 for messages that were not
@@ -141,7 +223,7 @@ func (outMsg *outboundMessage) DecRef() {
 	defer outMsg.refLock.Unlock()
 
 	outMsg.refs--
-	if outMsg.refs == 0 {
+	if outMsg.refs == 0 && outMsg.c != nil {
 		outMsg.c.byteSlicePool.Put(outMsg.bytes)
 	}
 }
@@ -170,3 +252,6 @@ func (*TestMsg) BytesSavedCompression() int { return 0 }
 func (*TestMsg) AddRef()                    {}
 func (*TestMsg) DecRef()                    {}
 func (m *TestMsg) BypassThrottling() bool   { return m.bypassThrottling }
+func (m *TestMsg) EncodedBytes(Encoding, compression.Type) ([]byte, int, error) {
+	return m.bytes, 0, nil
+}
@@ -47,6 +47,8 @@ const (
 	StateSummaryFrontier
 	GetAcceptedStateSummary
 	AcceptedStateSummary
+	// Handshake:
+	Capabilities
 
 	// Internal messages (External messages should be added above these):
 	GetAcceptedFrontierFailed
@@ -70,6 +72,7 @@ var (
 		PeerList,
 		Ping,
 		Pong,
+		Capabilities,
 	}
 
 	// List of all consensus request message types
@@ -210,6 +213,8 @@ var (
 		PeerList: {Peers},
 		Ping:     {},
 		Pong:     {Uptime},
+		// Sent after Version. Peers that don't know this message drop it.
+		Capabilities: {CapabilityStrs},
 		// Bootstrapping:
 		GetAcceptedFrontier: {ChainID, RequestID, Deadline},
 		AcceptedFrontier:    {ChainID, RequestID, ContainerIDs},
@@ -256,6 +261,8 @@ func (op Op) String() string {
 		return "ping"
 	case Pong:
 		return "pong"
+	case Capabilities:
+		return "capabilities"
 	case GetAcceptedFrontier:
 		return "get_accepted_frontier"
 	case AcceptedFrontier:
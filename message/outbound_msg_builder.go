@@ -35,6 +35,8 @@ type OutboundMsgBuilder interface {
 
 	Pong(uptimePercentage uint8) (OutboundMessage, error)
 
+	Capabilities(capabilities []string) (OutboundMessage, error)
+
 	GetStateSummaryFrontier(
 		chainID ids.ID,
 		requestID uint32,
@@ -226,6 +228,17 @@ func (b *outMsgBuilder) Pong(uptimePercentage uint8) (OutboundMessage, error) {
 	)
 }
 
+func (b *outMsgBuilder) Capabilities(capabilities []string) (OutboundMessage, error) {
+	return b.c.Pack(
+		Capabilities,
+		map[Field]interface{}{
+			CapabilityStrs: capabilities,
+		},
+		Capabilities.Compressible(), // Capabilities messages can't be compressed
+		true,
+	)
+}
+
 func (b *outMsgBuilder) GetStateSummaryFrontier(
 	chainID ids.ID,
 	requestID uint32,
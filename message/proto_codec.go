@@ -0,0 +1,838 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/metric"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+
+	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
+)
+
+// Encoding is the format that messages are sent over the wire in
+type Encoding byte
+
+const (
+	// PackerEncoding is the legacy encoding. The first byte of a message is
+	// its op code, which is followed by its fields packed with
+	// wrappers.Packer.
+	PackerEncoding Encoding = iota
+	// ProtoEncoding encodes messages as a p2ppb.Message protobuf
+	ProtoEncoding
+)
+
+func (e Encoding) String() string {
+	switch e {
+	case PackerEncoding:
+		return "packer"
+	case ProtoEncoding:
+		return "proto"
+	default:
+		return "unknown"
+	}
+}
+
+// protoBit is set in the first byte of every protobuf encoded message and is
+// never set in the first byte of a packer encoded message. See p2p.proto.
+const protoBit = 0x80
+
+var (
+	errUnsupportedEncoding = errors.New("unsupported encoding")
+	errInvalidFieldType    = errors.New("message has a field of an unexpected type")
+	errUnknownProtoMessage = errors.New("unknown protobuf message")
+	errNestedCompression   = errors.New("compressed message contains a compressed message")
+	errUncompressibleOp    = errors.New("message type can't be compressed")
+	errInvalidHashLen      = errors.New("invalid hash length")
+	errInvalidIPLen        = errors.New("invalid IP length")
+	errInvalidPort         = errors.New("invalid port")
+	errInvalidUptime       = errors.New("invalid uptime")
+
+	_ Codec = &protoCodec{}
+)
+
+// IsProto returns true if [bytes] is a protobuf encoded message
+func IsProto(bytes []byte) bool {
+	return len(bytes) > 0 && bytes[0]&protoBit != 0
+}
+
+// protoCodec encodes messages as p2ppb.Message protobufs.
+// It's safe for multiple goroutines to call Pack and Parse concurrently.
+type protoCodec struct {
+	clock mockable.Clock
+
+	compressTimeMetrics   map[Op]metric.Averager
+	decompressTimeMetrics map[Op]metric.Averager
+	compressors           *compressors
+	maxMessageTimeout     time.Duration
+}
+
+// NewProtoCodec returns a codec that encodes messages as p2ppb.Message protobufs
+func NewProtoCodec(namespace string, metrics prometheus.Registerer, maxMessageSize int64, maxMessageTimeout time.Duration) (Codec, error) {
+	compressors, err := newCompressors(namespace, metrics, maxMessageSize)
+	if err != nil {
+		return nil, err
+	}
+	return newProtoCodec(namespace, metrics, compressors, maxMessageTimeout)
+}
+
+func newProtoCodec(namespace string, metrics prometheus.Registerer, compressors *compressors, maxMessageTimeout time.Duration) (*protoCodec, error) {
+	c := &protoCodec{
+		compressTimeMetrics:   make(map[Op]metric.Averager, len(ExternalOps)),
+		decompressTimeMetrics: make(map[Op]metric.Averager, len(ExternalOps)),
+		compressors:           compressors,
+		maxMessageTimeout:     maxMessageTimeout,
+	}
+
+	errs := wrappers.Errs{}
+	for _, op := range ExternalOps {
+		if !op.Compressible() {
+			continue
+		}
+
+		c.compressTimeMetrics[op] = metric.NewAveragerWithErrs(
+			namespace,
+			fmt.Sprintf("%s_proto_compress_time", op),
+			fmt.Sprintf("time (in ns) to compress protobuf encoded %s messages", op),
+			metrics,
+			&errs,
+		)
+		c.decompressTimeMetrics[op] = metric.NewAveragerWithErrs(
+			namespace,
+			fmt.Sprintf("%s_proto_decompress_time", op),
+			fmt.Sprintf("time (in ns) to decompress protobuf encoded %s messages", op),
+			metrics,
+			&errs,
+		)
+	}
+	return c, errs.Err
+}
+
+func (c *protoCodec) SetTime(t time.Time) {
+	c.clock.Set(t)
+}
+
+// Pack attempts to encode a map of fields into a protobuf message.
+// If [compress] and messages of type [op] are compressible, the message is
+// compressed with gzip. The message can be re-encoded with another
+// compression algorithm by EncodedBytes.
+// If [bypassThrottling], mark the message to avoid outbound throttling checks.
+func (c *protoCodec) Pack(
+	op Op,
+	fieldValues map[Field]interface{},
+	compress bool,
+	bypassThrottling bool,
+) (OutboundMessage, error) {
+	compress = compress && op.Compressible()
+	compressionType := compression.TypeNone
+	if compress {
+		compressionType = compression.TypeGzip
+	}
+
+	bytes, bytesSaved, err := c.marshal(op, fieldValues, compressionType)
+	if err != nil {
+		return nil, err
+	}
+	return &outboundMessage{
+		bytes:                 bytes,
+		bytesSavedCompression: bytesSaved,
+		op:                    op,
+		bypassThrottling:      bypassThrottling,
+		encoding:              ProtoEncoding,
+		compressionType:       compressionType,
+		encoder:               c,
+		fields:                fieldValues,
+		compress:              compress,
+		refs:                  1,
+	}, nil
+}
+
+func (c *protoCodec) encode(
+	op Op,
+	fieldValues map[Field]interface{},
+	encoding Encoding,
+	compressionType compression.Type,
+) ([]byte, int, error) {
+	if encoding != ProtoEncoding {
+		return nil, 0, fmt.Errorf("%w: %s", errUnsupportedEncoding, encoding)
+	}
+	return c.marshal(op, fieldValues, compressionType)
+}
+
+// marshal returns the protobuf encoding of the message and the number of
+// bytes that were saved by compressing it.
+func (c *protoCodec) marshal(op Op, fieldValues map[Field]interface{}, compressionType compression.Type) ([]byte, int, error) {
+	msg, err := toProto(op, fieldValues)
+	if err != nil {
+		return nil, 0, err
+	}
+	bytes, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, 0, err
+	}
+	if compressionType == compression.TypeNone || !op.Compressible() {
+		return bytes, 0, nil
+	}
+
+	startTime := time.Now()
+	compressedBytes, err := c.compressors.compress(compressionType, bytes)
+	if err != nil {
+		return nil, 0, fmt.Errorf("couldn't compress %s message: %w", op, err)
+	}
+	c.compressTimeMetrics[op].Observe(float64(time.Since(startTime)))
+
+	compressedMsg := &p2ppb.Message{}
+	switch compressionType {
+	case compression.TypeGzip:
+		compressedMsg.Message = &p2ppb.Message_CompressedGzip{
+			CompressedGzip: compressedBytes,
+		}
+	case compression.TypeZstd:
+		compressedMsg.Message = &p2ppb.Message_CompressedZstd{
+			CompressedZstd: compressedBytes,
+		}
+	default:
+		return nil, 0, fmt.Errorf("%w: %s", errUnsupportedCompression, compressionType)
+	}
+	compressedBytes, err = proto.Marshal(compressedMsg)
+	if err != nil {
+		return nil, 0, err
+	}
+	return compressedBytes, len(bytes) - len(compressedBytes), nil // may be negative
+}
+
+// Parse attempts to convert a protobuf encoded message into a message.
+// Overrides client specified deadline in a message to maxDeadlineDuration
+func (c *protoCodec) Parse(bytes []byte, nodeID ids.NodeID, onFinishedHandling func()) (InboundMessage, error) {
+	msg := &p2ppb.Message{}
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+
+	var (
+		compressionType = compression.TypeNone
+		compressedBytes []byte
+	)
+	switch m := msg.GetMessage().(type) {
+	case *p2ppb.Message_CompressedGzip:
+		compressionType, compressedBytes = compression.TypeGzip, m.CompressedGzip
+	case *p2ppb.Message_CompressedZstd:
+		compressionType, compressedBytes = compression.TypeZstd, m.CompressedZstd
+	}
+
+	var (
+		compressed     = compressionType != compression.TypeNone
+		bytesSaved     = 0
+		decompressTime time.Duration
+	)
+	if compressed {
+		startTime := time.Now()
+		decompressedBytes, err := c.compressors.decompress(compressionType, compressedBytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't decompress message: %w", err)
+		}
+		decompressTime = time.Since(startTime)
+
+		msg = &p2ppb.Message{}
+		if err := proto.Unmarshal(decompressedBytes, msg); err != nil {
+			return nil, err
+		}
+		bytesSaved = len(decompressedBytes) - len(bytes)
+	}
+
+	op, fieldValues, err := fromProto(msg)
+	if err != nil {
+		return nil, err
+	}
+	if compressed {
+		// The op is only known once the message has been decompressed
+		if !op.Compressible() {
+			return nil, fmt.Errorf("%w: %s", errUncompressibleOp, op)
+		}
+		c.decompressTimeMetrics[op].Observe(float64(decompressTime))
+	}
+
+	var expirationTime time.Time
+	if deadline, hasDeadline := fieldValues[Deadline]; hasDeadline {
+		deadlineDuration := time.Duration(deadline.(uint64))
+		if deadlineDuration > c.maxMessageTimeout {
+			deadlineDuration = c.maxMessageTimeout
+		}
+		expirationTime = c.clock.Time().Add(deadlineDuration)
+	}
+
+	return &inboundMessage{
+		op:                    op,
+		fields:                fieldValues,
+		bytesSavedCompression: bytesSaved,
+		nodeID:                nodeID,
+		expirationTime:        expirationTime,
+		onFinishedHandling:    onFinishedHandling,
+	}, nil
+}
+
+// fieldGetter reads typed values out of the fields of an outbound message.
+// The first missing or mistyped field is recorded in [err].
+type fieldGetter struct {
+	fields map[Field]interface{}
+	err    error
+}
+
+func (g *fieldGetter) get(field Field) interface{} {
+	value, ok := g.fields[field]
+	if !ok && g.err == nil {
+		g.err = fmt.Errorf("%w: %s", errMissingField, field)
+	}
+	return value
+}
+
+func (g *fieldGetter) typeErr(field Field) {
+	if g.err == nil {
+		g.err = fmt.Errorf("%w: %s", errInvalidFieldType, field)
+	}
+}
+
+func (g *fieldGetter) uint8(field Field) uint8 {
+	value, ok := g.get(field).(uint8)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) uint32(field Field) uint32 {
+	value, ok := g.get(field).(uint32)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) uint64(field Field) uint64 {
+	value, ok := g.get(field).(uint64)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) uint64s(field Field) []uint64 {
+	value, ok := g.get(field).([]uint64)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) str(field Field) string {
+	value, ok := g.get(field).(string)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) strs(field Field) []string {
+	value, ok := g.get(field).([]string)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) bytes(field Field) []byte {
+	value, ok := g.get(field).([]byte)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) bytesSlice(field Field) [][]byte {
+	value, ok := g.get(field).([][]byte)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) ip(field Field) ips.IPPort {
+	value, ok := g.get(field).(ips.IPPort)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+func (g *fieldGetter) peers(field Field) []ips.ClaimedIPPort {
+	value, ok := g.get(field).([]ips.ClaimedIPPort)
+	if !ok {
+		g.typeErr(field)
+	}
+	return value
+}
+
+// toProto converts the fields of a message into its protobuf representation
+func toProto(op Op, fieldValues map[Field]interface{}) (*p2ppb.Message, error) {
+	g := &fieldGetter{fields: fieldValues}
+	msg := &p2ppb.Message{}
+	switch op {
+	case Ping:
+		msg.Message = &p2ppb.Message_Ping{
+			Ping: &p2ppb.Ping{},
+		}
+	case Pong:
+		msg.Message = &p2ppb.Message_Pong{
+			Pong: &p2ppb.Pong{
+				UptimePct: uint32(g.uint8(Uptime)),
+			},
+		}
+	case Version:
+		ip := g.ip(IP)
+		msg.Message = &p2ppb.Message_Version{
+			Version: &p2ppb.Version{
+				NetworkId:      g.uint32(NetworkID),
+				MyTime:         g.uint64(MyTime),
+				IpAddr:         ip.IP.To16(),
+				IpPort:         uint32(ip.Port),
+				MyVersion:      g.str(VersionStr),
+				MyVersionTime:  g.uint64(VersionTime),
+				Sig:            g.bytes(SigBytes),
+				TrackedSubnets: g.bytesSlice(TrackedSubnets),
+			},
+		}
+	case PeerList:
+		peers := g.peers(Peers)
+		claimedIPPorts := make([]*p2ppb.ClaimedIpPort, len(peers))
+		for i, peer := range peers {
+			if peer.Cert == nil {
+				return nil, fmt.Errorf("%w: %s", errInvalidFieldType, Peers)
+			}
+			claimedIPPorts[i] = &p2ppb.ClaimedIpPort{
+				X509Certificate: peer.Cert.Raw,
+				IpAddr:          peer.IPPort.IP.To16(),
+				IpPort:          uint32(peer.IPPort.Port),
+				Timestamp:       peer.Timestamp,
+				Signature:       peer.Signature,
+			}
+		}
+		msg.Message = &p2ppb.Message_PeerList{
+			PeerList: &p2ppb.PeerList{
+				ClaimedIpPorts: claimedIPPorts,
+			},
+		}
+	case Capabilities:
+		msg.Message = &p2ppb.Message_Capabilities{
+			Capabilities: &p2ppb.Capabilities{
+				Capabilities: g.strs(CapabilityStrs),
+			},
+		}
+	case GetStateSummaryFrontier:
+		msg.Message = &p2ppb.Message_GetStateSummaryFrontier{
+			GetStateSummaryFrontier: &p2ppb.GetStateSummaryFrontier{
+				ChainId:   g.bytes(ChainID),
+				RequestId: g.uint32(RequestID),
+				Deadline:  g.uint64(Deadline),
+			},
+		}
+	case StateSummaryFrontier:
+		msg.Message = &p2ppb.Message_StateSummaryFrontier_{
+			StateSummaryFrontier_: &p2ppb.StateSummaryFrontier{
+				ChainId:   g.bytes(ChainID),
+				RequestId: g.uint32(RequestID),
+				Summary:   g.bytes(SummaryBytes),
+			},
+		}
+	case GetAcceptedStateSummary:
+		msg.Message = &p2ppb.Message_GetAcceptedStateSummary{
+			GetAcceptedStateSummary: &p2ppb.GetAcceptedStateSummary{
+				ChainId:   g.bytes(ChainID),
+				RequestId: g.uint32(RequestID),
+				Deadline:  g.uint64(Deadline),
+				Heights:   g.uint64s(SummaryHeights),
+			},
+		}
+	case AcceptedStateSummary:
+		msg.Message = &p2ppb.Message_AcceptedStateSummary_{
+			AcceptedStateSummary_: &p2ppb.AcceptedStateSummary{
+				ChainId:    g.bytes(ChainID),
+				RequestId:  g.uint32(RequestID),
+				SummaryIds: g.bytesSlice(SummaryIDs),
+			},
+		}
+	case GetAcceptedFrontier:
+		msg.Message = &p2ppb.Message_GetAcceptedFrontier{
+			GetAcceptedFrontier: &p2ppb.GetAcceptedFrontier{
+				ChainId:   g.bytes(ChainID),
+				RequestId: g.uint32(RequestID),
+				Deadline:  g.uint64(Deadline),
+			},
+		}
+	case AcceptedFrontier:
+		msg.Message = &p2ppb.Message_AcceptedFrontier_{
+			AcceptedFrontier_: &p2ppb.AcceptedFrontier{
+				ChainId:      g.bytes(ChainID),
+				RequestId:    g.uint32(RequestID),
+				ContainerIds: g.bytesSlice(ContainerIDs),
+			},
+		}
+	case GetAccepted:
+		msg.Message = &p2ppb.Message_GetAccepted{
+			GetAccepted: &p2ppb.GetAccepted{
+				ChainId:      g.bytes(ChainID),
+				RequestId:    g.uint32(RequestID),
+				Deadline:     g.uint64(Deadline),
+				ContainerIds: g.bytesSlice(ContainerIDs),
+			},
+		}
+	case Accepted:
+		msg.Message = &p2ppb.Message_Accepted_{
+			Accepted_: &p2ppb.Accepted{
+				ChainId:      g.bytes(ChainID),
+				RequestId:    g.uint32(RequestID),
+				ContainerIds: g.bytesSlice(ContainerIDs),
+			},
+		}
+	case GetAncestors:
+		msg.Message = &p2ppb.Message_GetAncestors{
+			GetAncestors: &p2ppb.GetAncestors{
+				ChainId:     g.bytes(ChainID),
+				RequestId:   g.uint32(RequestID),
+				Deadline:    g.uint64(Deadline),
+				ContainerId: g.bytes(ContainerID),
+			},
+		}
+	case Ancestors:
+		msg.Message = &p2ppb.Message_Ancestors_{
+			Ancestors_: &p2ppb.Ancestors{
+				ChainId:    g.bytes(ChainID),
+				RequestId:  g.uint32(RequestID),
+				Containers: g.bytesSlice(MultiContainerBytes),
+			},
+		}
+	case Get:
+		msg.Message = &p2ppb.Message_Get{
+			Get: &p2ppb.Get{
+				ChainId:     g.bytes(ChainID),
+				RequestId:   g.uint32(RequestID),
+				Deadline:    g.uint64(Deadline),
+				ContainerId: g.bytes(ContainerID),
+			},
+		}
+	case Put:
+		msg.Message = &p2ppb.Message_Put{
+			Put: &p2ppb.Put{
+				ChainId:     g.bytes(ChainID),
+				RequestId:   g.uint32(RequestID),
+				ContainerId: g.bytes(ContainerID),
+				Container:   g.bytes(ContainerBytes),
+			},
+		}
+	case PushQuery:
+		msg.Message = &p2ppb.Message_PushQuery{
+			PushQuery: &p2ppb.PushQuery{
+				ChainId:     g.bytes(ChainID),
+				RequestId:   g.uint32(RequestID),
+				Deadline:    g.uint64(Deadline),
+				ContainerId: g.bytes(ContainerID),
+				Container:   g.bytes(ContainerBytes),
+			},
+		}
+	case PullQuery:
+		msg.Message = &p2ppb.Message_PullQuery{
+			PullQuery: &p2ppb.PullQuery{
+				ChainId:     g.bytes(ChainID),
+				RequestId:   g.uint32(RequestID),
+				Deadline:    g.uint64(Deadline),
+				ContainerId: g.bytes(ContainerID),
+			},
+		}
+	case Chits:
+		msg.Message = &p2ppb.Message_Chits{
+			Chits: &p2ppb.Chits{
+				ChainId:      g.bytes(ChainID),
+				RequestId:    g.uint32(RequestID),
+				ContainerIds: g.bytesSlice(ContainerIDs),
+			},
+		}
+	case AppRequest:
+		msg.Message = &p2ppb.Message_AppRequest{
+			AppRequest: &p2ppb.AppRequest{
+				ChainId:   g.bytes(ChainID),
+				RequestId: g.uint32(RequestID),
+				Deadline:  g.uint64(Deadline),
+				AppBytes:  g.bytes(AppBytes),
+			},
+		}
+	case AppResponse:
+		msg.Message = &p2ppb.Message_AppResponse{
+			AppResponse: &p2ppb.AppResponse{
+				ChainId:   g.bytes(ChainID),
+				RequestId: g.uint32(RequestID),
+				AppBytes:  g.bytes(AppBytes),
+			},
+		}
+	case AppGossip:
+		msg.Message = &p2ppb.Message_AppGossip{
+			AppGossip: &p2ppb.AppGossip{
+				ChainId:  g.bytes(ChainID),
+				AppBytes: g.bytes(AppBytes),
+			},
+		}
+	default:
+		return nil, errBadOp
+	}
+	return msg, g.err
+}
+
+// fieldParser validates the values of an inbound protobuf message.
+// The first invalid value is recorded in [err].
+type fieldParser struct {
+	err error
+}
+
+func (p *fieldParser) hash(hash []byte) []byte {
+	if len(hash) != hashing.HashLen && p.err == nil {
+		p.err = fmt.Errorf("%w: %d", errInvalidHashLen, len(hash))
+	}
+	return hash
+}
+
+func (p *fieldParser) hashes(hashes [][]byte) [][]byte {
+	for _, hash := range hashes {
+		p.hash(hash)
+	}
+	return hashes
+}
+
+func (p *fieldParser) ip(ip []byte, port uint32) ips.IPPort {
+	if len(ip) != net.IPv6len && p.err == nil {
+		p.err = fmt.Errorf("%w: %d", errInvalidIPLen, len(ip))
+	}
+	if port > math.MaxUint16 && p.err == nil {
+		p.err = fmt.Errorf("%w: %d", errInvalidPort, port)
+	}
+	return ips.IPPort{
+		IP:   ip,
+		Port: uint16(port),
+	}
+}
+
+func (p *fieldParser) uptime(uptime uint32) uint8 {
+	if uptime > math.MaxUint8 && p.err == nil {
+		p.err = fmt.Errorf("%w: %d", errInvalidUptime, uptime)
+	}
+	return uint8(uptime)
+}
+
+func (p *fieldParser) peers(claimedIPPorts []*p2ppb.ClaimedIpPort) []ips.ClaimedIPPort {
+	peers := make([]ips.ClaimedIPPort, 0, len(claimedIPPorts))
+	for _, claimedIPPort := range claimedIPPorts {
+		cert, err := x509.ParseCertificate(claimedIPPort.GetX509Certificate())
+		if err != nil {
+			if p.err == nil {
+				p.err = err
+			}
+			return nil
+		}
+		peers = append(peers, ips.ClaimedIPPort{
+			Cert:      cert,
+			IPPort:    p.ip(claimedIPPort.GetIpAddr(), claimedIPPort.GetIpPort()),
+			Timestamp: claimedIPPort.GetTimestamp(),
+			Signature: claimedIPPort.GetSignature(),
+		})
+	}
+	return peers
+}
+
+// fromProto converts a protobuf message into the fields of a message. The
+// fields have the same types as the fields parsed by the legacy codec.
+func fromProto(msg *p2ppb.Message) (Op, map[Field]interface{}, error) {
+	p := &fieldParser{}
+	var (
+		op     Op
+		fields map[Field]interface{}
+	)
+	switch m := msg.GetMessage().(type) {
+	case *p2ppb.Message_CompressedGzip, *p2ppb.Message_CompressedZstd:
+		return 0, nil, errNestedCompression
+	case *p2ppb.Message_Ping:
+		op, fields = Ping, map[Field]interface{}{}
+	case *p2ppb.Message_Pong:
+		op, fields = Pong, map[Field]interface{}{
+			Uptime: p.uptime(m.Pong.GetUptimePct()),
+		}
+	case *p2ppb.Message_Version:
+		v := m.Version
+		op, fields = Version, map[Field]interface{}{
+			NetworkID:      v.GetNetworkId(),
+			NodeID:         uint32(0),
+			MyTime:         v.GetMyTime(),
+			IP:             p.ip(v.GetIpAddr(), v.GetIpPort()),
+			VersionStr:     v.GetMyVersion(),
+			VersionTime:    v.GetMyVersionTime(),
+			SigBytes:       v.GetSig(),
+			TrackedSubnets: p.hashes(v.GetTrackedSubnets()),
+		}
+	case *p2ppb.Message_PeerList:
+		op, fields = PeerList, map[Field]interface{}{
+			Peers: p.peers(m.PeerList.GetClaimedIpPorts()),
+		}
+	case *p2ppb.Message_Capabilities:
+		op, fields = Capabilities, map[Field]interface{}{
+			CapabilityStrs: m.Capabilities.GetCapabilities(),
+		}
+	case *p2ppb.Message_GetStateSummaryFrontier:
+		v := m.GetStateSummaryFrontier
+		op, fields = GetStateSummaryFrontier, map[Field]interface{}{
+			ChainID:   p.hash(v.GetChainId()),
+			RequestID: v.GetRequestId(),
+			Deadline:  v.GetDeadline(),
+		}
+	case *p2ppb.Message_StateSummaryFrontier_:
+		v := m.StateSummaryFrontier_
+		op, fields = StateSummaryFrontier, map[Field]interface{}{
+			ChainID:      p.hash(v.GetChainId()),
+			RequestID:    v.GetRequestId(),
+			SummaryBytes: v.GetSummary(),
+		}
+	case *p2ppb.Message_GetAcceptedStateSummary:
+		v := m.GetAcceptedStateSummary
+		op, fields = GetAcceptedStateSummary, map[Field]interface{}{
+			ChainID:        p.hash(v.GetChainId()),
+			RequestID:      v.GetRequestId(),
+			Deadline:       v.GetDeadline(),
+			SummaryHeights: v.GetHeights(),
+		}
+	case *p2ppb.Message_AcceptedStateSummary_:
+		v := m.AcceptedStateSummary_
+		op, fields = AcceptedStateSummary, map[Field]interface{}{
+			ChainID:    p.hash(v.GetChainId()),
+			RequestID:  v.GetRequestId(),
+			SummaryIDs: p.hashes(v.GetSummaryIds()),
+		}
+	case *p2ppb.Message_GetAcceptedFrontier:
+		v := m.GetAcceptedFrontier
+		op, fields = GetAcceptedFrontier, map[Field]interface{}{
+			ChainID:   p.hash(v.GetChainId()),
+			RequestID: v.GetRequestId(),
+			Deadline:  v.GetDeadline(),
+		}
+	case *p2ppb.Message_AcceptedFrontier_:
+		v := m.AcceptedFrontier_
+		op, fields = AcceptedFrontier, map[Field]interface{}{
+			ChainID:      p.hash(v.GetChainId()),
+			RequestID:    v.GetRequestId(),
+			ContainerIDs: p.hashes(v.GetContainerIds()),
+		}
+	case *p2ppb.Message_GetAccepted:
+		v := m.GetAccepted
+		op, fields = GetAccepted, map[Field]interface{}{
+			ChainID:      p.hash(v.GetChainId()),
+			RequestID:    v.GetRequestId(),
+			Deadline:     v.GetDeadline(),
+			ContainerIDs: p.hashes(v.GetContainerIds()),
+		}
+	case *p2ppb.Message_Accepted_:
+		v := m.Accepted_
+		op, fields = Accepted, map[Field]interface{}{
+			ChainID:      p.hash(v.GetChainId()),
+			RequestID:    v.GetRequestId(),
+			ContainerIDs: p.hashes(v.GetContainerIds()),
+		}
+	case *p2ppb.Message_GetAncestors:
+		v := m.GetAncestors
+		op, fields = GetAncestors, map[Field]interface{}{
+			ChainID:     p.hash(v.GetChainId()),
+			RequestID:   v.GetRequestId(),
+			Deadline:    v.GetDeadline(),
+			ContainerID: p.hash(v.GetContainerId()),
+		}
+	case *p2ppb.Message_Ancestors_:
+		v := m.Ancestors_
+		op, fields = Ancestors, map[Field]interface{}{
+			ChainID:             p.hash(v.GetChainId()),
+			RequestID:           v.GetRequestId(),
+			MultiContainerBytes: v.GetContainers(),
+		}
+	case *p2ppb.Message_Get:
+		v := m.Get
+		op, fields = Get, map[Field]interface{}{
+			ChainID:     p.hash(v.GetChainId()),
+			RequestID:   v.GetRequestId(),
+			Deadline:    v.GetDeadline(),
+			ContainerID: p.hash(v.GetContainerId()),
+		}
+	case *p2ppb.Message_Put:
+		v := m.Put
+		op, fields = Put, map[Field]interface{}{
+			ChainID:        p.hash(v.GetChainId()),
+			RequestID:      v.GetRequestId(),
+			ContainerID:    p.hash(v.GetContainerId()),
+			ContainerBytes: v.GetContainer(),
+		}
+	case *p2ppb.Message_PushQuery:
+		v := m.PushQuery
+		op, fields = PushQuery, map[Field]interface{}{
+			ChainID:        p.hash(v.GetChainId()),
+			RequestID:      v.GetRequestId(),
+			Deadline:       v.GetDeadline(),
+			ContainerID:    p.hash(v.GetContainerId()),
+			ContainerBytes: v.GetContainer(),
+		}
+	case *p2ppb.Message_PullQuery:
+		v := m.PullQuery
+		op, fields = PullQuery, map[Field]interface{}{
+			ChainID:     p.hash(v.GetChainId()),
+			RequestID:   v.GetRequestId(),
+			Deadline:    v.GetDeadline(),
+			ContainerID: p.hash(v.GetContainerId()),
+		}
+	case *p2ppb.Message_Chits:
+		v := m.Chits
+		op, fields = Chits, map[Field]interface{}{
+			ChainID:      p.hash(v.GetChainId()),
+			RequestID:    v.GetRequestId(),
+			ContainerIDs: p.hashes(v.GetContainerIds()),
+		}
+	case *p2ppb.Message_AppRequest:
+		v := m.AppRequest
+		op, fields = AppRequest, map[Field]interface{}{
+			ChainID:   p.hash(v.GetChainId()),
+			RequestID: v.GetRequestId(),
+			Deadline:  v.GetDeadline(),
+			AppBytes:  v.GetAppBytes(),
+		}
+	case *p2ppb.Message_AppResponse:
+		v := m.AppResponse
+		op, fields = AppResponse, map[Field]interface{}{
+			ChainID:   p.hash(v.GetChainId()),
+			RequestID: v.GetRequestId(),
+			AppBytes:  v.GetAppBytes(),
+		}
+	case *p2ppb.Message_AppGossip:
+		v := m.AppGossip
+		op, fields = AppGossip, map[Field]interface{}{
+			ChainID:  p.hash(v.GetChainId()),
+			AppBytes: v.GetAppBytes(),
+		}
+	default:
+		return 0, nil, errUnknownProtoMessage
+	}
+	return op, fields, p.err
+}
@@ -0,0 +1,449 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/units"
+
+	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
+)
+
+// testMessages returns a message of every external op
+func testMessages(t *testing.T) []inboundMessage {
+	id := ids.GenerateTestID()
+	tlsCert, err := staking.NewTLSCert()
+	assert.NoError(t, err)
+
+	return []inboundMessage{
+		{
+			op: Version,
+			fields: map[Field]interface{}{
+				NetworkID:      uint32(1),
+				NodeID:         uint32(0),
+				MyTime:         uint64(1337),
+				IP:             ips.IPPort{IP: net.IPv4(1, 2, 3, 4), Port: 9651},
+				VersionStr:     "avalanche/1.2.3",
+				VersionTime:    uint64(1338),
+				SigBytes:       []byte{'y', 'e', 'e', 't'},
+				TrackedSubnets: [][]byte{id[:]},
+			},
+		},
+		{
+			op: PeerList,
+			fields: map[Field]interface{}{
+				Peers: []ips.ClaimedIPPort{
+					{
+						Cert:      tlsCert.Leaf,
+						IPPort:    ips.IPPort{IP: net.IPv6loopback, Port: 9651},
+						Timestamp: uint64(1337),
+						Signature: make([]byte, 65),
+					},
+				},
+			},
+		},
+		{
+			op:     Ping,
+			fields: map[Field]interface{}{},
+		},
+		{
+			op: Pong,
+			fields: map[Field]interface{}{
+				Uptime: uint8(80),
+			},
+		},
+		{
+			op: Capabilities,
+			fields: map[Field]interface{}{
+				CapabilityStrs: []string{"proto"},
+			},
+		},
+		{
+			op: GetStateSummaryFrontier,
+			fields: map[Field]interface{}{
+				ChainID:   id[:],
+				RequestID: uint32(1337),
+				Deadline:  uint64(time.Second),
+			},
+		},
+		{
+			op: StateSummaryFrontier,
+			fields: map[Field]interface{}{
+				ChainID:      id[:],
+				RequestID:    uint32(1337),
+				SummaryBytes: []byte{1, 2, 3},
+			},
+		},
+		{
+			op: GetAcceptedStateSummary,
+			fields: map[Field]interface{}{
+				ChainID:        id[:],
+				RequestID:      uint32(1337),
+				Deadline:       uint64(time.Second),
+				SummaryHeights: []uint64{1, 2},
+			},
+		},
+		{
+			op: AcceptedStateSummary,
+			fields: map[Field]interface{}{
+				ChainID:    id[:],
+				RequestID:  uint32(1337),
+				SummaryIDs: [][]byte{id[:]},
+			},
+		},
+		{
+			op: GetAcceptedFrontier,
+			fields: map[Field]interface{}{
+				ChainID:   id[:],
+				RequestID: uint32(1337),
+				Deadline:  uint64(time.Second),
+			},
+		},
+		{
+			op: AcceptedFrontier,
+			fields: map[Field]interface{}{
+				ChainID:      id[:],
+				RequestID:    uint32(1337),
+				ContainerIDs: [][]byte{id[:]},
+			},
+		},
+		{
+			op: GetAccepted,
+			fields: map[Field]interface{}{
+				ChainID:      id[:],
+				RequestID:    uint32(1337),
+				Deadline:     uint64(time.Second),
+				ContainerIDs: [][]byte{id[:]},
+			},
+		},
+		{
+			op: Accepted,
+			fields: map[Field]interface{}{
+				ChainID:      id[:],
+				RequestID:    uint32(1337),
+				ContainerIDs: [][]byte{id[:]},
+			},
+		},
+		{
+			op: GetAncestors,
+			fields: map[Field]interface{}{
+				ChainID:     id[:],
+				RequestID:   uint32(1337),
+				Deadline:    uint64(time.Second),
+				ContainerID: id[:],
+			},
+		},
+		{
+			op: Ancestors,
+			fields: map[Field]interface{}{
+				ChainID:             id[:],
+				RequestID:           uint32(1337),
+				MultiContainerBytes: [][]byte{id[:]},
+			},
+		},
+		{
+			op: Get,
+			fields: map[Field]interface{}{
+				ChainID:     id[:],
+				RequestID:   uint32(1337),
+				Deadline:    uint64(time.Second),
+				ContainerID: id[:],
+			},
+		},
+		{
+			op: Put,
+			fields: map[Field]interface{}{
+				ChainID:        id[:],
+				RequestID:      uint32(1337),
+				ContainerID:    id[:],
+				ContainerBytes: make([]byte, 1024),
+			},
+		},
+		{
+			op: PushQuery,
+			fields: map[Field]interface{}{
+				ChainID:        id[:],
+				RequestID:      uint32(1337),
+				Deadline:       uint64(time.Second),
+				ContainerID:    id[:],
+				ContainerBytes: make([]byte, 1024),
+			},
+		},
+		{
+			op: PullQuery,
+			fields: map[Field]interface{}{
+				ChainID:     id[:],
+				RequestID:   uint32(1337),
+				Deadline:    uint64(time.Second),
+				ContainerID: id[:],
+			},
+		},
+		{
+			op: Chits,
+			fields: map[Field]interface{}{
+				ChainID:      id[:],
+				RequestID:    uint32(1337),
+				ContainerIDs: [][]byte{id[:]},
+			},
+		},
+		{
+			op: AppRequest,
+			fields: map[Field]interface{}{
+				ChainID:   id[:],
+				RequestID: uint32(1337),
+				Deadline:  uint64(time.Second),
+				AppBytes:  []byte{1, 2, 3},
+			},
+		},
+		{
+			op: AppResponse,
+			fields: map[Field]interface{}{
+				ChainID:   id[:],
+				RequestID: uint32(1337),
+				AppBytes:  []byte{1, 2, 3},
+			},
+		},
+		{
+			op: AppGossip,
+			fields: map[Field]interface{}{
+				ChainID:  id[:],
+				AppBytes: []byte{1, 2, 3},
+			},
+		},
+	}
+}
+
+func TestTestMessagesCoverExternalOps(t *testing.T) {
+	ops := make(map[Op]struct{})
+	for _, m := range testMessages(t) {
+		ops[m.op] = struct{}{}
+	}
+	for _, op := range ExternalOps {
+		assert.Contains(t, ops, op)
+	}
+}
+
+func TestLegacyOpsDontSetProtoBit(t *testing.T) {
+	for _, op := range ExternalOps {
+		assert.False(t, IsProto([]byte{byte(op)}), "op %s", op)
+	}
+}
+
+func TestProtoCodecPackParse(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := NewCodecWithMemoryPool("", prometheus.NewRegistry(), 2*units.MiB, 10*time.Second)
+	assert.NoError(err)
+	c.SetTime(time.Unix(1000, 0))
+
+	compressionTypes := []compression.Type{
+		compression.TypeNone,
+		compression.TypeGzip,
+		compression.TypeZstd,
+	}
+	for _, compressionType := range compressionTypes {
+		for _, m := range testMessages(t) {
+			compress := compressionType != compression.TypeNone && m.op.Compressible()
+			msg, err := c.Pack(m.op, m.fields, compress, false)
+			assert.NoError(err, "failed to pack %s", m.op)
+
+			legacyBytes, _, err := msg.EncodedBytes(PackerEncoding, compressionType)
+			assert.NoError(err, "failed to encode legacy %s", m.op)
+			assert.False(IsProto(legacyBytes))
+			if compressionType != compression.TypeZstd {
+				assert.Equal(msg.Bytes(), legacyBytes)
+			}
+
+			protoBytes, _, err := msg.EncodedBytes(ProtoEncoding, compressionType)
+			assert.NoError(err, "failed to encode %s", m.op)
+			assert.True(IsProto(protoBytes))
+
+			// The re-encoded message is cached
+			cachedProtoBytes, _, err := msg.EncodedBytes(ProtoEncoding, compressionType)
+			assert.NoError(err)
+			assert.Equal(protoBytes, cachedProtoBytes)
+
+			legacyMsg, err := c.Parse(legacyBytes, dummyNodeID, dummyOnFinishedHandling)
+			assert.NoError(err, "failed to parse legacy %s", m.op)
+			protoMsg, err := c.Parse(protoBytes, dummyNodeID, dummyOnFinishedHandling)
+			assert.NoError(err, "failed to parse proto %s", m.op)
+
+			assert.Equal(m.op, protoMsg.Op())
+			assert.Equal(legacyMsg.ExpirationTime(), protoMsg.ExpirationTime())
+			assert.Equal(legacyMsg.(*inboundMessage).fields, protoMsg.(*inboundMessage).fields, "op %s", m.op)
+		}
+	}
+}
+
+func TestProtoCodec(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := NewProtoCodec("", prometheus.NewRegistry(), 2*units.MiB, 10*time.Second)
+	assert.NoError(err)
+
+	id := ids.GenerateTestID()
+	fields := map[Field]interface{}{
+		ChainID:        id[:],
+		RequestID:      uint32(1337),
+		ContainerID:    id[:],
+		ContainerBytes: make([]byte, 1024),
+	}
+	msg, err := c.Pack(Put, fields, true, false)
+	assert.NoError(err)
+	assert.True(IsProto(msg.Bytes()))
+	assert.Positive(msg.BytesSavedCompression())
+
+	_, _, err = msg.EncodedBytes(PackerEncoding, compression.TypeGzip)
+	assert.ErrorIs(err, errUnsupportedEncoding)
+
+	zstdBytes, bytesSaved, err := msg.EncodedBytes(ProtoEncoding, compression.TypeZstd)
+	assert.NoError(err)
+	assert.Positive(bytesSaved)
+
+	parsedMsg, err := c.Parse(zstdBytes, dummyNodeID, dummyOnFinishedHandling)
+	assert.NoError(err)
+	assert.Equal(Put, parsedMsg.Op())
+	assert.Equal(fields, parsedMsg.(*inboundMessage).fields)
+	assert.Equal(bytesSaved, parsedMsg.BytesSavedCompression())
+
+	parsedMsg, err = c.Parse(msg.Bytes(), dummyNodeID, dummyOnFinishedHandling)
+	assert.NoError(err)
+	assert.Equal(Put, parsedMsg.Op())
+	assert.Equal(fields, parsedMsg.(*inboundMessage).fields)
+
+	// Releasing a message that wasn't taken from a pool is a no-op
+	msg.DecRef()
+
+	_, err = c.Pack(Put, map[Field]interface{}{}, false, false)
+	assert.ErrorIs(err, errMissingField)
+
+	fields[RequestID] = uint64(1337)
+	_, err = c.Pack(Put, fields, false, false)
+	assert.ErrorIs(err, errInvalidFieldType)
+}
+
+func TestProtoCodecParseInvalid(t *testing.T) {
+	c, err := NewProtoCodec("", prometheus.NewRegistry(), 2*units.MiB, 10*time.Second)
+	assert.NoError(t, err)
+
+	marshal := func(msg proto.Message) []byte {
+		bytes, err := proto.Marshal(msg)
+		assert.NoError(t, err)
+		return bytes
+	}
+	compressors := c.(*protoCodec).compressors
+	compress := func(bytes []byte) []byte {
+		compressedBytes, err := compressors.compress(compression.TypeGzip, bytes)
+		assert.NoError(t, err)
+		return compressedBytes
+	}
+	ping := marshal(&p2ppb.Message{
+		Message: &p2ppb.Message_Ping{
+			Ping: &p2ppb.Ping{},
+		},
+	})
+	compressedPut := marshal(&p2ppb.Message{
+		Message: &p2ppb.Message_CompressedGzip{
+			CompressedGzip: compress(marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_Put{
+					Put: &p2ppb.Put{},
+				},
+			})),
+		},
+	})
+
+	tests := map[string]struct {
+		bytes       []byte
+		expectedErr error
+	}{
+		"empty message": {
+			bytes:       marshal(&p2ppb.Message{}),
+			expectedErr: errUnknownProtoMessage,
+		},
+		"short chain ID": {
+			bytes: marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_Get{
+					Get: &p2ppb.Get{
+						ChainId:     []byte{1},
+						ContainerId: make([]byte, 32),
+					},
+				},
+			}),
+			expectedErr: errInvalidHashLen,
+		},
+		"short IP": {
+			bytes: marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_Version{
+					Version: &p2ppb.Version{
+						IpAddr: []byte{1, 2, 3, 4},
+					},
+				},
+			}),
+			expectedErr: errInvalidIPLen,
+		},
+		"invalid port": {
+			bytes: marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_Version{
+					Version: &p2ppb.Version{
+						IpAddr: net.IPv6loopback,
+						IpPort: 1 << 16,
+					},
+				},
+			}),
+			expectedErr: errInvalidPort,
+		},
+		"invalid uptime": {
+			bytes: marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_Pong{
+					Pong: &p2ppb.Pong{
+						UptimePct: 256,
+					},
+				},
+			}),
+			expectedErr: errInvalidUptime,
+		},
+		"compressed ping": {
+			bytes: marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_CompressedGzip{
+					CompressedGzip: compress(ping),
+				},
+			}),
+			expectedErr: errUncompressibleOp,
+		},
+		"nested compression": {
+			bytes: marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_CompressedGzip{
+					CompressedGzip: compress(compressedPut),
+				},
+			}),
+			expectedErr: errNestedCompression,
+		},
+		"zstd compressed gzip": {
+			bytes: marshal(&p2ppb.Message{
+				Message: &p2ppb.Message_CompressedZstd{
+					CompressedZstd: compress(ping),
+				},
+			}),
+			expectedErr: zstd.ErrMagicMismatch,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := c.Parse(test.bytes, dummyNodeID, dummyOnFinishedHandling)
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
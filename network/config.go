@@ -10,6 +10,8 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
@@ -94,6 +96,7 @@ type ThrottlerConfig struct {
 	InboundConnUpgradeThrottlerConfig throttling.InboundConnUpgradeThrottlerConfig `json:"inboundConnUpgradeThrottlerConfig"`
 	InboundMsgThrottlerConfig         throttling.InboundMsgThrottlerConfig         `json:"inboundMsgThrottlerConfig"`
 	OutboundMsgThrottlerConfig        throttling.MsgByteThrottlerConfig            `json:"outboundMsgThrottlerConfig"`
+	OutboundBandwidthThrottlerConfig  throttling.OutboundBandwidthThrottlerConfig  `json:"outboundBandwidthThrottlerConfig"`
 	MaxInboundConnsPerSec             float64                                      `json:"maxInboundConnsPerSec"`
 }
 
@@ -107,6 +110,19 @@ type Config struct {
 	DialerConfig dialer.Config `json:"dialerConfig"`
 	TLSConfig    *tls.Config   `json:"-"`
 
+	// PeerFilterConfig specifies the NodeIDs and IPs that this node is
+	// allowed to connect to.
+	PeerFilterConfig peerfilter.Config `json:"peerFilterConfig"`
+
+	// CaptureConfig specifies where the messages sent to and received from
+	// peers are recorded. If the path is empty, messages aren't recorded.
+	CaptureConfig peer.RecorderConfig `json:"captureConfig"`
+
+	// PeerMetricsMaxPeers is the maximum number of peers whose messages are
+	// reported in metrics labelled by their NodeID. If 0, messages aren't
+	// reported per peer.
+	PeerMetricsMaxPeers int `json:"peerMetricsMaxPeers"`
+
 	Namespace          string            `json:"namespace"`
 	MyNodeID           ids.NodeID        `json:"myNodeID"`
 	MyIPPort           ips.DynamicIPPort `json:"myIP"`
@@ -115,10 +131,22 @@ type Config struct {
 	PingFrequency      time.Duration     `json:"pingFrequency"`
 	AllowPrivateIPs    bool              `json:"allowPrivateIPs"`
 
+	// MyIPv6Port is the IPv6 address that this node advertises in addition to
+	// [MyIPPort]. If nil, only [MyIPPort] is advertised.
+	MyIPv6Port ips.DynamicIPPort `json:"myIPv6"`
+
 	// CompressionEnabled will compress available outbound messages when set to
 	// true.
 	CompressionEnabled bool `json:"compressionEnabled"`
 
+	// ProtoCodecEnabled will send protobuf encoded messages to peers that
+	// advertise support for them when set to true.
+	ProtoCodecEnabled bool `json:"protoCodecEnabled"`
+
+	// ZstdEnabled will compress available outbound messages with zstd, rather
+	// than gzip, for peers that advertise support for it when set to true.
+	ZstdEnabled bool `json:"zstdEnabled"`
+
 	// TLSKey is this node's TLS key that is used to sign IPs.
 	TLSKey crypto.Signer `json:"-"`
 
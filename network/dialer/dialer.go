@@ -24,7 +24,7 @@ type Dialer interface {
 }
 
 type dialer struct {
-	dialer    net.Dialer
+	dialer    ContextDialer
 	log       logging.Logger
 	network   string
 	throttler throttling.DialThrottler
@@ -33,6 +33,7 @@ type dialer struct {
 type Config struct {
 	ThrottleRps       uint32        `json:"throttleRps"`
 	ConnectionTimeout time.Duration `json:"connectionTimeout"`
+	ProxyConfig       ProxyConfig   `json:"proxyConfig"`
 }
 
 // NewDialer returns a new Dialer that calls net.Dial with the provided network.
@@ -40,7 +41,13 @@ type Config struct {
 // [dialerConfig.connectionTimeout] gives the timeout when dialing an IP.
 // [dialerConfig.throttleRps] gives the max number of outgoing connection attempts/second.
 // If [dialerConfig.throttleRps] == 0, outgoing connections aren't rate-limited.
-func NewDialer(network string, dialerConfig Config, log logging.Logger) Dialer {
+// If [dialerConfig.proxyConfig] has an address, connections are made through
+// that SOCKS5 proxy.
+func NewDialer(network string, dialerConfig Config, log logging.Logger) (Dialer, error) {
+	contextDialer, err := NewContextDialer(dialerConfig.ConnectionTimeout, dialerConfig.ProxyConfig)
+	if err != nil {
+		return nil, err
+	}
 	var throttler throttling.DialThrottler
 	if dialerConfig.ThrottleRps <= 0 {
 		throttler = throttling.NewNoDialThrottler()
@@ -52,12 +59,15 @@ func NewDialer(network string, dialerConfig Config, log logging.Logger) Dialer {
 		dialerConfig.ThrottleRps,
 		dialerConfig.ConnectionTimeout,
 	)
+	if dialerConfig.ProxyConfig.Address != "" {
+		log.Info("dialer connects through the SOCKS5 proxy at %s", dialerConfig.ProxyConfig.Address)
+	}
 	return &dialer{
-		dialer:    net.Dialer{Timeout: dialerConfig.ConnectionTimeout},
+		dialer:    contextDialer,
 		log:       log,
 		network:   network,
 		throttler: throttler,
-	}
+	}, nil
 }
 
 func (d *dialer) Dial(ctx context.Context, ip ips.IPPort) (net.Conn, error) {
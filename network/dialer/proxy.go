@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dialer
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+var (
+	_ ContextDialer = &net.Dialer{}
+	_ ContextDialer = &socks5Dialer{}
+)
+
+// ContextDialer connects to an address on the named network
+type ContextDialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+type ProxyConfig struct {
+	// Address of the SOCKS5 proxy that outbound connections are made through.
+	// If empty, outbound connections are made directly.
+	Address string `json:"address"`
+
+	// Username to authenticate with the proxy. If empty, the proxy is used
+	// without authentication.
+	Username string `json:"username"`
+
+	// Password to authenticate with the proxy
+	Password string `json:"-"`
+}
+
+// NewContextDialer returns a ContextDialer that connects through the proxy in
+// [proxyConfig]. If no proxy is provided, connections are made directly.
+// [timeout] gives the timeout of establishing a connection, including the
+// proxy handshake. If [timeout] == 0, connections don't time out.
+func NewContextDialer(timeout time.Duration, proxyConfig ProxyConfig) (ContextDialer, error) {
+	direct := &net.Dialer{Timeout: timeout}
+	if proxyConfig.Address == "" {
+		return direct, nil
+	}
+
+	var auth *proxy.Auth
+	if proxyConfig.Username != "" {
+		auth = &proxy.Auth{
+			User:     proxyConfig.Username,
+			Password: proxyConfig.Password,
+		}
+	}
+	d, err := proxy.SOCKS5("tcp", proxyConfig.Address, auth, direct)
+	if err != nil {
+		return nil, err
+	}
+	return &socks5Dialer{
+		dialer:  d.(proxy.ContextDialer),
+		timeout: timeout,
+	}, nil
+}
+
+type socks5Dialer struct {
+	dialer  proxy.ContextDialer
+	timeout time.Duration
+}
+
+func (d *socks5Dialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+	conn, err := d.dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// The remote address of the connection is the address of the proxy. If
+	// the dialed address is an IP, it's reported as the remote address
+	// instead so that the connection is attributed to the dialed peer.
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return conn, nil
+	}
+	ip := net.ParseIP(host)
+	port, err := strconv.Atoi(portStr)
+	if ip == nil || err != nil {
+		return conn, nil
+	}
+	return &proxiedConn{
+		Conn: conn,
+		remoteAddr: &net.TCPAddr{
+			IP:   ip,
+			Port: port,
+		},
+	}, nil
+}
+
+type proxiedConn struct {
+	net.Conn
+	remoteAddr net.Addr
+}
+
+func (c *proxiedConn) RemoteAddr() net.Addr { return c.remoteAddr }
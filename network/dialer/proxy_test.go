@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dialer
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// testSOCKS5Server is a minimal SOCKS5 proxy that supports the CONNECT command
+// and, optionally, username/password authentication
+type testSOCKS5Server struct {
+	listener           net.Listener
+	username, password string
+
+	lock sync.Mutex
+	// Addresses that clients asked to connect to
+	targets []string
+}
+
+func newTestSOCKS5Server(t *testing.T, username, password string) *testSOCKS5Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:")
+	assert.NoError(t, err)
+
+	s := &testSOCKS5Server{
+		listener: listener,
+		username: username,
+		password: password,
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+	t.Cleanup(func() { _ = listener.Close() })
+	return s
+}
+
+func (s *testSOCKS5Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	// Method negotiation
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return
+	}
+	if s.username == "" {
+		if _, err := conn.Write([]byte{5, 0}); err != nil {
+			return
+		}
+	} else {
+		if _, err := conn.Write([]byte{5, 2}); err != nil {
+			return
+		}
+		// Username/password authentication
+		usernameLen := make([]byte, 2)
+		if _, err := io.ReadFull(conn, usernameLen); err != nil {
+			return
+		}
+		username := make([]byte, usernameLen[1])
+		if _, err := io.ReadFull(conn, username); err != nil {
+			return
+		}
+		passwordLen := make([]byte, 1)
+		if _, err := io.ReadFull(conn, passwordLen); err != nil {
+			return
+		}
+		password := make([]byte, passwordLen[0])
+		if _, err := io.ReadFull(conn, password); err != nil {
+			return
+		}
+		if string(username) != s.username || string(password) != s.password {
+			_, _ = conn.Write([]byte{1, 1})
+			return
+		}
+		if _, err := conn.Write([]byte{1, 0}); err != nil {
+			return
+		}
+	}
+
+	// Connect request
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return
+	}
+	var host string
+	switch request[3] {
+	case 1, 4:
+		ipLen := net.IPv4len
+		if request[3] == 4 {
+			ipLen = net.IPv6len
+		}
+		ip := make([]byte, ipLen)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return
+		}
+		host = net.IP(ip).String()
+	case 3:
+		hostLen := make([]byte, 1)
+		if _, err := io.ReadFull(conn, hostLen); err != nil {
+			return
+		}
+		hostBytes := make([]byte, hostLen[0])
+		if _, err := io.ReadFull(conn, hostBytes); err != nil {
+			return
+		}
+		host = string(hostBytes)
+	default:
+		return
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return
+	}
+	target := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+
+	s.lock.Lock()
+	s.targets = append(s.targets, target)
+	s.lock.Unlock()
+
+	targetConn, err := net.Dial("tcp", target)
+	if err != nil {
+		_, _ = conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+		return
+	}
+	defer targetConn.Close()
+	if _, err := conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+		return
+	}
+
+	go func() {
+		_, _ = io.Copy(targetConn, conn)
+	}()
+	_, _ = io.Copy(conn, targetConn)
+}
+
+func (s *testSOCKS5Server) Targets() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string(nil), s.targets...)
+}
+
+// newEchoListener returns the IP of a listener that echoes back everything
+// that it reads
+func newEchoListener(t *testing.T) ips.IPPort {
+	listener, err := net.Listen("tcp", "127.0.0.1:")
+	assert.NoError(t, err)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	t.Cleanup(func() { _ = listener.Close() })
+
+	ip, err := ips.ToIPPort(listener.Addr().String())
+	assert.NoError(t, err)
+	return ip
+}
+
+func TestDialerProxy(t *testing.T) {
+	assert := assert.New(t)
+
+	target := newEchoListener(t)
+	proxy := newTestSOCKS5Server(t, "user", "pass")
+
+	dialer, err := NewDialer(
+		"tcp",
+		Config{
+			ThrottleRps:       10,
+			ConnectionTimeout: 30 * time.Second,
+			ProxyConfig: ProxyConfig{
+				Address:  proxy.listener.Addr().String(),
+				Username: "user",
+				Password: "pass",
+			},
+		},
+		logging.NoLog{},
+	)
+	assert.NoError(err)
+
+	conn, err := dialer.Dial(context.Background(), target)
+	assert.NoError(err)
+	defer conn.Close()
+
+	// The connection is attributed to the target rather than the proxy
+	remoteIP, err := ips.ToIPPort(conn.RemoteAddr().String())
+	assert.NoError(err)
+	assert.Equal(target, remoteIP)
+	assert.Equal([]string{target.String()}, proxy.Targets())
+
+	msg := []byte("hello")
+	_, err = conn.Write(msg)
+	assert.NoError(err)
+	echoed := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, echoed)
+	assert.NoError(err)
+	assert.Equal(msg, echoed)
+}
+
+func TestDialerProxyAuthFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	target := newEchoListener(t)
+	proxy := newTestSOCKS5Server(t, "user", "pass")
+
+	dialer, err := NewDialer(
+		"tcp",
+		Config{
+			ConnectionTimeout: 30 * time.Second,
+			ProxyConfig: ProxyConfig{
+				Address:  proxy.listener.Addr().String(),
+				Username: "user",
+				Password: "wrong",
+			},
+		},
+		logging.NoLog{},
+	)
+	assert.NoError(err)
+
+	_, err = dialer.Dial(context.Background(), target)
+	assert.Error(err)
+	assert.Empty(proxy.Targets())
+}
+
+func TestContextDialerProxyHostname(t *testing.T) {
+	assert := assert.New(t)
+
+	target := newEchoListener(t)
+	proxy := newTestSOCKS5Server(t, "", "")
+
+	dialer, err := NewContextDialer(30*time.Second, ProxyConfig{
+		Address: proxy.listener.Addr().String(),
+	})
+	assert.NoError(err)
+
+	// Hostnames are resolved by the proxy
+	address := net.JoinHostPort("localhost", strconv.Itoa(int(target.Port)))
+	conn, err := dialer.DialContext(context.Background(), "tcp", address)
+	assert.NoError(err)
+	defer conn.Close()
+
+	assert.Equal([]string{address}, proxy.Targets())
+}
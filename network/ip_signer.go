@@ -13,25 +13,31 @@ import (
 )
 
 // ipSigner will return a signedIP for the current value of our dynamic IP.
+// If this node also advertises an IPv6 address, the IPv6 address is signed
+// alongside it.
 type ipSigner struct {
-	ip     ips.DynamicIPPort
+	ip ips.DynamicIPPort
+	// ipv6 is nil if this node only advertises [ip]
+	ipv6   ips.DynamicIPPort
 	clock  *mockable.Clock
 	signer crypto.Signer
 
-	// Must be held while accessing [signedIP]
+	// Must be held while accessing [signedIPs]
 	signedIPLock sync.RWMutex
-	// Note that the values in [*signedIP] are constants and can be inspected
+	// Note that the values in [signedIPs] are constants and can be inspected
 	// without holding [signedIPLock].
-	signedIP *peer.SignedIP
+	signedIPs []*peer.SignedIP
 }
 
 func newIPSigner(
 	ip ips.DynamicIPPort,
+	ipv6 ips.DynamicIPPort,
 	clock *mockable.Clock,
 	signer crypto.Signer,
 ) *ipSigner {
 	return &ipSigner{
 		ip:     ip,
+		ipv6:   ipv6,
 		clock:  clock,
 		signer: signer,
 	}
@@ -43,38 +49,78 @@ func newIPSigner(
 //
 // It's safe for multiple goroutines to concurrently call getSignedIP.
 func (s *ipSigner) getSignedIP() (*peer.SignedIP, error) {
-	// Optimistically, the IP should already be signed. By grabbing a read lock
-	// here we enable full concurrency of new connections.
+	signedIPs, err := s.getSignedIPs()
+	if err != nil {
+		return nil, err
+	}
+	return signedIPs[0], nil
+}
+
+// getSignedIPs returns the signedIPs of the current values of the provided
+// dynamicIPs. The signedIP of [ip] is first, followed by the signedIP of
+// [ipv6] if it was provided. All the IPs are signed with the same timestamp,
+// so that peers that only track a single IP per node don't replace one with
+// the other.
+//
+// It's safe for multiple goroutines to concurrently call getSignedIPs.
+func (s *ipSigner) getSignedIPs() ([]*peer.SignedIP, error) {
+	// Optimistically, the IPs should already be signed. By grabbing a read
+	// lock here we enable full concurrency of new connections.
 	s.signedIPLock.RLock()
-	signedIP := s.signedIP
+	signedIPs := s.signedIPs
 	s.signedIPLock.RUnlock()
-	ip := s.ip.IPPort()
-	if signedIP != nil && signedIP.IP.IP.Equal(ip) {
-		return signedIP, nil
+	currentIPs := s.currentIPs()
+	if isSigned(signedIPs, currentIPs) {
+		return signedIPs, nil
 	}
 
-	// If our current IP hasn't been signed yet - then we should sign it.
+	// If our current IPs haven't been signed yet - then we should sign them.
 	s.signedIPLock.Lock()
 	defer s.signedIPLock.Unlock()
 
-	// It's possible that multiple threads read [n.signedIP] as incorrect at the
-	// same time, we should verify that we are the first thread to attempt to
-	// update it.
-	signedIP = s.signedIP
-	if signedIP != nil && signedIP.IP.IP.Equal(ip) {
-		return signedIP, nil
+	// It's possible that multiple threads read [s.signedIPs] as incorrect at
+	// the same time, we should verify that we are the first thread to attempt
+	// to update them.
+	signedIPs = s.signedIPs
+	if isSigned(signedIPs, currentIPs) {
+		return signedIPs, nil
 	}
 
-	// We should now sign our new IP at the current timestamp.
-	unsignedIP := peer.UnsignedIP{
-		IP:        ip,
-		Timestamp: s.clock.Unix(),
+	// We should now sign our new IPs at the current timestamp.
+	timestamp := s.clock.Unix()
+	signedIPs = make([]*peer.SignedIP, len(currentIPs))
+	for i, ip := range currentIPs {
+		unsignedIP := peer.UnsignedIP{
+			IP:        ip,
+			Timestamp: timestamp,
+		}
+		signedIP, err := unsignedIP.Sign(s.signer)
+		if err != nil {
+			return nil, err
+		}
+		signedIPs[i] = signedIP
 	}
-	signedIP, err := unsignedIP.Sign(s.signer)
-	if err != nil {
-		return nil, err
+
+	s.signedIPs = signedIPs
+	return s.signedIPs, nil
+}
+
+func (s *ipSigner) currentIPs() []ips.IPPort {
+	if s.ipv6 == nil {
+		return []ips.IPPort{s.ip.IPPort()}
 	}
+	return []ips.IPPort{s.ip.IPPort(), s.ipv6.IPPort()}
+}
 
-	s.signedIP = signedIP
-	return s.signedIP, nil
+// isSigned returns true if [signedIPs] are the signatures of [ipPorts]
+func isSigned(signedIPs []*peer.SignedIP, ipPorts []ips.IPPort) bool {
+	if len(signedIPs) != len(ipPorts) {
+		return false
+	}
+	for i, ip := range ipPorts {
+		if !signedIPs[i].IP.IP.Equal(ip) {
+			return false
+		}
+	}
+	return true
 }
@@ -31,7 +31,7 @@ func TestIPSigner(t *testing.T) {
 
 	key := tlsCert.PrivateKey.(crypto.Signer)
 
-	s := newIPSigner(dynIP, &clock, key)
+	s := newIPSigner(dynIP, nil, &clock, key)
 
 	signedIP1, err := s.getSignedIP()
 	assert.NoError(err)
@@ -54,3 +54,50 @@ func TestIPSigner(t *testing.T) {
 	assert.EqualValues(11, signedIP3.IP.Timestamp)
 	assert.NotEqualValues(signedIP2.Signature, signedIP3.Signature)
 }
+
+func TestIPSignerDualStack(t *testing.T) {
+	assert := assert.New(t)
+
+	dynIP := ips.NewDynamicIPPort(
+		net.IPv4(1, 2, 3, 4),
+		0,
+	)
+	dynIPv6 := ips.NewDynamicIPPort(
+		net.IPv6loopback,
+		0,
+	)
+	clock := mockable.Clock{}
+	clock.Set(time.Unix(10, 0))
+
+	tlsCert, err := staking.NewTLSCert()
+	assert.NoError(err)
+
+	key := tlsCert.PrivateKey.(crypto.Signer)
+
+	s := newIPSigner(dynIP, dynIPv6, &clock, key)
+
+	signedIPs1, err := s.getSignedIPs()
+	assert.NoError(err)
+	assert.Len(signedIPs1, 2)
+	assert.EqualValues(dynIP.IPPort(), signedIPs1[0].IP.IP)
+	assert.EqualValues(dynIPv6.IPPort(), signedIPs1[1].IP.IP)
+	assert.EqualValues(10, signedIPs1[0].IP.Timestamp)
+	assert.EqualValues(10, signedIPs1[1].IP.Timestamp)
+
+	signedIP, err := s.getSignedIP()
+	assert.NoError(err)
+	assert.Equal(signedIPs1[0], signedIP)
+
+	clock.Set(time.Unix(11, 0))
+
+	// Changing either IP re-signs both of them
+	dynIPv6.SetIP(net.ParseIP("2001:db8::1"))
+
+	signedIPs2, err := s.getSignedIPs()
+	assert.NoError(err)
+	assert.EqualValues(dynIP.IPPort(), signedIPs2[0].IP.IP)
+	assert.EqualValues(dynIPv6.IPPort(), signedIPs2[1].IP.IP)
+	assert.EqualValues(11, signedIPs2[0].IP.Timestamp)
+	assert.EqualValues(11, signedIPs2[1].IP.Timestamp)
+	assert.NotEqualValues(signedIPs1[0].Signature, signedIPs2[0].Signature)
+}
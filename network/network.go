@@ -5,6 +5,7 @@ package network
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
@@ -22,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
@@ -46,6 +48,7 @@ var (
 	_                      sender.ExternalSender = &network{}
 	_                      Network               = &network{}
 	errNoPrimaryValidators                       = errors.New("no default subnet validators")
+	errNoCertificate                             = errors.New("no TLS certificate")
 )
 
 // Network defines the functionality of the networking library.
@@ -78,11 +81,35 @@ type Network interface {
 	// connect to this ID.
 	ManuallyTrack(nodeID ids.NodeID, ip ips.IPPort)
 
+	// DisconnectPeer closes the connection to [nodeID]. Returns false if this
+	// node isn't connected, or connecting, to [nodeID]. The peer may reconnect
+	// afterwards, unless it is banned.
+	DisconnectPeer(nodeID ids.NodeID) bool
+
+	// BanPeer disconnects from [nodeID] and refuses connections to and from
+	// [nodeID] for [duration]. If [nodeID] is already banned, the ban is
+	// replaced.
+	BanPeer(nodeID ids.NodeID, duration time.Duration)
+
+	// UnbanPeer lifts the ban of [nodeID]. Returns false if [nodeID] isn't
+	// banned.
+	UnbanPeer(nodeID ids.NodeID) bool
+
 	// PeerInfo returns information about peers. If [nodeIDs] is empty, returns
 	// info about all peers that have finished the handshake. Otherwise, returns
 	// info about the peers in [nodeIDs] that have finished the handshake.
 	PeerInfo(nodeIDs []ids.NodeID) []peer.Info
 
+	// PeerStats returns the messages that were sent to and received from
+	// peers. If [nodeIDs] is empty, returns the stats of all peers that have
+	// finished the handshake. Otherwise, returns the stats of the peers in
+	// [nodeIDs] that have finished the handshake.
+	PeerStats(nodeIDs []ids.NodeID) []peer.Stats
+
+	// PeerFilter returns the filter of the NodeIDs and IPs that this node is
+	// allowed to connect to. The filter can be modified at runtime.
+	PeerFilter() peerfilter.Filter
+
 	NodeUptime() (UptimeResult, bool)
 }
 
@@ -98,11 +125,17 @@ type network struct {
 	// Signs my IP so I can send my signed IP address to other nodes in Version
 	// messages
 	ipSigner *ipSigner
+	// myCert is the certificate that my IPv6 address is claimed with. nil if
+	// this node doesn't advertise an IPv6 address in addition to its IP.
+	myCert *x509.Certificate
 
 	outboundMsgThrottler throttling.OutboundMsgThrottler
 
 	// Limits the number of connection attempts based on IP.
 	inboundConnUpgradeThrottler throttling.InboundConnUpgradeThrottler
+	// Rejects peers that are denied, or not allowed, by the operator. Enforced
+	// when dialing, when upgrading connections and when tracking gossiped IPs.
+	peerFilter peerfilter.Filter
 	// Listens for and accepts new inbound connections
 	listener net.Listener
 	// Makes new outbound connections
@@ -128,9 +161,13 @@ type network struct {
 	// finished the handshake.
 	trackedIPs         map[ids.NodeID]*trackedIP
 	manuallyTrackedIDs ids.NodeIDSet
-	connectingPeers    peer.Set
-	connectedPeers     peer.Set
-	closing            bool
+	// bannedUntil maps the nodeIDs that were banned with [BanPeer] to the time
+	// that their ban expires. Expired bans are pruned whenever a peer is
+	// banned.
+	bannedUntil     map[ids.NodeID]time.Time
+	connectingPeers peer.Set
+	connectedPeers  peer.Set
+	closing         bool
 
 	// router is notified about all peer [Connected] and [Disconnected] events
 	// as well as all non-handshake peer messages.
@@ -189,6 +226,16 @@ func NewNetwork(
 		return nil, fmt.Errorf("initializing outbound message throttler failed with: %w", err)
 	}
 
+	outboundBandwidthThrottler, err := throttling.NewOutboundBandwidthThrottler(
+		log,
+		config.Namespace,
+		metricsRegisterer,
+		config.ThrottlerConfig.OutboundBandwidthThrottlerConfig,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("initializing outbound bandwidth throttler failed with: %w", err)
+	}
+
 	peerMetrics, err := peer.NewMetrics(log, config.Namespace, metricsRegisterer)
 	if err != nil {
 		return nil, fmt.Errorf("initializing peer metrics failed with: %w", err)
@@ -199,40 +246,80 @@ func NewNetwork(
 		return nil, fmt.Errorf("initializing network metrics failed with: %w", err)
 	}
 
+	peerFilter, err := peerfilter.NewFilter(config.PeerFilterConfig, log, config.Namespace, metricsRegisterer)
+	if err != nil {
+		return nil, fmt.Errorf("initializing peer filter failed with: %w", err)
+	}
+
 	pingMessge, err := msgCreator.Ping()
 	if err != nil {
 		return nil, fmt.Errorf("initializing common ping message failed with: %w", err)
 	}
 
 	peerConfig := &peer.Config{
-		ReadBufferSize:       config.PeerReadBufferSize,
-		WriteBufferSize:      config.PeerWriteBufferSize,
-		Metrics:              peerMetrics,
-		MessageCreator:       msgCreator,
-		Log:                  log,
-		InboundMsgThrottler:  inboundMsgThrottler,
-		Network:              nil, // This is set below.
-		Router:               router,
-		VersionCompatibility: version.GetCompatibility(config.NetworkID),
-		VersionParser:        version.DefaultApplicationParser,
-		MySubnets:            config.WhitelistedSubnets,
-		Beacons:              config.Beacons,
-		NetworkID:            config.NetworkID,
-		PingFrequency:        config.PingFrequency,
-		PongTimeout:          config.PingPongTimeout,
-		MaxClockDifference:   config.MaxClockDifference,
-		ResourceTracker:      config.ResourceTracker,
-		PingMessage:          pingMessge,
+		ReadBufferSize:             config.PeerReadBufferSize,
+		WriteBufferSize:            config.PeerWriteBufferSize,
+		Metrics:                    peerMetrics,
+		MessageCreator:             msgCreator,
+		Log:                        log,
+		InboundMsgThrottler:        inboundMsgThrottler,
+		OutboundBandwidthThrottler: outboundBandwidthThrottler,
+		Network:                    nil, // This is set below.
+		Router:                     router,
+		VersionCompatibility:       version.GetCompatibility(config.NetworkID),
+		VersionParser:              version.DefaultApplicationParser,
+		MySubnets:                  config.WhitelistedSubnets,
+		Beacons:                    config.Beacons,
+		NetworkID:                  config.NetworkID,
+		PingFrequency:              config.PingFrequency,
+		PongTimeout:                config.PingPongTimeout,
+		MaxClockDifference:         config.MaxClockDifference,
+		ResourceTracker:            config.ResourceTracker,
+		PingMessage:                pingMessge,
+	}
+	if config.ProtoCodecEnabled {
+		peerConfig.Capabilities = append(peerConfig.Capabilities, peer.ProtoCapability)
+	}
+	peerConfig.Capabilities = append(peerConfig.Capabilities, peer.GzipCapability)
+	if config.ZstdEnabled {
+		peerConfig.Capabilities = append(peerConfig.Capabilities, peer.ZstdCapability)
+	}
+	if config.PeerMetricsMaxPeers > 0 {
+		peerConfig.PeerMetrics, err = peer.NewPeerMetrics(config.Namespace, metricsRegisterer, config.PeerMetricsMaxPeers)
+		if err != nil {
+			return nil, fmt.Errorf("initializing per peer metrics failed with: %w", err)
+		}
+	}
+	if config.CaptureConfig.Path != "" {
+		peerConfig.Recorder, err = peer.NewRecorder(config.CaptureConfig)
+		if err != nil {
+			return nil, fmt.Errorf("initializing message recorder failed with: %w", err)
+		}
+		log.Info("recording p2p messages to %q", config.CaptureConfig.Path)
+	}
+	// Dual-stack nodes send the IP that isn't in their Version message as a
+	// claimed IP of their own certificate.
+	var myCert *x509.Certificate
+	if config.MyIPv6Port != nil {
+		if len(config.TLSConfig.Certificates) == 0 || len(config.TLSConfig.Certificates[0].Certificate) == 0 {
+			return nil, errNoCertificate
+		}
+		myCert, err = x509.ParseCertificate(config.TLSConfig.Certificates[0].Certificate[0])
+		if err != nil {
+			return nil, fmt.Errorf("parsing TLS certificate failed with: %w", err)
+		}
 	}
 	onCloseCtx, cancel := context.WithCancel(context.Background())
 	n := &network{
 		config:               config,
 		peerConfig:           peerConfig,
 		metrics:              metrics,
-		ipSigner:             newIPSigner(config.MyIPPort, &peerConfig.Clock, config.TLSKey),
+		myCert:               myCert,
+		ipSigner:             newIPSigner(config.MyIPPort, config.MyIPv6Port, &peerConfig.Clock, config.TLSKey),
 		outboundMsgThrottler: outboundMsgThrottler,
 
 		inboundConnUpgradeThrottler: throttling.NewInboundConnUpgradeThrottler(log, config.ThrottlerConfig.InboundConnUpgradeThrottlerConfig),
+		peerFilter:                  peerFilter,
 		listener:                    listener,
 		dialer:                      dialer,
 		serverUpgrader:              peer.NewTLSServerUpgrader(config.TLSConfig),
@@ -248,6 +335,7 @@ func NewNetwork(
 		)),
 
 		trackedIPs:      make(map[ids.NodeID]*trackedIP),
+		bannedUntil:     make(map[ids.NodeID]time.Time),
 		connectingPeers: peer.NewSet(),
 		connectedPeers:  peer.NewSet(),
 		router:          router,
@@ -416,7 +504,7 @@ func (n *network) Track(claimedIPPort ips.ClaimedIPPort) bool {
 	tracked, isTracked := n.trackedIPs[nodeID]
 	switch {
 	case isTracked:
-		if tracked.ip.Timestamp >= claimedIPPort.Timestamp {
+		if !n.prefers(claimedIPPort, tracked.ip) {
 			return false
 		}
 		// Stop tracking the old IP and instead start tracking new one.
@@ -478,6 +566,20 @@ func (n *network) Version() (message.OutboundMessage, error) {
 
 func (n *network) Peers() (message.OutboundMessage, error) {
 	peers := n.sampleValidatorIPs()
+	if n.config.MyIPv6Port != nil {
+		// The IP in the Version message is followed by my IPv6 address
+		mySignedIPs, err := n.ipSigner.getSignedIPs()
+		if err != nil {
+			return nil, err
+		}
+		myIPv6 := mySignedIPs[1]
+		peers = append(peers, ips.ClaimedIPPort{
+			Cert:      n.myCert,
+			IPPort:    myIPv6.IP.IP,
+			Timestamp: myIPv6.IP.Timestamp,
+			Signature: myIPv6.Signature,
+		})
+	}
 	return n.peerConfig.MessageCreator.PeerList(peers, true)
 }
 
@@ -496,6 +598,7 @@ func (n *network) Pong(nodeID ids.NodeID) (message.OutboundMessage, error) {
 func (n *network) Dispatch() error {
 	go n.runTimers() // Periodically perform operations
 	go n.inboundConnUpgradeThrottler.Dispatch()
+	go n.peerFilter.Dispatch()
 	errs := wrappers.Errs{}
 	for { // Continuously accept new connections
 		conn, err := n.listener.Accept() // Returns error when n.Close() is called
@@ -543,6 +646,7 @@ func (n *network) Dispatch() error {
 		}()
 	}
 	n.inboundConnUpgradeThrottler.Stop()
+	n.peerFilter.Stop()
 	n.StartClose()
 
 	n.peersLock.RLock()
@@ -553,6 +657,9 @@ func (n *network) Dispatch() error {
 	for _, peer := range append(connecting, connected...) {
 		errs.Add(peer.AwaitClosed(context.TODO()))
 	}
+	if n.peerConfig.Recorder != nil {
+		errs.Add(n.peerConfig.Recorder.Close())
+	}
 	return errs.Err
 }
 
@@ -616,14 +723,16 @@ func (n *network) sampleValidatorIPs() []ips.ClaimedIPPort {
 	)
 	n.peersLock.RUnlock()
 
-	sampledIPs := make([]ips.ClaimedIPPort, len(peers))
-	for i, peer := range peers {
-		peerIP := peer.IP()
-		sampledIPs[i] = ips.ClaimedIPPort{
-			Cert:      peer.Cert(),
-			IPPort:    peerIP.IP.IP,
-			Timestamp: peerIP.IP.Timestamp,
-			Signature: peerIP.Signature,
+	// Dual-stack peers are gossiped with both of their IPs
+	sampledIPs := make([]ips.ClaimedIPPort, 0, len(peers))
+	for _, peer := range peers {
+		for _, peerIP := range peer.IPs() {
+			sampledIPs = append(sampledIPs, ips.ClaimedIPPort{
+				Cert:      peer.Cert(),
+				IPPort:    peerIP.IP.IP,
+				Timestamp: peerIP.IP.Timestamp,
+				Signature: peerIP.Signature,
+			})
 		}
 	}
 	return sampledIPs
@@ -773,7 +882,7 @@ func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 
 	// The peer that is disconnecting from us finished the handshake
 	if n.wantsConnection(nodeID) {
-		tracked := newTrackedIP(&peer.IP().IP)
+		tracked := newTrackedIP(n.reachableIP(peer.IPs()))
 		n.trackedIPs[nodeID] = tracked
 		n.dial(n.onCloseCtx, nodeID, tracked)
 	} else {
@@ -792,9 +901,21 @@ func (n *network) shouldTrack(nodeID ids.NodeID, ip ips.ClaimedIPPort) bool {
 		return false
 	}
 
+	if !n.peerFilter.Allowed(nodeID, ip.IPPort.IP) {
+		n.peerConfig.Log.Verbo(
+			"dropping suggested connection to %s because the peer filter rejects it at %s",
+			nodeID, ip.IPPort,
+		)
+		return false
+	}
+
 	n.peersLock.RLock()
 	defer n.peersLock.RUnlock()
 
+	if n.isBanned(nodeID) {
+		return false
+	}
+
 	_, connected := n.connectedPeers.GetByID(nodeID)
 	if connected {
 		// If I'm currently connected to [nodeID] then they will have told me
@@ -805,11 +926,42 @@ func (n *network) shouldTrack(nodeID ids.NodeID, ip ips.ClaimedIPPort) bool {
 
 	tracked, isTracked := n.trackedIPs[nodeID]
 	if isTracked {
-		return tracked.ip.Timestamp < ip.Timestamp
+		return n.prefers(ip, tracked.ip)
 	}
 	return n.wantsConnection(nodeID)
 }
 
+// prefers returns true if [ip] should be tracked rather than [tracked]. Newer
+// IPs are preferred. Dual-stack nodes claim both of their IPs at the same
+// time, in which case an IP of an address family that this node can reach is
+// preferred.
+func (n *network) prefers(ip ips.ClaimedIPPort, tracked *peer.UnsignedIP) bool {
+	if ip.Timestamp != tracked.Timestamp {
+		return ip.Timestamp > tracked.Timestamp
+	}
+	return n.canReach(ip.IPPort) && !n.canReach(tracked.IP)
+}
+
+// canReach returns true if this node is expected to be able to dial [ip]. A
+// node can reach the address families that it advertises IPs of.
+func (n *network) canReach(ip ips.IPPort) bool {
+	if n.config.MyIPv6Port != nil {
+		return true
+	}
+	return ip.IsIPv4() == n.config.MyIPPort.IPPort().IsIPv4()
+}
+
+// reachableIP returns the first IP of [signedIPs] that this node can reach. If
+// this node can't reach any of them, the first IP is returned.
+func (n *network) reachableIP(signedIPs []*peer.SignedIP) *peer.UnsignedIP {
+	for _, signedIP := range signedIPs {
+		if n.canReach(signedIP.IP.IP) {
+			return &signedIP.IP
+		}
+	}
+	return &signedIPs[0].IP
+}
+
 // dial will spin up a new goroutine and attempt to establish a connection with
 // [nodeID] at [ip].
 //
@@ -845,7 +997,7 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 			}
 
 			n.peersLock.Lock()
-			if !n.wantsConnection(nodeID) {
+			if !n.wantsConnection(nodeID) || !n.peerFilter.Allowed(nodeID, ip.ip.IP.IP) {
 				// Typically [n.trackedIPs[nodeID]] will already equal [ip], but
 				// the reference to [ip] is refreshed to avoid any potential
 				// race conditions before removing the entry.
@@ -858,6 +1010,7 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 			}
 			_, connecting := n.connectingPeers.GetByID(nodeID)
 			_, connected := n.connectedPeers.GetByID(nodeID)
+			banned := n.isBanned(nodeID)
 			n.peersLock.Unlock()
 
 			// While it may not be strictly needed to stop attempting to connect
@@ -880,6 +1033,16 @@ func (n *network) dial(ctx context.Context, nodeID ids.NodeID, ip *trackedIP) {
 				n.config.MaxReconnectDelay,
 			)
 
+			// Keep tracking a banned peer so that the connection is
+			// re-established once the ban expires.
+			if banned {
+				n.peerConfig.Log.Verbo(
+					"not dialing %s because it is banned, attempting again in %s",
+					nodeID, ip.delay,
+				)
+				continue
+			}
+
 			conn, err := n.dialer.Dial(ctx, ip.ip.IP)
 			if err != nil {
 				n.peerConfig.Log.Verbo(
@@ -950,6 +1113,20 @@ func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader) error {
 		return nil
 	}
 
+	// If the remote IP can't be determined, only the NodeID is filtered.
+	var remoteIP net.IP
+	if remoteIPPort, err := ips.ToIPPort(tlsConn.RemoteAddr().String()); err == nil {
+		remoteIP = remoteIPPort.IP
+	}
+	if !n.peerFilter.Allowed(nodeID, remoteIP) {
+		_ = tlsConn.Close()
+		n.peerConfig.Log.Verbo(
+			"dropping connection to %s because the peer filter rejects it at %s",
+			nodeID, remoteIP,
+		)
+		return nil
+	}
+
 	if !n.AllowConnection(nodeID) {
 		_ = tlsConn.Close()
 		n.peerConfig.Log.Verbo(
@@ -970,6 +1147,15 @@ func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader) error {
 		return nil
 	}
 
+	if n.isBanned(nodeID) {
+		_ = tlsConn.Close()
+		n.peerConfig.Log.Verbo(
+			"dropping connection to %s because it is banned",
+			nodeID,
+		)
+		return nil
+	}
+
 	if _, connecting := n.connectingPeers.GetByID(nodeID); connecting {
 		_ = tlsConn.Close()
 		n.peerConfig.Log.Verbo(
@@ -1016,6 +1202,67 @@ func (n *network) PeerInfo(nodeIDs []ids.NodeID) []peer.Info {
 	return n.connectedPeers.Info(nodeIDs)
 }
 
+func (n *network) PeerStats(nodeIDs []ids.NodeID) []peer.Stats {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	if len(nodeIDs) == 0 {
+		return n.connectedPeers.AllStats()
+	}
+	return n.connectedPeers.Stats(nodeIDs)
+}
+
+func (n *network) DisconnectPeer(nodeID ids.NodeID) bool {
+	n.peersLock.RLock()
+	peer, ok := n.connectedPeers.GetByID(nodeID)
+	if !ok {
+		peer, ok = n.connectingPeers.GetByID(nodeID)
+	}
+	n.peersLock.RUnlock()
+
+	if !ok {
+		return false
+	}
+	n.peerConfig.Log.Info("disconnecting from %s", nodeID)
+	peer.StartClose()
+	return true
+}
+
+func (n *network) BanPeer(nodeID ids.NodeID, duration time.Duration) {
+	n.peersLock.Lock()
+	now := n.peerConfig.Clock.Time()
+	for bannedID, bannedUntil := range n.bannedUntil {
+		if !now.Before(bannedUntil) {
+			delete(n.bannedUntil, bannedID)
+		}
+	}
+	n.bannedUntil[nodeID] = now.Add(duration)
+	n.peersLock.Unlock()
+
+	n.peerConfig.Log.Info("banning %s for %s", nodeID, duration)
+	n.DisconnectPeer(nodeID)
+}
+
+func (n *network) UnbanPeer(nodeID ids.NodeID) bool {
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+
+	banned := n.isBanned(nodeID)
+	delete(n.bannedUntil, nodeID)
+	return banned
+}
+
+// isBanned returns true if [nodeID] is currently banned.
+// Assumes [n.peersLock] is held.
+func (n *network) isBanned(nodeID ids.NodeID) bool {
+	bannedUntil, ok := n.bannedUntil[nodeID]
+	return ok && n.peerConfig.Clock.Time().Before(bannedUntil)
+}
+
+func (n *network) PeerFilter() peerfilter.Filter {
+	return n.peerFilter
+}
+
 func (n *network) StartClose() {
 	n.closeOnce.Do(func() {
 		n.peerConfig.Log.Info("shutting down the p2p networking")
@@ -5,6 +5,7 @@ package network
 
 import (
 	"crypto"
+	"math"
 	"net"
 	"sync"
 	"testing"
@@ -17,6 +18,8 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/peerfilter"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
@@ -183,9 +186,19 @@ func newMessageCreator(t *testing.T) message.Creator {
 }
 
 func newFullyConnectedTestNetwork(t *testing.T, handlers []router.InboundHandler) ([]ids.NodeID, []Network, *sync.WaitGroup) {
-	assert := assert.New(t)
-
 	dialer, listeners, nodeIDs, configs := newTestNetwork(t, len(handlers))
+	return connectTestNetwork(t, dialer, listeners, nodeIDs, configs, handlers)
+}
+
+func connectTestNetwork(
+	t *testing.T,
+	dialer *testDialer,
+	listeners []*testListener,
+	nodeIDs []ids.NodeID,
+	configs []*Config,
+	handlers []router.InboundHandler,
+) ([]ids.NodeID, []Network, *sync.WaitGroup) {
+	assert := assert.New(t)
 
 	beacons := validators.NewSet()
 	err := beacons.AddWeight(nodeIDs[0], 1)
@@ -357,3 +370,179 @@ func TestTrackVerifiesSignatures(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestTrackRespectsPeerFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	_, networks, wg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil})
+
+	network := networks[0].(*network)
+	nodeID, _, _ := getTLS(t, 1)
+	err := network.config.Validators.AddWeight(constants.PrimaryNetworkID, nodeID, 1)
+	assert.NoError(err)
+
+	ip := ips.ClaimedIPPort{
+		IPPort: ips.IPPort{
+			IP:   net.IPv4(123, 132, 123, 123),
+			Port: 10000,
+		},
+		Timestamp: 1000,
+	}
+	assert.True(network.shouldTrack(nodeID, ip))
+
+	err = network.PeerFilter().Add(peerfilter.Rules{
+		DeniedIPs: []string{"123.132.0.0/16"},
+	})
+	assert.NoError(err)
+	assert.False(network.shouldTrack(nodeID, ip))
+
+	err = network.PeerFilter().Remove(peerfilter.Rules{
+		DeniedIPs: []string{"123.132.0.0/16"},
+	})
+	assert.NoError(err)
+	err = network.PeerFilter().Add(peerfilter.Rules{
+		DeniedNodeIDs: []ids.NodeID{nodeID},
+	})
+	assert.NoError(err)
+	assert.False(network.shouldTrack(nodeID, ip))
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
+func TestDisconnectAndBanPeer(t *testing.T) {
+	assert := assert.New(t)
+
+	nodeIDs, networks, wg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil, nil})
+
+	net0 := networks[0].(*network)
+	nodeID1 := nodeIDs[1]
+	isConnected := func() bool {
+		return len(net0.PeerInfo([]ids.NodeID{nodeID1})) == 1
+	}
+
+	assert.False(net0.DisconnectPeer(ids.GenerateTestNodeID()))
+
+	// Validators reconnect after being disconnected
+	assert.True(net0.DisconnectPeer(nodeID1))
+	assert.Eventually(isConnected, 10*time.Second, 10*time.Millisecond)
+
+	net0.BanPeer(nodeID1, time.Hour)
+	assert.Eventually(func() bool { return !isConnected() }, 10*time.Second, 10*time.Millisecond)
+	assert.False(net0.shouldTrack(nodeID1, ips.ClaimedIPPort{
+		IPPort: ips.IPPort{
+			IP:   net.IPv4(123, 132, 123, 123),
+			Port: 10000,
+		},
+		Timestamp: math.MaxUint64,
+	}))
+
+	assert.True(net0.UnbanPeer(nodeID1))
+	assert.False(net0.UnbanPeer(nodeID1))
+
+	// Expired bans are ignored
+	net0.BanPeer(nodeID1, 0)
+	assert.False(net0.UnbanPeer(nodeID1))
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
+func TestDualStackPeerIPs(t *testing.T) {
+	assert := assert.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 2)
+
+	// Node 0 advertises an IPv4 address in addition to its IPv6 address
+	ipv4 := ips.NewDynamicIPPort(net.IPv4(127, 0, 0, 1), 0)
+	dialer.AddListener(ipv4.IPPort(), listeners[0])
+	configs[0].MyIPv6Port = configs[0].MyIPPort
+	configs[0].MyIPPort = ipv4
+
+	_, networks, wg := connectTestNetwork(
+		t,
+		dialer,
+		listeners,
+		nodeIDs,
+		configs,
+		[]router.InboundHandler{nil, nil},
+	)
+
+	net1 := networks[1].(*network)
+	net1.peersLock.RLock()
+	peer0, ok := net1.connectedPeers.GetByID(nodeIDs[0])
+	net1.peersLock.RUnlock()
+	assert.True(ok)
+
+	assert.Eventually(
+		func() bool { return len(peer0.IPs()) == 2 },
+		10*time.Second,
+		10*time.Millisecond,
+	)
+	peerIPs := peer0.IPs()
+	assert.Equal(ipv4.IPPort(), peerIPs[0].IP.IP)
+	assert.Equal(configs[0].MyIPv6Port.IPPort(), peerIPs[1].IP.IP)
+	assert.Equal(peerIPs[0].IP.Timestamp, peerIPs[1].IP.Timestamp)
+
+	// Both IPs of node 0 are gossiped
+	sampledIPs := net1.sampleValidatorIPs()
+	assert.Len(sampledIPs, 2)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
+func TestTrackPrefersReachableIP(t *testing.T) {
+	assert := assert.New(t)
+
+	// The test network only advertises IPv6 addresses
+	_, networks, wg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil})
+
+	network := networks[0].(*network)
+	nodeID, tlsCert, _ := getTLS(t, 1)
+	err := network.config.Validators.AddWeight(constants.PrimaryNetworkID, nodeID, 1)
+	assert.NoError(err)
+
+	claimIP := func(ip net.IP, timestamp uint64) ips.ClaimedIPPort {
+		unsignedIP := peer.UnsignedIP{
+			IP: ips.IPPort{
+				IP:   ip,
+				Port: 10000,
+			},
+			Timestamp: timestamp,
+		}
+		signedIP, err := unsignedIP.Sign(tlsCert.PrivateKey.(crypto.Signer))
+		assert.NoError(err)
+		return ips.ClaimedIPPort{
+			Cert:      tlsCert.Leaf,
+			IPPort:    signedIP.IP.IP,
+			Timestamp: signedIP.IP.Timestamp,
+			Signature: signedIP.Signature,
+		}
+	}
+	ipv4 := claimIP(net.IPv4(123, 132, 123, 123), 1000)
+	ipv6 := claimIP(net.ParseIP("2001:db8::1"), 1000)
+	newerIPv4 := claimIP(net.IPv4(123, 132, 123, 124), 1001)
+
+	assert.True(network.Track(ipv4))
+	// An IP claimed at the same time is only preferred if it's reachable
+	assert.True(network.Track(ipv6))
+	assert.False(network.Track(ipv4))
+	// Newer IPs are always preferred
+	assert.True(network.Track(newerIPv4))
+
+	network.peersLock.RLock()
+	assert.Equal(newerIPv4.IPPort, network.trackedIPs[nodeID].ip.IP)
+	network.peersLock.RUnlock()
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
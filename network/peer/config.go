@@ -12,31 +12,46 @@ import (
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/version"
 )
 
+// ProtoCapability is advertised by peers that are able to parse protobuf
+// encoded messages
+const ProtoCapability = "proto"
+
+// Compression algorithms are advertised as capabilities by name. Compressible
+// messages are compressed with the most preferred algorithm advertised by both
+// peers. See message.SelectCompression.
+var (
+	GzipCapability = compression.TypeGzip.String()
+	ZstdCapability = compression.TypeZstd.String()
+)
+
 type Config struct {
 	// Size, in bytes, of the buffer this peer reads messages into
 	ReadBufferSize int
 	// Size, in bytes, of the buffer this peer writes messages into
-	WriteBufferSize      int
-	Clock                mockable.Clock
-	Metrics              *Metrics
-	MessageCreator       message.Creator
-	Log                  logging.Logger
-	InboundMsgThrottler  throttling.InboundMsgThrottler
-	Network              Network
-	Router               router.InboundHandler
-	VersionCompatibility version.Compatibility
-	VersionParser        version.ApplicationParser
-	MySubnets            ids.Set
-	Beacons              validators.Set
-	NetworkID            uint32
-	PingFrequency        time.Duration
-	PongTimeout          time.Duration
-	MaxClockDifference   time.Duration
+	WriteBufferSize     int
+	Clock               mockable.Clock
+	Metrics             *Metrics
+	MessageCreator      message.Creator
+	Log                 logging.Logger
+	InboundMsgThrottler throttling.InboundMsgThrottler
+	// Rate-limits the bandwidth used to send messages to peers
+	OutboundBandwidthThrottler throttling.OutboundBandwidthThrottler
+	Network                    Network
+	Router                     router.InboundHandler
+	VersionCompatibility       version.Compatibility
+	VersionParser              version.ApplicationParser
+	MySubnets                  ids.Set
+	Beacons                    validators.Set
+	NetworkID                  uint32
+	PingFrequency              time.Duration
+	PongTimeout                time.Duration
+	MaxClockDifference         time.Duration
 
 	// Unix time of the last message sent and received respectively
 	// Must only be accessed atomically
@@ -46,4 +61,18 @@ type Config struct {
 	ResourceTracker tracker.ResourceTracker
 
 	PingMessage message.OutboundMessage
+
+	// Capabilities advertised to the peer right after the Version message.
+	// Messages are protobuf encoded if both peers advertise ProtoCapability.
+	// Compressible messages are compressed with zstd if both peers advertise
+	// ZstdCapability.
+	Capabilities []string
+
+	// Recorder captures every message sent to and received from the peer. If
+	// nil, messages aren't captured.
+	Recorder Recorder
+
+	// PeerMetrics reports the messages sent to and received from each peer. If
+	// nil, the messages aren't reported per peer.
+	PeerMetrics *PeerMetrics
 }
@@ -121,8 +121,8 @@ func NewMetrics(
 }
 
 // Sent updates the metrics for having sent [msg] and removes a reference from
-// the [msg].
-func (m *Metrics) Sent(msg message.OutboundMessage) {
+// the [msg]. [saved] is the number of bytes that compressing [msg] saved.
+func (m *Metrics) Sent(msg message.OutboundMessage, msgLen uint32, saved int) {
 	op := msg.Op()
 	msgMetrics := m.MessageMetrics[op]
 	if msgMetrics == nil {
@@ -134,9 +134,9 @@ func (m *Metrics) Sent(msg message.OutboundMessage) {
 		return
 	}
 	msgMetrics.NumSent.Inc()
-	msgMetrics.SentBytes.Add(float64(len(msg.Bytes())))
+	msgMetrics.SentBytes.Add(float64(msgLen))
 	// assume that if [saved] == 0, [msg] wasn't compressed
-	if saved := msg.BytesSavedCompression(); saved != 0 {
+	if saved != 0 {
 		msgMetrics.SavedSentBytes.Observe(float64(saved))
 	}
 	msg.DecRef()
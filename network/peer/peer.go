@@ -5,6 +5,7 @@ package peer
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"crypto/x509"
 	"encoding/binary"
@@ -19,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -62,10 +64,20 @@ type Peer interface {
 	// called after [Ready] returns true.
 	Info() Info
 
+	// Stats returns the messages that were sent to and received from this
+	// peer.
+	Stats() Stats
+
 	// IP returns the claimed IP and signature provided by this peer during the
 	// handshake. It should only be called after [Ready] returns true.
 	IP() *SignedIP
 
+	// IPs returns the signed IPs that this peer can be reached at. The IP
+	// returned by [IP] is first. If the peer is dual-stack, it's followed by
+	// the IP of the other address family that the peer provided during the
+	// handshake. It should only be called after [Ready] returns true.
+	IPs() []*SignedIP
+
 	// Version returns the claimed node version this peer is running. It should
 	// only be called after [Ready] returns true.
 	Version() version.Application
@@ -115,6 +127,12 @@ type peer struct {
 
 	// ip is the claimed IP the peer gave us in the Version message.
 	ip *SignedIP
+	// altIPLock must be held while accessing [altIP]
+	altIPLock sync.RWMutex
+	// altIP is the claimed IP of the other address family that a dual-stack
+	// peer gave us in the PeerList message of the handshake. nil if the peer
+	// didn't provide one.
+	altIP *SignedIP
 	// version is the claimed version the peer is running that we received in
 	// the Version message.
 	version version.Application
@@ -141,6 +159,16 @@ type peer struct {
 	// onFinishHandshake is closed when the peer finishes the p2p handshake.
 	onFinishHandshake chan struct{}
 
+	// True if this peer has sent us a Capabilities message.
+	// Only accessed on the connection's reader routine.
+	gotCapabilities bool
+	// The message.Encoding of the messages sent to this peer.
+	// Must only be accessed atomically.
+	encoding uint32
+	// The compression.Type of the compressible messages sent to this peer.
+	// Must only be accessed atomically.
+	compression uint32
+
 	// numExecuting is the number of goroutines this peer is currently using
 	numExecuting     int64
 	startClosingOnce sync.Once
@@ -155,6 +183,12 @@ type peer struct {
 	// Unix time of the last message sent and received respectively
 	// Must only be accessed atomically
 	lastSent, lastReceived int64
+
+	// stats of the messages sent to and received from this peer
+	stats stats
+	// label that the messages of this peer are reported with in
+	// [PeerMetrics]
+	metricsLabel string
 }
 
 func Start(
@@ -172,6 +206,7 @@ func Start(
 		id:                 id,
 		messageQueue:       messageQueue,
 		onFinishHandshake:  make(chan struct{}),
+		compression:        uint32(compression.TypeGzip),
 		numExecuting:       3,
 		onClosingCtx:       onClosingCtx,
 		onClosingCtxCancel: onClosingCtxCancel,
@@ -179,6 +214,9 @@ func Start(
 	}
 
 	p.trackedSubnets.Add(constants.PrimaryNetworkID)
+	if p.PeerMetrics != nil {
+		p.metricsLabel = p.PeerMetrics.Connected(id)
+	}
 
 	go p.readMessages()
 	go p.writeMessages()
@@ -235,8 +273,20 @@ func (p *peer) Info() Info {
 	}
 }
 
+func (p *peer) Stats() Stats { return p.stats.get(p.id) }
+
 func (p *peer) IP() *SignedIP { return p.ip }
 
+func (p *peer) IPs() []*SignedIP {
+	p.altIPLock.RLock()
+	defer p.altIPLock.RUnlock()
+
+	if p.altIP == nil {
+		return []*SignedIP{p.ip}
+	}
+	return []*SignedIP{p.ip, p.altIP}
+}
+
 func (p *peer) Version() version.Application { return p.version }
 
 func (p *peer) TrackedSubnets() ids.Set { return p.trackedSubnets }
@@ -291,6 +341,9 @@ func (p *peer) close() {
 		return
 	}
 
+	if p.PeerMetrics != nil {
+		p.PeerMetrics.Disconnected(p.id, p.metricsLabel)
+	}
 	p.Network.Disconnected(p.id)
 	close(p.onClosed)
 }
@@ -383,6 +436,14 @@ func (p *peer) readMessages() {
 			p.id, formatting.DumpBytes(msgBytes),
 		)
 
+		// Parsing may decompress the message in place, so the message is
+		// copied before it's parsed if it's recorded.
+		var recordedBytes []byte
+		if p.Recorder != nil {
+			recordedBytes = make([]byte, len(msgBytes))
+			copy(recordedBytes, msgBytes)
+		}
+
 		// Parse the message
 		msg, err := p.MessageCreator.Parse(msgBytes, p.id, onFinishedHandling)
 		if err != nil {
@@ -390,6 +451,11 @@ func (p *peer) readMessages() {
 				"failed to parse message from %s: %s\n%s",
 				p.id, err, formatting.DumpBytes(msgBytes),
 			)
+			if p.Recorder != nil {
+				if err := p.Recorder.Record(Inbound, p.id, UnknownOp, ids.Empty, 0, recordedBytes); err != nil {
+					p.Log.Debug("couldn't record message from %s: %s", p.id, err)
+				}
+			}
 
 			p.Metrics.FailedToParse.Inc()
 
@@ -399,10 +465,21 @@ func (p *peer) readMessages() {
 			continue
 		}
 
+		if p.Recorder != nil {
+			chainID, requestID := messageMetadata(msg.Get)
+			if err := p.Recorder.Record(Inbound, p.id, msg.Op(), chainID, requestID, recordedBytes); err != nil {
+				p.Log.Debug("couldn't record message from %s: %s", p.id, err)
+			}
+		}
+
 		now := p.Clock.Time().Unix()
 		atomic.StoreInt64(&p.Config.LastReceived, now)
 		atomic.StoreInt64(&p.lastReceived, now)
 		p.Metrics.Received(msg, msgLen)
+		p.stats.received(msg.Op(), msgLen)
+		if p.PeerMetrics != nil {
+			p.PeerMetrics.Received(p.metricsLabel, msgLen)
+		}
 
 		// Handle the message. Note that when we are done handling this message,
 		// we must call [msg.OnFinishedHandling()].
@@ -412,7 +489,10 @@ func (p *peer) readMessages() {
 }
 
 func (p *peer) writeMessages() {
+	// Track this node with the outbound bandwidth throttler.
+	p.OutboundBandwidthThrottler.AddNode(p.id)
 	defer func() {
+		p.OutboundBandwidthThrottler.RemoveNode(p.id)
 		p.StartClose()
 		p.close()
 	}()
@@ -425,6 +505,15 @@ func (p *peer) writeMessages() {
 
 	p.writeMessage(writer, msg)
 
+	// Advertise our capabilities right after the version. Peers that don't
+	// know the Capabilities message fail to parse it and drop it.
+	if len(p.Capabilities) > 0 {
+		msg, err := p.MessageCreator.Capabilities(p.Capabilities)
+		p.Log.AssertNoError(err)
+
+		p.writeMessage(writer, msg)
+	}
+
 	for {
 		msg, ok := p.messageQueue.PopNow()
 		if ok {
@@ -452,8 +541,18 @@ func (p *peer) writeMessages() {
 	}
 }
 
-func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
-	msgBytes := msg.Bytes()
+func (p *peer) writeMessage(writer *bufio.Writer, msg message.OutboundMessage) {
+	encoding := message.Encoding(atomic.LoadUint32(&p.encoding))
+	compressionType := compression.Type(atomic.LoadUint32(&p.compression))
+	msgBytes, bytesSaved, err := msg.EncodedBytes(encoding, compressionType)
+	if err != nil {
+		p.Log.Error(
+			"couldn't encode %s message to %s as %s with %s: %s",
+			msg.Op(), p.id, encoding, compressionType, err,
+		)
+		msg.DecRef()
+		return
+	}
 	p.Log.Verbo(
 		"sending message to %s:\n%s",
 		p.id, formatting.DumpBytes(msgBytes),
@@ -463,6 +562,26 @@ func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 	msgLenBytes := [wrappers.IntLen]byte{}
 	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
 
+	// Wait until there is enough outbound bandwidth to send the message. The
+	// messages that are already buffered are flushed before waiting, so that
+	// they aren't held back by this message.
+	flush := func() {
+		if err := writer.Flush(); err != nil {
+			p.Log.Verbo(
+				"couldn't flush writer to %s: %s",
+				p.id, err,
+			)
+		}
+	}
+	if !p.OutboundBandwidthThrottler.Acquire(p.onClosingCtx, uint64(wrappers.IntLen+msgLen), p.id, flush) {
+		p.Log.Debug(
+			"dropping %s message to %s because the peer is closing",
+			msg.Op(), p.id,
+		)
+		msg.DecRef()
+		return
+	}
+
 	if err := p.conn.SetWriteDeadline(p.nextTimeout()); err != nil {
 		p.Log.Verbo(
 			"error setting write deadline to %s due to: %s",
@@ -480,10 +599,21 @@ func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 		return
 	}
 
+	if p.Recorder != nil {
+		chainID, requestID := messageMetadata(msg.Get)
+		if err := p.Recorder.Record(Outbound, p.id, msg.Op(), chainID, requestID, msgBytes); err != nil {
+			p.Log.Debug("couldn't record message to %s: %s", p.id, err)
+		}
+	}
+
 	now := p.Clock.Time().Unix()
 	atomic.StoreInt64(&p.Config.LastSent, now)
 	atomic.StoreInt64(&p.lastSent, now)
-	p.Metrics.Sent(msg)
+	p.stats.sent(msg.Op(), msgLen)
+	if p.PeerMetrics != nil {
+		p.PeerMetrics.Sent(p.metricsLabel, msgLen)
+	}
+	p.Metrics.Sent(msg, msgLen, bytesSaved)
 }
 
 func (p *peer) sendPings() {
@@ -543,6 +673,10 @@ func (p *peer) handle(msg message.InboundMessage) {
 		p.handlePeerList(msg)
 		msg.OnFinishedHandling()
 		return
+	case message.Capabilities:
+		p.handleCapabilities(msg)
+		msg.OnFinishedHandling()
+		return
 	}
 	if !p.finishedHandshake.GetValue() {
 		p.Log.Debug(
@@ -709,12 +843,80 @@ func (p *peer) handlePeerList(msg message.InboundMessage) {
 
 	ips := msg.Get(message.Peers).([]ips.ClaimedIPPort)
 	for _, ip := range ips {
+		// Dual-stack peers include their own IP of the other address family in
+		// the PeerList message.
+		if bytes.Equal(ip.Cert.Raw, p.cert.Raw) {
+			p.handleAltIP(ip)
+			continue
+		}
 		if !p.Network.Track(ip) {
 			p.Metrics.NumUselessPeerListBytes.Add(float64(ip.BytesLen()))
 		}
 	}
 }
 
+func (p *peer) handleAltIP(claimedIP ips.ClaimedIPPort) {
+	// The alternative IP must be of the other address family than the IP in
+	// the Version message.
+	if claimedIP.IPPort.IsIPv4() == p.ip.IP.IP.IsIPv4() {
+		p.Log.Verbo("dropping alternative IP %s of %s with the same address family as %s",
+			claimedIP.IPPort, p.id, p.ip.IP.IP,
+		)
+		return
+	}
+
+	altIP := &SignedIP{
+		IP: UnsignedIP{
+			IP:        claimedIP.IPPort,
+			Timestamp: claimedIP.Timestamp,
+		},
+		Signature: claimedIP.Signature,
+	}
+	if err := altIP.Verify(p.cert); err != nil {
+		p.Log.Debug("alternative IP signature verification failed for %s: %s",
+			p.id, err,
+		)
+		return
+	}
+
+	p.altIPLock.Lock()
+	p.altIP = altIP
+	p.altIPLock.Unlock()
+}
+
+func (p *peer) handleCapabilities(msg message.InboundMessage) {
+	if p.gotCapabilities {
+		p.Log.Verbo("dropping duplicated capabilities message from %s", p.id)
+		return
+	}
+	p.gotCapabilities = true
+
+	peerCapabilities := msg.Get(message.CapabilityStrs).([]string)
+	if p.supports(ProtoCapability, peerCapabilities) {
+		p.Log.Debug("sending protobuf encoded messages to %s", p.id)
+		atomic.StoreUint32(&p.encoding, uint32(message.ProtoEncoding))
+	}
+
+	compressionType := message.SelectCompression(p.Capabilities, peerCapabilities)
+	p.Log.Debug("compressing messages sent to %s with %s", p.id, compressionType)
+	atomic.StoreUint32(&p.compression, uint32(compressionType))
+}
+
+// supports returns true if [capability] is advertised by both this node and
+// the peer
+func (p *peer) supports(capability string, peerCapabilities []string) bool {
+	return contains(p.Capabilities, capability) && contains(peerCapabilities, capability)
+}
+
+func contains(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *peer) nextTimeout() time.Time {
 	return p.Clock.Time().Add(p.PongTimeout)
 }
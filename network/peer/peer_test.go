@@ -8,6 +8,7 @@ import (
 	"crypto"
 	"crypto/x509"
 	"net"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -85,20 +87,21 @@ func makeRawTestPeers(t *testing.T) (*rawTestPeer, *rawTestPeer) {
 	resourceTracker, err := tracker.NewResourceTracker(prometheus.NewRegistry(), resource.NoUsage, meter.ContinuousFactory{}, 10*time.Second)
 	assert.NoError(err)
 	sharedConfig := Config{
-		Metrics:              metrics,
-		MessageCreator:       mc,
-		Log:                  logging.NoLog{},
-		InboundMsgThrottler:  throttling.NewNoInboundThrottler(),
-		VersionCompatibility: version.GetCompatibility(constants.LocalID),
-		VersionParser:        version.DefaultApplicationParser,
-		MySubnets:            ids.Set{},
-		Beacons:              validators.NewSet(),
-		NetworkID:            constants.LocalID,
-		PingFrequency:        constants.DefaultPingFrequency,
-		PongTimeout:          constants.DefaultPingPongTimeout,
-		MaxClockDifference:   time.Minute,
-		ResourceTracker:      resourceTracker,
-		PingMessage:          pingMessage,
+		Metrics:                    metrics,
+		MessageCreator:             mc,
+		Log:                        logging.NoLog{},
+		InboundMsgThrottler:        throttling.NewNoInboundThrottler(),
+		OutboundBandwidthThrottler: throttling.NewNoOutboundBandwidthThrottler(),
+		VersionCompatibility:       version.GetCompatibility(constants.LocalID),
+		VersionParser:              version.DefaultApplicationParser,
+		MySubnets:                  ids.Set{},
+		Beacons:                    validators.NewSet(),
+		NetworkID:                  constants.LocalID,
+		PingFrequency:              constants.DefaultPingFrequency,
+		PongTimeout:                constants.DefaultPingPongTimeout,
+		MaxClockDifference:         time.Minute,
+		ResourceTracker:            resourceTracker,
+		PingMessage:                pingMessage,
 	}
 	peerConfig0 := sharedConfig
 	peerConfig1 := sharedConfig
@@ -279,9 +282,128 @@ func TestSend(t *testing.T) {
 	inboundGetMsg := <-peer1.inboundMsgChan
 	assert.Equal(message.Get, inboundGetMsg.Op())
 
+	receivedStats := peer1.Stats().Ops[message.Get.String()]
+	assert.EqualValues(1, receivedStats.NumReceived)
+	assert.NotZero(receivedStats.ReceivedBytes)
+	assert.Eventually(func() bool {
+		return peer0.Stats().Ops[message.Get.String()] == MessageStats{
+			NumSent:   1,
+			SentBytes: receivedStats.ReceivedBytes,
+		}
+	}, 5*time.Second, time.Millisecond)
+
 	peer1.StartClose()
 	err = peer0.AwaitClosed(context.Background())
 	assert.NoError(err)
 	err = peer1.AwaitClosed(context.Background())
 	assert.NoError(err)
 }
+
+func TestProtoNegotiation(t *testing.T) {
+	tests := map[string]struct {
+		capabilities0       []string
+		capabilities1       []string
+		expectedEncoding    message.Encoding
+		expectedCompression compression.Type
+	}{
+		"both support proto": {
+			capabilities0:       []string{ProtoCapability},
+			capabilities1:       []string{"unknown", ProtoCapability},
+			expectedEncoding:    message.ProtoEncoding,
+			expectedCompression: compression.TypeGzip,
+		},
+		"one supports proto": {
+			capabilities0:       []string{ProtoCapability},
+			capabilities1:       nil,
+			expectedEncoding:    message.PackerEncoding,
+			expectedCompression: compression.TypeGzip,
+		},
+		"neither supports proto": {
+			capabilities0:       nil,
+			capabilities1:       []string{"unknown"},
+			expectedEncoding:    message.PackerEncoding,
+			expectedCompression: compression.TypeGzip,
+		},
+		"both support zstd": {
+			capabilities0:       []string{GzipCapability, ZstdCapability},
+			capabilities1:       []string{ZstdCapability},
+			expectedEncoding:    message.PackerEncoding,
+			expectedCompression: compression.TypeZstd,
+		},
+		"both support proto and zstd": {
+			capabilities0:       []string{ProtoCapability, ZstdCapability},
+			capabilities1:       []string{ProtoCapability, GzipCapability, ZstdCapability},
+			expectedEncoding:    message.ProtoEncoding,
+			expectedCompression: compression.TypeZstd,
+		},
+		"one supports zstd": {
+			capabilities0:       []string{GzipCapability, ZstdCapability},
+			capabilities1:       []string{GzipCapability},
+			expectedEncoding:    message.PackerEncoding,
+			expectedCompression: compression.TypeGzip,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			rawPeer0, rawPeer1 := makeRawTestPeers(t)
+			rawPeer0.config.Capabilities = test.capabilities0
+			rawPeer1.config.Capabilities = test.capabilities1
+
+			peer0 := Start(
+				rawPeer0.config,
+				rawPeer0.conn,
+				rawPeer1.cert,
+				rawPeer1.nodeID,
+				NewThrottledMessageQueue(
+					rawPeer0.config.Metrics,
+					rawPeer1.nodeID,
+					logging.NoLog{},
+					throttling.NewNoOutboundThrottler(),
+				),
+			)
+			peer1 := Start(
+				rawPeer1.config,
+				rawPeer1.conn,
+				rawPeer0.cert,
+				rawPeer0.nodeID,
+				NewThrottledMessageQueue(
+					rawPeer1.config.Metrics,
+					rawPeer0.nodeID,
+					logging.NoLog{},
+					throttling.NewNoOutboundThrottler(),
+				),
+			)
+
+			// The capabilities are handled before the handshake finishes
+			assert.NoError(peer0.AwaitReady(context.Background()))
+			assert.NoError(peer1.AwaitReady(context.Background()))
+			assert.EqualValues(test.expectedEncoding, atomic.LoadUint32(&peer0.(*peer).encoding))
+			assert.EqualValues(test.expectedEncoding, atomic.LoadUint32(&peer1.(*peer).encoding))
+			assert.EqualValues(test.expectedCompression, atomic.LoadUint32(&peer0.(*peer).compression))
+			assert.EqualValues(test.expectedCompression, atomic.LoadUint32(&peer1.(*peer).compression))
+
+			mc := newMessageCreator(t)
+			outboundGetMsg, err := mc.Get(ids.Empty, 1, time.Second, ids.Empty)
+			assert.NoError(err)
+			assert.True(peer0.Send(context.Background(), outboundGetMsg))
+
+			inboundGetMsg := <-rawPeer1.inboundMsgChan
+			assert.Equal(message.Get, inboundGetMsg.Op())
+			assert.Equal(uint32(1), inboundGetMsg.Get(message.RequestID))
+
+			outboundPutMsg, err := mc.Put(ids.Empty, 2, ids.Empty, make([]byte, 1024))
+			assert.NoError(err)
+			assert.True(peer0.Send(context.Background(), outboundPutMsg))
+
+			inboundPutMsg := <-rawPeer1.inboundMsgChan
+			assert.Equal(message.Put, inboundPutMsg.Op())
+			assert.Positive(inboundPutMsg.BytesSavedCompression())
+
+			peer1.StartClose()
+			assert.NoError(peer0.AwaitClosed(context.Background()))
+			assert.NoError(peer1.AwaitClosed(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/utils/filesystem"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	// Inbound messages were received from the peer
+	Inbound Direction = iota
+	// Outbound messages were sent to the peer
+	Outbound
+)
+
+const (
+	// UnknownOp is the op of recorded messages that couldn't be parsed
+	UnknownOp message.Op = math.MaxUint8
+
+	captureFilePerms = 0o600
+
+	// Size of a record, excluding the message bytes
+	recordHeaderLen = wrappers.LongLen + // timestamp
+		wrappers.ByteLen + // direction
+		hashing.AddrLen + // nodeID
+		wrappers.ByteLen + // op
+		hashing.HashLen + // chainID
+		wrappers.IntLen + // requestID
+		wrappers.IntLen // message length
+)
+
+var (
+	errRecorderClosed = errors.New("recorder closed")
+	errInvalidRecord  = errors.New("invalid capture record")
+
+	_ Recorder = &recorder{}
+)
+
+// Direction a recorded message was sent in
+type Direction byte
+
+func (d Direction) String() string {
+	switch d {
+	case Inbound:
+		return "inbound"
+	case Outbound:
+		return "outbound"
+	default:
+		return fmt.Sprintf("Unknown Direction: %d", d)
+	}
+}
+
+// Record is a message that was sent to or received from a peer
+type Record struct {
+	Time      time.Time
+	Direction Direction
+	NodeID    ids.NodeID
+	Op        message.Op
+	// ChainID is empty for messages that aren't sent to a chain
+	ChainID ids.ID
+	// RequestID is 0 for messages that don't contain a request ID
+	RequestID uint32
+	// Bytes of the message as they were sent over the wire
+	Bytes []byte
+}
+
+// Recorder captures the messages that peers send and receive
+type Recorder interface {
+	// Record [msgBytes] sent to or received from [nodeID]. [op], [chainID] and
+	// [requestID] are the metadata of the message, as described in Record.
+	// [msgBytes] isn't modified or retained.
+	Record(
+		direction Direction,
+		nodeID ids.NodeID,
+		op message.Op,
+		chainID ids.ID,
+		requestID uint32,
+		msgBytes []byte,
+	) error
+
+	// Close the capture file. Messages recorded after Close is called are
+	// dropped.
+	Close() error
+}
+
+type RecorderConfig struct {
+	// Path of the capture file. If empty, messages aren't recorded.
+	Path string `json:"path"`
+
+	// MaxFileSize is the size, in bytes, that the capture file may grow to
+	// before it's rotated. If 0, the file is never rotated.
+	MaxFileSize uint64 `json:"maxFileSize"`
+
+	// MaxFiles is the number of rotated capture files that are kept
+	MaxFiles int `json:"maxFiles"`
+}
+
+// recorder appends records to a capture file. Each record is prefixed with
+// its length as a 4 byte big endian integer.
+//
+// Once the file would grow larger than [MaxFileSize], it's renamed to
+// [Path].1, the previously rotated files are renamed to [Path].2 and so on,
+// and only the [MaxFiles] most recent rotated files are kept.
+type recorder struct {
+	config RecorderConfig
+	clock  mockable.Clock
+
+	lock sync.Mutex
+	// nil once the recorder is closed
+	file *os.File
+	size uint64
+}
+
+// NewRecorder returns a recorder that writes to [config.Path]
+func NewRecorder(config RecorderConfig) (Recorder, error) {
+	r := &recorder{
+		config: config,
+	}
+	return r, r.open()
+}
+
+func (r *recorder) open() error {
+	file, err := os.OpenFile(r.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, captureFilePerms)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	r.file = file
+	r.size = uint64(info.Size())
+	return nil
+}
+
+func (r *recorder) Record(
+	direction Direction,
+	nodeID ids.NodeID,
+	op message.Op,
+	chainID ids.ID,
+	requestID uint32,
+	msgBytes []byte,
+) error {
+	recordBytes := marshalRecord(&Record{
+		Time:      r.clock.Time(),
+		Direction: direction,
+		NodeID:    nodeID,
+		Op:        op,
+		ChainID:   chainID,
+		RequestID: requestID,
+		Bytes:     msgBytes,
+	})
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return errRecorderClosed
+	}
+
+	recordSize := uint64(len(recordBytes))
+	if r.config.MaxFileSize > 0 && r.size > 0 && r.size+recordSize > r.config.MaxFileSize {
+		if err := r.rotate(); err != nil {
+			return fmt.Errorf("couldn't rotate %s: %w", r.config.Path, err)
+		}
+	}
+
+	n, err := r.file.Write(recordBytes)
+	r.size += uint64(n)
+	return err
+}
+
+// rotate assumes [r.lock] is held
+func (r *recorder) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+
+	if err := filesystem.Rotate(r.config.Path, r.config.MaxFiles); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+func (r *recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// messageMetadata returns the chain ID and request ID of a message whose
+// fields are returned by [get]. They are empty if the message doesn't have
+// them.
+func messageMetadata(get func(message.Field) interface{}) (ids.ID, uint32) {
+	var chainID ids.ID
+	if chainIDBytes, ok := get(message.ChainID).([]byte); ok {
+		copy(chainID[:], chainIDBytes)
+	}
+	requestID, _ := get(message.RequestID).(uint32)
+	return chainID, requestID
+}
+
+// marshalRecord returns the length prefixed representation of [record]
+func marshalRecord(record *Record) []byte {
+	p := wrappers.Packer{
+		Bytes: make([]byte, wrappers.IntLen+recordHeaderLen+len(record.Bytes)),
+	}
+	p.PackInt(uint32(recordHeaderLen + len(record.Bytes)))
+	p.PackLong(uint64(record.Time.UnixNano()))
+	p.PackByte(byte(record.Direction))
+	p.PackFixedBytes(record.NodeID[:])
+	p.PackByte(byte(record.Op))
+	p.PackFixedBytes(record.ChainID[:])
+	p.PackInt(record.RequestID)
+	p.PackBytes(record.Bytes)
+	return p.Bytes
+}
+
+// ReadCapture returns the records that were written to a capture file, in the
+// order that they were recorded.
+func ReadCapture(reader io.Reader) ([]Record, error) {
+	var (
+		records   []Record
+		lenPrefix [wrappers.IntLen]byte
+	)
+	for {
+		if _, err := io.ReadFull(reader, lenPrefix[:]); err != nil {
+			if err == io.EOF {
+				return records, nil
+			}
+			return nil, fmt.Errorf("%w: %s", errInvalidRecord, err)
+		}
+		recordLen := binary.BigEndian.Uint32(lenPrefix[:])
+		if recordLen < recordHeaderLen {
+			return nil, fmt.Errorf("%w: length %d is too short", errInvalidRecord, recordLen)
+		}
+		recordBytes := make([]byte, recordLen)
+		if _, err := io.ReadFull(reader, recordBytes); err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidRecord, err)
+		}
+
+		p := wrappers.Packer{Bytes: recordBytes}
+		record := Record{
+			Time:      time.Unix(0, int64(p.UnpackLong())),
+			Direction: Direction(p.UnpackByte()),
+		}
+		copy(record.NodeID[:], p.UnpackFixedBytes(hashing.AddrLen))
+		record.Op = message.Op(p.UnpackByte())
+		copy(record.ChainID[:], p.UnpackFixedBytes(hashing.HashLen))
+		record.RequestID = p.UnpackInt()
+		record.Bytes = p.UnpackBytes()
+		if p.Errored() || p.Offset != len(recordBytes) {
+			return nil, fmt.Errorf("%w: malformed record of length %d", errInvalidRecord, recordLen)
+		}
+		records = append(records, record)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow/networking/router"
+	"github.com/ava-labs/avalanchego/utils/compression"
+)
+
+func readCapture(t *testing.T, path string) []Record {
+	t.Helper()
+
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	records, err := ReadCapture(file)
+	assert.NoError(t, err)
+	return records
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	assert := assert.New(t)
+
+	mc := newMessageCreator(t)
+	path := filepath.Join(t.TempDir(), "capture")
+	r, err := NewRecorder(RecorderConfig{Path: path})
+	assert.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	chainID := ids.GenerateTestID()
+	containerID := ids.GenerateTestID()
+
+	ping, err := mc.Ping()
+	assert.NoError(err)
+	get, err := mc.Get(chainID, 1, time.Second, containerID)
+	assert.NoError(err)
+	put, err := mc.Put(chainID, 2, containerID, make([]byte, 1024))
+	assert.NoError(err)
+
+	pingBytes, _, err := ping.EncodedBytes(message.PackerEncoding, compression.TypeNone)
+	assert.NoError(err)
+	getBytes, _, err := get.EncodedBytes(message.ProtoEncoding, compression.TypeNone)
+	assert.NoError(err)
+	putBytes, _, err := put.EncodedBytes(message.ProtoEncoding, compression.TypeZstd)
+	assert.NoError(err)
+	originalPutBytes := append([]byte(nil), putBytes...)
+	parsedPut, err := mc.Parse(putBytes, nodeID, func() {})
+	assert.NoError(err)
+
+	getChainID, getRequestID := messageMetadata(get.Get)
+	putChainID, putRequestID := messageMetadata(parsedPut.Get)
+	assert.NoError(r.Record(Inbound, nodeID, ping.Op(), ids.Empty, 0, pingBytes))
+	assert.NoError(r.Record(Outbound, nodeID, get.Op(), getChainID, getRequestID, getBytes))
+	assert.NoError(r.Record(Inbound, nodeID, parsedPut.Op(), putChainID, putRequestID, originalPutBytes))
+	assert.NoError(r.Record(Inbound, nodeID, UnknownOp, ids.Empty, 0, []byte{1, 2, 3}))
+	assert.NoError(r.Close())
+
+	err = r.Record(Inbound, nodeID, ping.Op(), ids.Empty, 0, pingBytes)
+	assert.ErrorIs(err, errRecorderClosed)
+
+	records := readCapture(t, path)
+	assert.Len(records, 4)
+
+	assert.Equal(Inbound, records[0].Direction)
+	assert.Equal(message.Ping, records[0].Op)
+	assert.Equal(ids.Empty, records[0].ChainID)
+	assert.Equal(pingBytes, records[0].Bytes)
+
+	assert.Equal(Outbound, records[1].Direction)
+	assert.Equal(nodeID, records[1].NodeID)
+	assert.Equal(message.Get, records[1].Op)
+	assert.Equal(chainID, records[1].ChainID)
+	assert.EqualValues(1, records[1].RequestID)
+
+	assert.Equal(message.Put, records[2].Op)
+	assert.Equal(chainID, records[2].ChainID)
+	assert.EqualValues(2, records[2].RequestID)
+	assert.Equal(originalPutBytes, records[2].Bytes)
+
+	assert.Equal(UnknownOp, records[3].Op)
+	assert.Equal([]byte{1, 2, 3}, records[3].Bytes)
+
+	// Only the inbound Put is handed to the router. The unparseable message is
+	// skipped.
+	var handled []message.InboundMessage
+	handler := router.InboundHandlerFunc(func(msg message.InboundMessage) {
+		handled = append(handled, msg)
+		msg.OnFinishedHandling()
+	})
+	replayed, err := Replay(context.Background(), records, mc, handler)
+	assert.NoError(err)
+	assert.Equal(1, replayed)
+	assert.Len(handled, 1)
+	assert.Equal(message.Put, handled[0].Op())
+	assert.Equal(nodeID, handled[0].NodeID())
+	assert.Equal(containerID[:], handled[0].Get(message.ContainerID))
+
+	// Replaying stops if a message is never finished being handled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	replayed, err = Replay(ctx, records, mc, router.InboundHandlerFunc(func(message.InboundMessage) {}))
+	assert.ErrorIs(err, context.Canceled)
+	assert.Zero(replayed)
+}
+
+func TestRecorderRotate(t *testing.T) {
+	assert := assert.New(t)
+
+	mc := newMessageCreator(t)
+	path := filepath.Join(t.TempDir(), "capture")
+
+	ping, err := mc.Ping()
+	assert.NoError(err)
+	pingBytes, _, err := ping.EncodedBytes(message.PackerEncoding, compression.TypeNone)
+	assert.NoError(err)
+	recordSize := uint64(len(marshalRecord(&Record{Bytes: pingBytes})))
+
+	r, err := NewRecorder(
+		RecorderConfig{
+			Path:        path,
+			MaxFileSize: 2 * recordSize,
+			MaxFiles:    1,
+		},
+	)
+	assert.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	for i := 0; i < 5; i++ {
+		assert.NoError(r.Record(Inbound, nodeID, ping.Op(), ids.Empty, 0, pingBytes))
+	}
+	assert.NoError(r.Close())
+
+	assert.Len(readCapture(t, path), 1)
+	assert.Len(readCapture(t, path+".1"), 2)
+	_, err = os.Stat(path + ".2")
+	assert.True(os.IsNotExist(err))
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow/networking/router"
+)
+
+var handshakeOps = map[message.Op]struct{}{}
+
+func init() {
+	for _, op := range message.HandshakeOps {
+		handshakeOps[op] = struct{}{}
+	}
+}
+
+// Replay hands the inbound messages in [records] that would have been routed
+// to a chain to [handler], in the order that they were recorded. A message is
+// only handed to [handler] once the previous message has finished being
+// handled, so that replaying a capture is deterministic.
+//
+// Returns the number of messages that were replayed.
+func Replay(
+	ctx context.Context,
+	records []Record,
+	parser message.Parser,
+	handler router.InboundHandler,
+) (int, error) {
+	replayed := 0
+	for i, record := range records {
+		if record.Direction != Inbound || record.Op == UnknownOp {
+			// Outbound messages and messages that couldn't be parsed were
+			// never handed to the router
+			continue
+		}
+		if _, ok := handshakeOps[record.Op]; ok {
+			// Handshake messages are handled by the peer, not the router
+			continue
+		}
+
+		finished := make(chan struct{})
+		msg, err := parser.Parse(record.Bytes, record.NodeID, func() { close(finished) })
+		if err != nil {
+			return replayed, fmt.Errorf("couldn't parse record %d: %w", i, err)
+		}
+
+		handler.HandleInbound(msg)
+		select {
+		case <-finished:
+		case <-ctx.Done():
+			return replayed, ctx.Err()
+		}
+		replayed++
+	}
+	return replayed, nil
+}
@@ -47,6 +47,13 @@ type Set interface {
 	// Info returns information about the requested peers if they are in the
 	// set.
 	Info(nodeIDs []ids.NodeID) []Info
+
+	// Returns the message stats of all the peers.
+	AllStats() []Stats
+
+	// Stats returns the message stats of the requested peers if they are in
+	// the set.
+	Stats(nodeIDs []ids.NodeID) []Stats
 }
 
 type set struct {
@@ -155,3 +162,21 @@ func (s *set) Info(nodeIDs []ids.NodeID) []Info {
 	}
 	return peerInfo
 }
+
+func (s *set) AllStats() []Stats {
+	peerStats := make([]Stats, len(s.peersSlice))
+	for i, peer := range s.peersSlice {
+		peerStats[i] = peer.Stats()
+	}
+	return peerStats
+}
+
+func (s *set) Stats(nodeIDs []ids.NodeID) []Stats {
+	peerStats := make([]Stats, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if peer, ok := s.GetByID(nodeID); ok {
+			peerStats = append(peerStats, peer.Stats())
+		}
+	}
+	return peerStats
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// otherPeersLabel is reported for the peers that aren't labelled individually
+// because the maximum number of labelled peers was reached
+const otherPeersLabel = "other"
+
+// MessageStats are the number of messages, and the number of bytes of those
+// messages, that were sent to and received from a peer
+type MessageStats struct {
+	NumSent       json.Uint64 `json:"numSent"`
+	SentBytes     json.Uint64 `json:"sentBytes"`
+	NumReceived   json.Uint64 `json:"numReceived"`
+	ReceivedBytes json.Uint64 `json:"receivedBytes"`
+}
+
+// Stats are the messages that were sent to and received from a peer since the
+// connection with the peer was established
+type Stats struct {
+	ID ids.NodeID `json:"nodeID"`
+	// Totals across all ops
+	MessageStats
+	// Op --> stats of the messages with that op
+	Ops map[string]MessageStats `json:"ops"`
+}
+
+// stats is safe to use as its zero value
+type stats struct {
+	lock  sync.Mutex
+	total MessageStats
+	ops   map[message.Op]*MessageStats
+}
+
+// opStats assumes [s.lock] is held
+func (s *stats) opStats(op message.Op) *MessageStats {
+	if s.ops == nil {
+		s.ops = make(map[message.Op]*MessageStats)
+	}
+	opStats, ok := s.ops[op]
+	if !ok {
+		opStats = &MessageStats{}
+		s.ops[op] = opStats
+	}
+	return opStats
+}
+
+func (s *stats) sent(op message.Op, msgLen uint32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	opStats := s.opStats(op)
+	opStats.NumSent++
+	opStats.SentBytes += json.Uint64(msgLen)
+	s.total.NumSent++
+	s.total.SentBytes += json.Uint64(msgLen)
+}
+
+func (s *stats) received(op message.Op, msgLen uint32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	opStats := s.opStats(op)
+	opStats.NumReceived++
+	opStats.ReceivedBytes += json.Uint64(msgLen)
+	s.total.NumReceived++
+	s.total.ReceivedBytes += json.Uint64(msgLen)
+}
+
+func (s *stats) get(nodeID ids.NodeID) Stats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ops := make(map[string]MessageStats, len(s.ops))
+	for op, opStats := range s.ops {
+		ops[op.String()] = *opStats
+	}
+	return Stats{
+		ID:           nodeID,
+		MessageStats: s.total,
+		Ops:          ops,
+	}
+}
+
+// PeerMetrics reports the messages sent to and received from each peer,
+// labelled by the NodeID of the peer. To bound the cardinality of the metrics,
+// at most [maxPeers] connected peers are labelled individually. The messages of
+// the remaining peers are reported with the "other" label.
+type PeerMetrics struct {
+	maxPeers int
+
+	numSent, sentBytes, numReceived, receivedBytes *prometheus.CounterVec
+
+	lock sync.Mutex
+	// NodeID --> number of connections to the peer that are labelled
+	labelled map[ids.NodeID]int
+}
+
+func NewPeerMetrics(
+	namespace string,
+	registerer prometheus.Registerer,
+	maxPeers int,
+) (*PeerMetrics, error) {
+	m := &PeerMetrics{
+		maxPeers: maxPeers,
+		numSent: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "peer_sent",
+				Help:      "Number of messages sent to the peer",
+			},
+			[]string{"nodeID"},
+		),
+		sentBytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "peer_sent_bytes",
+				Help:      "Number of bytes of messages sent to the peer",
+			},
+			[]string{"nodeID"},
+		),
+		numReceived: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "peer_received",
+				Help:      "Number of messages received from the peer",
+			},
+			[]string{"nodeID"},
+		),
+		receivedBytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "peer_received_bytes",
+				Help:      "Number of bytes of messages received from the peer",
+			},
+			[]string{"nodeID"},
+		),
+		labelled: make(map[ids.NodeID]int),
+	}
+	errs := wrappers.Errs{}
+	errs.Add(
+		registerer.Register(m.numSent),
+		registerer.Register(m.sentBytes),
+		registerer.Register(m.numReceived),
+		registerer.Register(m.receivedBytes),
+	)
+	return m, errs.Err
+}
+
+// Connected returns the label that the messages of [nodeID] are reported
+// with. Disconnected must be called with the returned label once the
+// connection to the peer is closed.
+func (m *PeerMetrics) Connected(nodeID ids.NodeID) string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.labelled[nodeID]; !ok && len(m.labelled) >= m.maxPeers {
+		return otherPeersLabel
+	}
+	m.labelled[nodeID]++
+	return nodeID.String()
+}
+
+// Disconnected releases [label] of [nodeID]. Once no connections to the peer
+// are labelled, the metrics of the peer are removed.
+func (m *PeerMetrics) Disconnected(nodeID ids.NodeID, label string) {
+	if label == otherPeersLabel {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.labelled[nodeID]--
+	if m.labelled[nodeID] > 0 {
+		return
+	}
+	delete(m.labelled, nodeID)
+	m.numSent.DeleteLabelValues(label)
+	m.sentBytes.DeleteLabelValues(label)
+	m.numReceived.DeleteLabelValues(label)
+	m.receivedBytes.DeleteLabelValues(label)
+}
+
+func (m *PeerMetrics) Sent(label string, msgLen uint32) {
+	m.numSent.WithLabelValues(label).Inc()
+	m.sentBytes.WithLabelValues(label).Add(float64(msgLen))
+}
+
+func (m *PeerMetrics) Received(label string, msgLen uint32) {
+	m.numReceived.WithLabelValues(label).Inc()
+	m.receivedBytes.WithLabelValues(label).Add(float64(msgLen))
+}
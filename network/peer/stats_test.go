@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+)
+
+func TestStats(t *testing.T) {
+	assert := assert.New(t)
+
+	s := stats{}
+	nodeID := ids.GenerateTestNodeID()
+	assert.Equal(Stats{ID: nodeID, Ops: map[string]MessageStats{}}, s.get(nodeID))
+
+	s.sent(message.Get, 10)
+	s.sent(message.Get, 20)
+	s.received(message.Put, 100)
+
+	assert.Equal(
+		Stats{
+			ID: nodeID,
+			MessageStats: MessageStats{
+				NumSent:       2,
+				SentBytes:     30,
+				NumReceived:   1,
+				ReceivedBytes: 100,
+			},
+			Ops: map[string]MessageStats{
+				message.Get.String(): {
+					NumSent:   2,
+					SentBytes: 30,
+				},
+				message.Put.String(): {
+					NumReceived:   1,
+					ReceivedBytes: 100,
+				},
+			},
+		},
+		s.get(nodeID),
+	)
+}
+
+func TestPeerMetricsMaxPeers(t *testing.T) {
+	assert := assert.New(t)
+
+	registry := prometheus.NewRegistry()
+	m, err := NewPeerMetrics("", registry, 1)
+	assert.NoError(err)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+
+	label0 := m.Connected(nodeID0)
+	assert.Equal(nodeID0.String(), label0)
+	// A second connection to the same peer shares the label
+	duplicateLabel0 := m.Connected(nodeID0)
+	assert.Equal(label0, duplicateLabel0)
+	label1 := m.Connected(nodeID1)
+	assert.Equal(otherPeersLabel, label1)
+
+	m.Sent(label0, 10)
+	m.Received(label0, 20)
+	m.Sent(label1, 30)
+	assert.Equal(10.0, testutil.ToFloat64(m.sentBytes.WithLabelValues(label0)))
+	assert.Equal(20.0, testutil.ToFloat64(m.receivedBytes.WithLabelValues(label0)))
+	assert.Equal(30.0, testutil.ToFloat64(m.sentBytes.WithLabelValues(otherPeersLabel)))
+
+	// The metrics of a peer are removed once all its connections are closed
+	m.Disconnected(nodeID0, label0)
+	assert.Equal(2, testutil.CollectAndCount(m.sentBytes))
+	m.Disconnected(nodeID0, duplicateLabel0)
+	assert.Equal(1, testutil.CollectAndCount(m.sentBytes))
+	m.Disconnected(nodeID1, label1)
+
+	// The freed label is given to the next peer
+	assert.Equal(nodeID1.String(), m.Connected(nodeID1))
+}
@@ -102,10 +102,11 @@ func StartTestPeer(
 
 	peer := Start(
 		&Config{
-			Metrics:             metrics,
-			MessageCreator:      mc,
-			Log:                 logging.NoLog{},
-			InboundMsgThrottler: throttling.NewNoInboundThrottler(),
+			Metrics:                    metrics,
+			MessageCreator:             mc,
+			Log:                        logging.NoLog{},
+			InboundMsgThrottler:        throttling.NewNoInboundThrottler(),
+			OutboundBandwidthThrottler: throttling.NewNoOutboundBandwidthThrottler(),
 			Network: NewTestNetwork(
 				mc,
 				networkID,
@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peerfilter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+// notAllowedRule is reported when a peer is rejected because it doesn't match
+// any allowed entry
+const notAllowedRule = "notAllowed"
+
+var (
+	errInvalidIP = errors.New("invalid IP or CIDR")
+
+	_ Filter = &filter{}
+)
+
+// Filter decides which peers this node is willing to be connected to.
+type Filter interface {
+	// Allowed returns true if this node may be connected to [nodeID] at [ip].
+	// [ip] may be nil if the IP of the peer isn't known. Rejections are
+	// recorded in the metrics.
+	Allowed(nodeID ids.NodeID, ip net.IP) bool
+
+	// Rules returns the current entries of the filter
+	Rules() Rules
+
+	// Add the entries of [rules] to the filter. If the filter is backed by a
+	// file, the file is updated.
+	Add(rules Rules) error
+
+	// Remove the entries of [rules] from the filter. If the filter is backed
+	// by a file, the file is updated.
+	Remove(rules Rules) error
+
+	// Dispatch reloads the filter whenever its file is modified. Should only
+	// be called once and will run until Stop is called.
+	Dispatch()
+
+	// Stop reloading the filter
+	Stop()
+}
+
+type Config struct {
+	// Path of the JSON encoded Rules that the filter is loaded from. If empty,
+	// the filter is only modified through Add and Remove. If the file doesn't
+	// exist, the filter starts empty and the file is created on the first
+	// modification.
+	Path string `json:"path"`
+
+	// ReloadFrequency is how often the file is checked for modifications
+	ReloadFrequency time.Duration `json:"reloadFrequency"`
+}
+
+type filter struct {
+	config Config
+	log    logging.Logger
+
+	rejected *prometheus.CounterVec
+
+	lock  sync.RWMutex
+	rules *ruleSet
+	// Modification time of the file when it was last loaded or written
+	modTime time.Time
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// NewFilter returns a filter that is loaded from [config.Path]
+func NewFilter(
+	config Config,
+	log logging.Logger,
+	namespace string,
+	registerer prometheus.Registerer,
+) (Filter, error) {
+	f := &filter{
+		config: config,
+		log:    log,
+		rejected: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "peer_filter_rejected",
+				Help:      "Number of connections and IPs that were rejected by the peer filter",
+			},
+			[]string{"rule"},
+		),
+		rules: newRuleSet(),
+		stop:  make(chan struct{}),
+	}
+	if err := registerer.Register(f.rejected); err != nil {
+		return nil, err
+	}
+	if err := f.reload(); err != nil {
+		return nil, fmt.Errorf("couldn't load peer filter from %q: %w", config.Path, err)
+	}
+	return f, nil
+}
+
+func (f *filter) Allowed(nodeID ids.NodeID, ip net.IP) bool {
+	f.lock.RLock()
+	rule := f.rules.rejectedBy(nodeID, ip)
+	f.lock.RUnlock()
+
+	if rule == "" {
+		return true
+	}
+	f.log.Verbo("peer filter rejected %s at %s due to %s", nodeID, ip, rule)
+	f.rejected.WithLabelValues(rule).Inc()
+	return false
+}
+
+func (f *filter) Rules() Rules {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.rules.rules()
+}
+
+func (f *filter) Add(rules Rules) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.rules.add(rules); err != nil {
+		return err
+	}
+	return f.write()
+}
+
+func (f *filter) Remove(rules Rules) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.rules.remove(rules); err != nil {
+		return err
+	}
+	return f.write()
+}
+
+func (f *filter) Dispatch() {
+	if f.config.Path == "" || f.config.ReloadFrequency <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(f.config.ReloadFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := f.reload(); err != nil {
+				f.log.Error("couldn't reload peer filter from %q, keeping the previous entries: %s", f.config.Path, err)
+			}
+		case <-f.stop:
+			return
+		}
+	}
+}
+
+func (f *filter) Stop() {
+	f.stopOnce.Do(func() {
+		close(f.stop)
+	})
+}
+
+// reload the rules from the file if it was modified since it was last loaded
+func (f *filter) reload() error {
+	if f.config.Path == "" {
+		return nil
+	}
+
+	info, err := os.Stat(f.config.Path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	f.lock.RLock()
+	modified := !info.ModTime().Equal(f.modTime)
+	f.lock.RUnlock()
+	if !modified {
+		return nil
+	}
+
+	bytes, err := os.ReadFile(f.config.Path)
+	if err != nil {
+		return err
+	}
+	rules := Rules{}
+	if err := json.Unmarshal(bytes, &rules); err != nil {
+		return err
+	}
+	ruleSet := newRuleSet()
+	if err := ruleSet.add(rules); err != nil {
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.rules = ruleSet
+	f.modTime = info.ModTime()
+	f.log.Info("loaded peer filter from %q", f.config.Path)
+	return nil
+}
+
+// write the rules to the file, if there is one.
+// Assumes [f.lock] is held.
+func (f *filter) write() error {
+	if f.config.Path == "" {
+		return nil
+	}
+
+	bytes, err := json.MarshalIndent(f.rules.rules(), "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := perms.WriteFile(f.config.Path, bytes, perms.ReadWrite); err != nil {
+		return fmt.Errorf("couldn't write peer filter to %q: %w", f.config.Path, err)
+	}
+	info, err := os.Stat(f.config.Path)
+	if err != nil {
+		return err
+	}
+	f.modTime = info.ModTime()
+	return nil
+}
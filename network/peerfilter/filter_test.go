@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peerfilter
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func TestFilterAllowed(t *testing.T) {
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+	ip0 := net.IPv4(10, 0, 0, 1)
+	ip1 := net.IPv4(192, 168, 0, 1)
+	ip2 := net.ParseIP("2001:db8::1")
+
+	tests := map[string]struct {
+		rules    Rules
+		nodeID   ids.NodeID
+		ip       net.IP
+		expected bool
+		rule     string
+	}{
+		"empty filter": {
+			nodeID:   nodeID0,
+			ip:       ip0,
+			expected: true,
+		},
+		"denied node ID": {
+			rules: Rules{
+				DeniedNodeIDs: []ids.NodeID{nodeID0},
+			},
+			nodeID:   nodeID0,
+			ip:       ip0,
+			expected: false,
+			rule:     "deny:" + nodeID0.String(),
+		},
+		"denied IP": {
+			rules: Rules{
+				DeniedIPs: []string{"10.0.0.0/8"},
+			},
+			nodeID:   nodeID0,
+			ip:       ip0,
+			expected: false,
+			rule:     "deny:10.0.0.0/8",
+		},
+		"denied single IPv6": {
+			rules: Rules{
+				DeniedIPs: []string{"2001:db8::1"},
+			},
+			nodeID:   nodeID0,
+			ip:       ip2,
+			expected: false,
+			rule:     "deny:2001:db8::1/128",
+		},
+		"unknown IP isn't denied": {
+			rules: Rules{
+				DeniedIPs: []string{"10.0.0.0/8"},
+			},
+			nodeID:   nodeID0,
+			expected: true,
+		},
+		"deny overrides allow": {
+			rules: Rules{
+				AllowedNodeIDs: []ids.NodeID{nodeID0},
+				DeniedIPs:      []string{"10.0.0.1"},
+			},
+			nodeID:   nodeID0,
+			ip:       ip0,
+			expected: false,
+			rule:     "deny:10.0.0.1/32",
+		},
+		"allowed node ID": {
+			rules: Rules{
+				AllowedNodeIDs: []ids.NodeID{nodeID0},
+			},
+			nodeID:   nodeID0,
+			ip:       ip1,
+			expected: true,
+		},
+		"allowed IP": {
+			rules: Rules{
+				AllowedNodeIDs: []ids.NodeID{nodeID1},
+				AllowedIPs:     []string{"192.168.0.0/16"},
+			},
+			nodeID:   nodeID0,
+			ip:       ip1,
+			expected: true,
+		},
+		"not allowed": {
+			rules: Rules{
+				AllowedNodeIDs: []ids.NodeID{nodeID1},
+				AllowedIPs:     []string{"192.168.0.0/16"},
+			},
+			nodeID:   nodeID0,
+			ip:       ip0,
+			expected: false,
+			rule:     notAllowedRule,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			f, err := NewFilter(Config{}, logging.NoLog{}, "", prometheus.NewRegistry())
+			assert.NoError(err)
+			assert.NoError(f.Add(test.rules))
+
+			assert.Equal(test.expected, f.Allowed(test.nodeID, test.ip))
+			if !test.expected {
+				rejected := f.(*filter).rejected.WithLabelValues(test.rule)
+				assert.Equal(1.0, testutil.ToFloat64(rejected))
+			}
+		})
+	}
+}
+
+func TestFilterAddRemove(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "peer-filter.json")
+	f, err := NewFilter(Config{Path: path}, logging.NoLog{}, "", prometheus.NewRegistry())
+	assert.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	assert.NoError(f.Add(Rules{
+		DeniedNodeIDs: []ids.NodeID{nodeID},
+		DeniedIPs:     []string{"10.0.0.0/8", "1.2.3.4"},
+	}))
+	assert.False(f.Allowed(nodeID, nil))
+
+	// Invalid entries don't modify the filter
+	err = f.Add(Rules{
+		AllowedNodeIDs: []ids.NodeID{nodeID},
+		AllowedIPs:     []string{"not an IP"},
+	})
+	assert.ErrorIs(err, errInvalidIP)
+	assert.Empty(f.Rules().AllowedNodeIDs)
+
+	assert.NoError(f.Remove(Rules{
+		DeniedNodeIDs: []ids.NodeID{nodeID},
+		DeniedIPs:     []string{"1.2.3.4/32"},
+	}))
+	assert.True(f.Allowed(nodeID, nil))
+
+	expectedRules := Rules{
+		AllowedNodeIDs: []ids.NodeID{},
+		DeniedNodeIDs:  []ids.NodeID{},
+		AllowedIPs:     []string{},
+		DeniedIPs:      []string{"10.0.0.0/8"},
+	}
+	assert.Equal(expectedRules, f.Rules())
+
+	// Modifications are persisted to the file
+	reloaded, err := NewFilter(Config{Path: path}, logging.NoLog{}, "", prometheus.NewRegistry())
+	assert.NoError(err)
+	assert.Equal(expectedRules, reloaded.Rules())
+}
+
+func TestFilterReload(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "peer-filter.json")
+	f, err := NewFilter(
+		Config{
+			Path:            path,
+			ReloadFrequency: time.Millisecond,
+		},
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+	)
+	assert.NoError(err)
+	go f.Dispatch()
+	defer f.Stop()
+
+	nodeID := ids.GenerateTestNodeID()
+	assert.True(f.Allowed(nodeID, nil))
+
+	writeRules := func(rules interface{}) {
+		bytes, err := json.Marshal(rules)
+		assert.NoError(err)
+		assert.NoError(os.WriteFile(path, bytes, 0o600))
+	}
+
+	writeRules(Rules{DeniedNodeIDs: []ids.NodeID{nodeID}})
+	assert.Eventually(func() bool {
+		return !f.Allowed(nodeID, nil)
+	}, 5*time.Second, time.Millisecond)
+
+	// Invalid files are ignored
+	writeRules(Rules{DeniedIPs: []string{"not an IP"}})
+	time.Sleep(10 * time.Millisecond)
+	assert.False(f.Allowed(nodeID, nil))
+}
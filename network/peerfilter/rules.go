@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peerfilter
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// Rules are the entries of a peer filter. IPs are given in CIDR notation. A
+// single IP may be given without a prefix length, in which case it only
+// matches that IP.
+//
+// A peer is rejected if its NodeID or IP is denied. If any entries are
+// allowed, a peer is also rejected unless its NodeID or IP is allowed.
+type Rules struct {
+	AllowedNodeIDs []ids.NodeID `json:"allowedNodeIDs"`
+	DeniedNodeIDs  []ids.NodeID `json:"deniedNodeIDs"`
+	AllowedIPs     []string     `json:"allowedIPs"`
+	DeniedIPs      []string     `json:"deniedIPs"`
+}
+
+// ruleSet is the parsed representation of Rules
+type ruleSet struct {
+	allowedNodeIDs ids.NodeIDSet
+	deniedNodeIDs  ids.NodeIDSet
+	// Canonical CIDR string --> subnet
+	allowedIPs map[string]*net.IPNet
+	deniedIPs  map[string]*net.IPNet
+}
+
+func newRuleSet() *ruleSet {
+	return &ruleSet{
+		allowedNodeIDs: ids.NodeIDSet{},
+		deniedNodeIDs:  ids.NodeIDSet{},
+		allowedIPs:     make(map[string]*net.IPNet),
+		deniedIPs:      make(map[string]*net.IPNet),
+	}
+}
+
+// parseCIDR parses [cidr] into a subnet. If [cidr] is a single IP, the
+// returned subnet only contains that IP.
+func parseCIDR(cidr string) (*net.IPNet, error) {
+	if !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return nil, fmt.Errorf("%w: %q", errInvalidIP, cidr)
+		}
+		if ipv4 := ip.To4(); ipv4 != nil {
+			ip = ipv4
+		}
+		return &net.IPNet{
+			IP:   ip,
+			Mask: net.CIDRMask(len(ip)*8, len(ip)*8),
+		}, nil
+	}
+
+	_, subnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", errInvalidIP, cidr)
+	}
+	return subnet, nil
+}
+
+func parseCIDRs(cidrs []string) (map[string]*net.IPNet, error) {
+	subnets := make(map[string]*net.IPNet, len(cidrs))
+	for _, cidr := range cidrs {
+		subnet, err := parseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		subnets[subnet.String()] = subnet
+	}
+	return subnets, nil
+}
+
+// add the entries of [rules] to this rule set. If [rules] contains an invalid
+// entry, this rule set isn't modified.
+func (s *ruleSet) add(rules Rules) error {
+	allowedIPs, err := parseCIDRs(rules.AllowedIPs)
+	if err != nil {
+		return err
+	}
+	deniedIPs, err := parseCIDRs(rules.DeniedIPs)
+	if err != nil {
+		return err
+	}
+
+	s.allowedNodeIDs.Add(rules.AllowedNodeIDs...)
+	s.deniedNodeIDs.Add(rules.DeniedNodeIDs...)
+	for key, subnet := range allowedIPs {
+		s.allowedIPs[key] = subnet
+	}
+	for key, subnet := range deniedIPs {
+		s.deniedIPs[key] = subnet
+	}
+	return nil
+}
+
+// remove the entries of [rules] from this rule set. Entries that aren't in
+// this rule set are ignored. If [rules] contains an invalid entry, this rule
+// set isn't modified.
+func (s *ruleSet) remove(rules Rules) error {
+	allowedIPs, err := parseCIDRs(rules.AllowedIPs)
+	if err != nil {
+		return err
+	}
+	deniedIPs, err := parseCIDRs(rules.DeniedIPs)
+	if err != nil {
+		return err
+	}
+
+	s.allowedNodeIDs.Remove(rules.AllowedNodeIDs...)
+	s.deniedNodeIDs.Remove(rules.DeniedNodeIDs...)
+	for key := range allowedIPs {
+		delete(s.allowedIPs, key)
+	}
+	for key := range deniedIPs {
+		delete(s.deniedIPs, key)
+	}
+	return nil
+}
+
+// rejectedBy returns the rule that rejects a peer with [nodeID] at [ip]. If
+// the peer isn't rejected, the empty string is returned. [ip] may be nil if
+// the IP of the peer isn't known.
+func (s *ruleSet) rejectedBy(nodeID ids.NodeID, ip net.IP) string {
+	if s.deniedNodeIDs.Contains(nodeID) {
+		return "deny:" + nodeID.String()
+	}
+	if key, denied := contains(s.deniedIPs, ip); denied {
+		return "deny:" + key
+	}
+	if s.allowedNodeIDs.Len() == 0 && len(s.allowedIPs) == 0 {
+		return ""
+	}
+	if s.allowedNodeIDs.Contains(nodeID) {
+		return ""
+	}
+	if _, allowed := contains(s.allowedIPs, ip); allowed {
+		return ""
+	}
+	return notAllowedRule
+}
+
+// rules returns the entries of this rule set in a deterministic order
+func (s *ruleSet) rules() Rules {
+	return Rules{
+		AllowedNodeIDs: s.allowedNodeIDs.SortedList(),
+		DeniedNodeIDs:  s.deniedNodeIDs.SortedList(),
+		AllowedIPs:     sortedKeys(s.allowedIPs),
+		DeniedIPs:      sortedKeys(s.deniedIPs),
+	}
+}
+
+// contains returns the key of a subnet in [subnets] that contains [ip]
+func contains(subnets map[string]*net.IPNet, ip net.IP) (string, bool) {
+	if ip == nil {
+		return "", false
+	}
+	for key, subnet := range subnets {
+		if subnet.Contains(ip) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func sortedKeys(subnets map[string]*net.IPNet) []string {
+	keys := make([]string, 0, len(subnets))
+	for key := range subnets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var _ OutboundBandwidthThrottler = &noOutboundBandwidthThrottler{}
+
+// Returns an OutboundBandwidthThrottler where Acquire() always returns
+// immediately.
+func NewNoOutboundBandwidthThrottler() OutboundBandwidthThrottler {
+	return &noOutboundBandwidthThrottler{}
+}
+
+// [Acquire] always returns immediately.
+type noOutboundBandwidthThrottler struct{}
+
+func (*noOutboundBandwidthThrottler) Acquire(context.Context, uint64, ids.NodeID, func()) bool {
+	return true
+}
+
+func (*noOutboundBandwidthThrottler) AddNode(ids.NodeID) {}
+
+func (*noOutboundBandwidthThrottler) RemoveNode(ids.NodeID) {}
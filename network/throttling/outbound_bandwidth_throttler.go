@@ -0,0 +1,229 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/metric"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var _ OutboundBandwidthThrottler = &outboundBandwidthThrottler{}
+
+// OutboundBandwidthThrottler rate-limits the bandwidth used to send messages,
+// both to each peer and to all peers combined. It uses token buckets, where
+// each token is 1 byte. See https://pkg.go.dev/golang.org/x/time/rate#Limiter
+type OutboundBandwidthThrottler interface {
+	// Blocks until a message of size [msgSize] can be sent to [nodeID].
+	// If the message can't be sent immediately, [beforeWait] is called
+	// before blocking.
+	// Returns false if [ctx] is canceled before the message can be sent.
+	// AddNode([nodeID]) must have been called since the last time
+	// RemoveNode([nodeID]) was called, if any.
+	// It's safe for multiple goroutines to concurrently call Acquire.
+	Acquire(ctx context.Context, msgSize uint64, nodeID ids.NodeID, beforeWait func()) bool
+
+	// Add a new node to this throttler.
+	// Must be called before Acquire(..., [nodeID], ...) is called.
+	// It's safe for multiple goroutines to concurrently call AddNode.
+	AddNode(nodeID ids.NodeID)
+
+	// Remove a node from this throttler.
+	// Must be called when we stop sending messages to [nodeID].
+	// It's safe for multiple goroutines to concurrently call RemoveNode.
+	RemoveNode(nodeID ids.NodeID)
+}
+
+type OutboundBandwidthThrottlerConfig struct {
+	// Rate at which the outbound bandwidth consumable by a peer replenishes.
+	// If 0, the outbound bandwidth of each peer isn't limited.
+	RefillRate uint64 `json:"bandwidthRefillRate"`
+	// Max amount of outbound bandwidth that can accumulate for a given peer
+	MaxBurstSize uint64 `json:"bandwidthMaxBurstSize"`
+	// Rate at which the outbound bandwidth consumable by all peers combined
+	// replenishes. If 0, the outbound bandwidth of this node isn't limited.
+	NodeRefillRate uint64 `json:"nodeBandwidthRefillRate"`
+	// Max amount of outbound bandwidth that can accumulate for all peers
+	// combined
+	NodeMaxBurstSize uint64 `json:"nodeBandwidthMaxBurstSize"`
+}
+
+// NewOutboundBandwidthThrottler returns a throttler that limits the outbound
+// bandwidth according to [config]. If neither the bandwidth of each peer nor
+// the bandwidth of this node is limited, messages are never throttled.
+func NewOutboundBandwidthThrottler(
+	log logging.Logger,
+	namespace string,
+	registerer prometheus.Registerer,
+	config OutboundBandwidthThrottlerConfig,
+) (OutboundBandwidthThrottler, error) {
+	if config.RefillRate == 0 && config.NodeRefillRate == 0 {
+		return NewNoOutboundBandwidthThrottler(), nil
+	}
+
+	errs := wrappers.Errs{}
+	t := &outboundBandwidthThrottler{
+		OutboundBandwidthThrottlerConfig: config,
+		log:                              log,
+		limiters:                         make(map[ids.NodeID]*rate.Limiter),
+		metrics: outboundBandwidthThrottlerMetrics{
+			acquireLatency: metric.NewAveragerWithErrs(
+				namespace,
+				"bandwidth_throttler_outbound_acquire_latency",
+				"average time (in ns) to acquire bytes from the outbound bandwidth throttler",
+				registerer,
+				&errs,
+			),
+			awaitingAcquire: prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "bandwidth_throttler_outbound_awaiting_acquire",
+				Help:      "Number of outbound messages waiting to acquire bandwidth from the outbound bandwidth throttler",
+			}),
+			delayed: prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "bandwidth_throttler_outbound_delayed",
+				Help:      "Number of outbound messages that were delayed by the outbound bandwidth throttler",
+			}),
+		},
+	}
+	if config.NodeRefillRate != 0 {
+		t.nodeLimiter = rate.NewLimiter(rate.Limit(config.NodeRefillRate), int(config.NodeMaxBurstSize))
+	}
+	errs.Add(
+		registerer.Register(t.metrics.awaitingAcquire),
+		registerer.Register(t.metrics.delayed),
+	)
+	return t, errs.Err
+}
+
+type outboundBandwidthThrottlerMetrics struct {
+	acquireLatency  metric.Averager
+	awaitingAcquire prometheus.Gauge
+	delayed         prometheus.Counter
+}
+
+type outboundBandwidthThrottler struct {
+	OutboundBandwidthThrottlerConfig
+	metrics outboundBandwidthThrottlerMetrics
+	log     logging.Logger
+	// Limits the bandwidth of all peers combined. nil if this node's
+	// bandwidth isn't limited.
+	nodeLimiter *rate.Limiter
+	lock        sync.RWMutex
+	// Node ID --> token bucket based rate limiter where each token
+	// is a byte of bandwidth. Empty if the bandwidth of each peer isn't
+	// limited.
+	limiters map[ids.NodeID]*rate.Limiter
+}
+
+// See OutboundBandwidthThrottler.
+func (t *outboundBandwidthThrottler) Acquire(
+	ctx context.Context,
+	msgSize uint64,
+	nodeID ids.NodeID,
+	beforeWait func(),
+) bool {
+	startTime := time.Now()
+	t.metrics.awaitingAcquire.Inc()
+	defer func() {
+		t.metrics.acquireLatency.Observe(float64(time.Since(startTime)))
+		t.metrics.awaitingAcquire.Dec()
+	}()
+
+	var reservations []*rate.Reservation
+	if t.RefillRate != 0 {
+		t.lock.RLock()
+		limiter, ok := t.limiters[nodeID]
+		t.lock.RUnlock()
+		if !ok {
+			// This should never happen. If it is, the caller is misusing this
+			// struct.
+			t.log.Debug("tried to acquire %d bytes for %s but that node isn't registered", msgSize, nodeID)
+		} else {
+			reservations = append(reservations, reserve(limiter, startTime, msgSize))
+		}
+	}
+	if t.nodeLimiter != nil {
+		reservations = append(reservations, reserve(t.nodeLimiter, startTime, msgSize))
+	}
+
+	var delay time.Duration
+	for _, reservation := range reservations {
+		if reservationDelay := reservation.DelayFrom(startTime); reservationDelay > delay {
+			delay = reservationDelay
+		}
+	}
+	if delay == 0 {
+		return true
+	}
+
+	t.metrics.delayed.Inc()
+	beforeWait()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		// Return the bytes that won't be sent to the token buckets
+		for _, reservation := range reservations {
+			reservation.Cancel()
+		}
+		t.log.Debug("error while awaiting %d bytes for %s: %s", msgSize, nodeID, ctx.Err())
+		return false
+	}
+}
+
+// reserve [msgSize] bytes from [limiter]. Messages that are larger than the
+// limiter's burst size are allowed to use the burst size worth of bytes,
+// rather than never being sent.
+func reserve(limiter *rate.Limiter, now time.Time, msgSize uint64) *rate.Reservation {
+	n := int(msgSize)
+	if burst := limiter.Burst(); n > burst {
+		n = burst
+	}
+	return limiter.ReserveN(now, n)
+}
+
+// See OutboundBandwidthThrottler.
+func (t *outboundBandwidthThrottler) AddNode(nodeID ids.NodeID) {
+	if t.RefillRate == 0 {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.limiters[nodeID]; ok {
+		t.log.Debug("tried to add %s but it's already registered", nodeID)
+		return
+	}
+	t.limiters[nodeID] = rate.NewLimiter(rate.Limit(t.RefillRate), int(t.MaxBurstSize))
+}
+
+// See OutboundBandwidthThrottler.
+func (t *outboundBandwidthThrottler) RemoveNode(nodeID ids.NodeID) {
+	if t.RefillRate == 0 {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.limiters[nodeID]; !ok {
+		t.log.Debug("tried to remove %s but it isn't registered", nodeID)
+		return
+	}
+	delete(t.limiters, nodeID)
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func TestNoOutboundBandwidthThrottlerWhenUnlimited(t *testing.T) {
+	assert := assert.New(t)
+
+	throttler, err := NewOutboundBandwidthThrottler(logging.NoLog{}, "", prometheus.NewRegistry(), OutboundBandwidthThrottlerConfig{})
+	assert.NoError(err)
+	_, ok := throttler.(*noOutboundBandwidthThrottler)
+	assert.True(ok)
+
+	nodeID := ids.GenerateTestNodeID()
+	throttler.AddNode(nodeID)
+	assert.True(throttler.Acquire(context.Background(), 1<<30, nodeID, func() { t.Fatal("shouldn't wait") }))
+	throttler.RemoveNode(nodeID)
+}
+
+func TestOutboundBandwidthThrottler(t *testing.T) {
+	assert := assert.New(t)
+
+	config := OutboundBandwidthThrottlerConfig{
+		RefillRate:   1000,
+		MaxBurstSize: 10,
+	}
+	throttlerIntf, err := NewOutboundBandwidthThrottler(logging.NoLog{}, "", prometheus.NewRegistry(), config)
+	assert.NoError(err)
+	throttler, ok := throttlerIntf.(*outboundBandwidthThrottler)
+	assert.True(ok)
+	assert.Nil(throttler.nodeLimiter)
+	assert.Len(throttler.limiters, 0)
+
+	nodeID1, nodeID2 := ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
+	throttler.AddNode(nodeID1)
+	throttler.AddNode(nodeID2)
+	assert.Len(throttler.limiters, 2)
+
+	// The burst is available immediately
+	waited := false
+	beforeWait := func() { waited = true }
+	assert.True(throttler.Acquire(context.Background(), 10, nodeID1, beforeWait))
+	assert.False(waited)
+
+	// The bucket of [nodeID1] is empty, so the next message waits
+	assert.True(throttler.Acquire(context.Background(), 5, nodeID1, beforeWait))
+	assert.True(waited)
+
+	// Other peers have their own bucket
+	waited = false
+	assert.True(throttler.Acquire(context.Background(), 10, nodeID2, beforeWait))
+	assert.False(waited)
+
+	// Messages larger than the burst size are still sent
+	waited = false
+	assert.True(throttler.Acquire(context.Background(), 100, nodeID2, beforeWait))
+	assert.True(waited)
+
+	throttler.RemoveNode(nodeID1)
+	throttler.RemoveNode(nodeID2)
+	assert.Len(throttler.limiters, 0)
+}
+
+func TestOutboundBandwidthThrottlerNodeLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	config := OutboundBandwidthThrottlerConfig{
+		NodeRefillRate:   1000,
+		NodeMaxBurstSize: 10,
+	}
+	throttler, err := NewOutboundBandwidthThrottler(logging.NoLog{}, "", prometheus.NewRegistry(), config)
+	assert.NoError(err)
+
+	nodeID1, nodeID2 := ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
+	throttler.AddNode(nodeID1)
+	throttler.AddNode(nodeID2)
+
+	waited := false
+	beforeWait := func() { waited = true }
+	assert.True(throttler.Acquire(context.Background(), 10, nodeID1, beforeWait))
+	assert.False(waited)
+
+	// The bucket is shared by all peers
+	assert.True(throttler.Acquire(context.Background(), 5, nodeID2, beforeWait))
+	assert.True(waited)
+}
+
+func TestOutboundBandwidthThrottlerCancel(t *testing.T) {
+	assert := assert.New(t)
+
+	config := OutboundBandwidthThrottlerConfig{
+		RefillRate:       1,
+		MaxBurstSize:     10,
+		NodeRefillRate:   1,
+		NodeMaxBurstSize: 10,
+	}
+	throttlerIntf, err := NewOutboundBandwidthThrottler(logging.NoLog{}, "", prometheus.NewRegistry(), config)
+	assert.NoError(err)
+	throttler, ok := throttlerIntf.(*outboundBandwidthThrottler)
+	assert.True(ok)
+
+	nodeID := ids.GenerateTestNodeID()
+	throttler.AddNode(nodeID)
+	assert.True(throttler.Acquire(context.Background(), 10, nodeID, func() {}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.False(throttler.Acquire(ctx, 10, nodeID, cancel))
+
+	// The canceled reservations are returned, so the buckets aren't
+	// charged for the message that wasn't sent
+	now := time.Now()
+	assert.LessOrEqual(throttler.limiters[nodeID].ReserveN(now, 1).DelayFrom(now), 2*time.Second)
+	assert.LessOrEqual(throttler.nodeLimiter.ReserveN(now, 1).DelayFrom(now), 2*time.Second)
+}
@@ -10,10 +10,14 @@ import (
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/ipcs"
 	"github.com/ava-labs/avalanchego/nat"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/snow/consensus/avalanche"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/trace"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -29,6 +33,8 @@ type IPCConfig struct {
 	IPCAPIEnabled      bool     `json:"ipcAPIEnabled"`
 	IPCPath            string   `json:"ipcPath"`
 	IPCDefaultChainIDs []string `json:"ipcDefaultChainIDs"`
+	// Chain ID --> Sinks that the chain's accepted containers are delivered to
+	IPCSinks map[ids.ID][]ipcs.SinkConfig `json:"ipcSinks"`
 }
 
 type APIAuthConfig struct {
@@ -62,11 +68,12 @@ type APIConfig struct {
 	IPCConfig        `json:"ipcConfig"`
 
 	// Enable/Disable APIs
-	AdminAPIEnabled    bool `json:"adminAPIEnabled"`
-	InfoAPIEnabled     bool `json:"infoAPIEnabled"`
-	KeystoreAPIEnabled bool `json:"keystoreAPIEnabled"`
-	MetricsAPIEnabled  bool `json:"metricsAPIEnabled"`
-	HealthAPIEnabled   bool `json:"healthAPIEnabled"`
+	AdminAPIEnabled         bool `json:"adminAPIEnabled"`
+	InfoAPIEnabled          bool `json:"infoAPIEnabled"`
+	KeystoreAPIEnabled      bool `json:"keystoreAPIEnabled"`
+	MetricsAPIEnabled       bool `json:"metricsAPIEnabled"`
+	HealthAPIEnabled        bool `json:"healthAPIEnabled"`
+	SubscriptionsAPIEnabled bool `json:"subscriptionsAPIEnabled"`
 }
 
 type IPConfig struct {
@@ -79,6 +86,11 @@ type IPConfig struct {
 	DynamicUpdateDuration time.Duration `json:"dynamicUpdateDuration"`
 	// Tries to resolve our IP from an external source
 	DynamicPublicIPResolver dynamicip.Resolver `json:"-"`
+	// IPv6 address advertised in addition to [IPPort]. nil if this node
+	// doesn't advertise an IPv6 address in addition to [IPPort].
+	IPv6Port ips.DynamicIPPort `json:"ipv6,omitempty"`
+	// Tries to resolve our IPv6 address from an external source
+	DynamicPublicIPv6Resolver dynamicip.Resolver `json:"-"`
 }
 
 type StakingConfig struct {
@@ -113,6 +125,15 @@ type BootstrapConfig struct {
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int `json:"bootstrapAncestorsMaxContainersReceived"`
 
+	// Number of workers that pre-verify upcoming blocks while snowman chains
+	// execute the blocks they bootstrapped
+	BootstrapPreVerificationWorkers int `json:"bootstrapPreVerificationWorkers"`
+
+	// Checkpoint that a snowman chain is bootstrapped up to from a local
+	// archive before it's bootstrapped from peers. nil if chains are only
+	// bootstrapped from peers.
+	BootstrapCheckpoint *bootstrap.CheckpointConfig `json:"bootstrapCheckpoint"`
+
 	// Max time to spend fetching a container and its
 	// ancestors while responding to a GetAncestors message
 	BootstrapMaxTimeGetAncestors time.Duration `json:"bootstrapMaxTimeGetAncestors"`
@@ -130,6 +151,10 @@ type DatabaseConfig struct {
 
 	// Path to config file
 	Config []byte `json:"-"`
+
+	// Path to a database snapshot to restore before the database is opened.
+	// Empty if no snapshot should be restored.
+	RestoreDir string `json:"restoreDir"`
 }
 
 // Config contains all of the configurations of an Avalanche node.
@@ -192,10 +217,21 @@ type Config struct {
 	ConsensusShutdownTimeout time.Duration       `json:"consensusShutdownTimeout"`
 	// Gossip a container in the accepted frontier every [ConsensusGossipFrequency]
 	ConsensusGossipFrequency time.Duration `json:"consensusGossipFreq"`
+	// Weights of the lanes of each chain's handler message queue
+	HandlerLaneWeights handler.LaneWeights `json:"handlerLaneWeights"`
+	// Recording of the polls of the blocks processing in each snowman chain
+	ConsensusTraceConfig trace.Config `json:"consensusTraceConfig"`
 
 	// Subnet Whitelist
 	WhitelistedSubnets ids.Set `json:"whitelistedSubnets"`
 
+	// True if the P-chain mempool should prioritize decision txs by fee rate
+	MempoolFeePriority bool `json:"mempoolFeePriority"`
+
+	// Number of accepted P-chain blocks that the P-chain state history is kept
+	// for. If 0, the history isn't recorded.
+	StateHistoryRetention uint64 `json:"stateHistoryRetention"`
+
 	// SubnetConfigs
 	SubnetConfigs map[ids.ID]chains.SubnetConfig `json:"subnetConfigs"`
 
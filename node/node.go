@@ -28,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/api/keystore"
 	"github.com/ava-labs/avalanchego/api/metrics"
 	"github.com/ava-labs/avalanchego/api/server"
+	"github.com/ava-labs/avalanchego/api/subscriptions"
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/database"
@@ -75,8 +76,10 @@ import (
 )
 
 var (
-	genesisHashKey  = []byte("genesisID")
-	indexerDBPrefix = []byte{0x00}
+	genesisHashKey = []byte("genesisID")
+	// IndexerDBPrefix is the prefix of the indexer's database in the node's
+	// database
+	IndexerDBPrefix = []byte{0x00}
 
 	errInvalidTLSKey = errors.New("invalid TLS key")
 	errShuttingDown  = errors.New("server shutting down")
@@ -188,6 +191,8 @@ type Node struct {
 // Assumes [n.CPUTracker] and [n.CPUTargeter] have been initialized.
 func (n *Node) initNetworking(primaryNetVdrs validators.Set) error {
 	currentIPPort := n.Config.IPPort.IPPort()
+	// Listening on the unspecified address accepts both IPv4 and IPv6
+	// connections, so dual-stack nodes only need a single listener.
 	listener, err := net.Listen(constants.NetworkType, fmt.Sprintf(":%d", currentIPPort.Port))
 	if err != nil {
 		return err
@@ -205,6 +210,9 @@ func (n *Node) initNetworking(primaryNetVdrs validators.Set) error {
 		}
 		n.Log.Info("this node's IP is set to: %q", ipPort)
 	}
+	if n.Config.IPv6Port != nil {
+		n.Log.Info("this node's IPv6 address is set to: %q", n.Config.IPv6Port.IPPort())
+	}
 
 	tlsKey, ok := n.Config.StakingTLSCert.PrivateKey.(crypto.Signer)
 	if !ok {
@@ -264,6 +272,7 @@ func (n *Node) initNetworking(primaryNetVdrs validators.Set) error {
 	n.Config.NetworkConfig.Namespace = n.networkNamespace
 	n.Config.NetworkConfig.MyNodeID = n.ID
 	n.Config.NetworkConfig.MyIPPort = n.Config.IPPort
+	n.Config.NetworkConfig.MyIPv6Port = n.Config.IPv6Port
 	n.Config.NetworkConfig.NetworkID = n.Config.NetworkID
 	n.Config.NetworkConfig.Validators = n.vdrs
 	n.Config.NetworkConfig.Beacons = n.beacons
@@ -276,13 +285,18 @@ func (n *Node) initNetworking(primaryNetVdrs validators.Set) error {
 	n.Config.NetworkConfig.CPUTargeter = n.cpuTargeter
 	n.Config.NetworkConfig.DiskTargeter = n.diskTargeter
 
+	networkDialer, err := dialer.NewDialer(constants.NetworkType, n.Config.NetworkConfig.DialerConfig, n.Log)
+	if err != nil {
+		return fmt.Errorf("couldn't create dialer: %w", err)
+	}
+
 	n.Net, err = network.NewNetwork(
 		&n.Config.NetworkConfig,
 		n.msgCreator,
 		n.MetricsRegisterer,
 		n.Log,
 		listener,
-		dialer.NewDialer(constants.NetworkType, n.Config.NetworkConfig.DialerConfig, n.Log),
+		networkDialer,
 		consensusRouter,
 		n.benchlistManager,
 	)
@@ -460,7 +474,7 @@ func (n *Node) initIPCs() error {
 	}
 
 	var err error
-	n.IPCs, err = ipcs.NewChainIPCs(n.Log, n.Config.IPCPath, n.Config.NetworkID, n.ConsensusAcceptorGroup, n.DecisionAcceptorGroup, chainIDs)
+	n.IPCs, err = ipcs.NewChainIPCs(n.Log, n.Config.IPCPath, n.Config.NetworkID, n.ConsensusAcceptorGroup, n.DecisionAcceptorGroup, chainIDs, n.Config.IPCSinks)
 	return err
 }
 
@@ -469,7 +483,7 @@ func (n *Node) initIPCs() error {
 // [n.ConsensusAcceptorGroup], [n.Log], [n.APIServer], [n.chainManager] are
 // initialized
 func (n *Node) initIndexer() error {
-	txIndexerDB := prefixdb.New(indexerDBPrefix, n.DB)
+	txIndexerDB := prefixdb.New(IndexerDBPrefix, n.DB)
 	var err error
 	n.indexer, err = indexer.NewIndexer(indexer.Config{
 		IndexingEnabled:        n.Config.IndexAPIEnabled,
@@ -491,6 +505,35 @@ func (n *Node) initIndexer() error {
 	return nil
 }
 
+// initSubscriptionsAPI initializes the websocket API that streams accepted
+// containers. Assumes n.chainManager and n.indexer already initialized.
+func (n *Node) initSubscriptionsAPI() error {
+	if !n.Config.SubscriptionsAPIEnabled {
+		n.Log.Info("skipping subscriptions API initialization because it has been disabled")
+		return nil
+	}
+
+	n.Log.Info("initializing subscriptions API")
+	server := subscriptions.New(
+		n.Log,
+		n.chainManager,
+		n.indexer,
+		n.DecisionAcceptorGroup,
+		n.ConsensusAcceptorGroup,
+	)
+	// Chain manager will notify the server when a chain is created
+	n.chainManager.AddRegistrant(server)
+	return n.APIServer.AddRoute(
+		&common.HTTPHandler{
+			LockOptions: common.NoLock,
+			Handler:     server,
+		},
+		&sync.RWMutex{},
+		"subscriptions",
+		"",
+	)
+}
+
 // Initializes the Platform chain.
 // Its genesis data specifies the other chains that should be created.
 func (n *Node) initChains(genesisBytes []byte) {
@@ -654,10 +697,14 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 		SubnetConfigs:                           n.Config.SubnetConfigs,
 		ChainConfigs:                            n.Config.ChainConfigs,
 		ConsensusGossipFrequency:                n.Config.ConsensusGossipFrequency,
+		HandlerLaneWeights:                      n.Config.HandlerLaneWeights,
+		ConsensusTraceConfig:                    n.Config.ConsensusTraceConfig,
 		GossipConfig:                            n.Config.GossipConfig,
 		BootstrapMaxTimeGetAncestors:            n.Config.BootstrapMaxTimeGetAncestors,
 		BootstrapAncestorsMaxContainersSent:     n.Config.BootstrapAncestorsMaxContainersSent,
 		BootstrapAncestorsMaxContainersReceived: n.Config.BootstrapAncestorsMaxContainersReceived,
+		BootstrapPreVerificationWorkers:         n.Config.BootstrapPreVerificationWorkers,
+		BootstrapCheckpoint:                     n.Config.BootstrapCheckpoint,
 		ApricotPhase4Time:                       version.GetApricotPhase4Time(n.Config.NetworkID),
 		ApricotPhase4MinPChainHeight:            version.GetApricotPhase4MinPChainHeight(n.Config.NetworkID),
 		ResourceTracker:                         n.resourceTracker,
@@ -700,6 +747,8 @@ func (n *Node) initVMs() error {
 				UptimeLockedCalculator: n.uptimeCalculator,
 				StakingEnabled:         n.Config.EnableStaking,
 				WhitelistedSubnets:     n.Config.WhitelistedSubnets,
+				MempoolFeePriority:     n.Config.MempoolFeePriority,
+				StateHistoryRetention:  n.Config.StateHistoryRetention,
 				TxFee:                  n.Config.TxFee,
 				CreateAssetTxFee:       n.Config.CreateAssetTxFee,
 				CreateSubnetTxFee:      n.Config.CreateSubnetTxFee,
@@ -715,6 +764,7 @@ func (n *Node) initVMs() error {
 				ApricotPhase3Time:      version.GetApricotPhase3Time(n.Config.NetworkID),
 				ApricotPhase4Time:      version.GetApricotPhase4Time(n.Config.NetworkID),
 				ApricotPhase5Time:      version.GetApricotPhase5Time(n.Config.NetworkID),
+				ApricotPhase6Time:      version.GetApricotPhase6Time(n.Config.NetworkID),
 			},
 		}),
 		vmRegisterer.Register(constants.AVMID, &avm.Factory{
@@ -839,6 +889,12 @@ func (n *Node) initAdminAPI() error {
 			NodeConfig:   n.Config,
 			VMManager:    n.Config.VMManager,
 			VMRegistry:   n.VMRegistry,
+			DBManager:    n.DBManager,
+			DBName:       n.Config.DatabaseConfig.Name,
+			DBConfig:     n.Config.DatabaseConfig.Config,
+			PeerFilter:   n.Net.PeerFilter(),
+			Network:      n.Net,
+			MsgParser:    n.msgCreator,
 		},
 	)
 	if err != nil {
@@ -1188,6 +1244,11 @@ func (n *Node) Initialize(
 	if err := n.initIndexer(); err != nil {
 		return fmt.Errorf("couldn't initialize indexer: %w", err)
 	}
+	// Must be initialized after the indexer so that accepted containers are
+	// indexed before they are sent to subscribers.
+	if err := n.initSubscriptionsAPI(); err != nil {
+		return fmt.Errorf("couldn't initialize subscriptions API: %w", err)
+	}
 
 	n.health.Start(n.Config.HealthCheckFreq)
 	n.initProfiler()
@@ -56,6 +56,7 @@ type VMClient interface {
 	GetStateSummary(ctx context.Context, in *GetStateSummaryRequest, opts ...grpc.CallOption) (*GetStateSummaryResponse, error)
 	// Block
 	BlockVerify(ctx context.Context, in *BlockVerifyRequest, opts ...grpc.CallOption) (*BlockVerifyResponse, error)
+	BlockPreVerify(ctx context.Context, in *BlockPreVerifyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	BlockAccept(ctx context.Context, in *BlockAcceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	BlockReject(ctx context.Context, in *BlockRejectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// StateSummary
@@ -322,6 +323,15 @@ func (c *vMClient) BlockVerify(ctx context.Context, in *BlockVerifyRequest, opts
 	return out, nil
 }
 
+func (c *vMClient) BlockPreVerify(ctx context.Context, in *BlockPreVerifyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/vm.VM/BlockPreVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vMClient) BlockAccept(ctx context.Context, in *BlockAcceptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/vm.VM/BlockAccept", in, out, opts...)
@@ -386,6 +396,7 @@ type VMServer interface {
 	GetStateSummary(context.Context, *GetStateSummaryRequest) (*GetStateSummaryResponse, error)
 	// Block
 	BlockVerify(context.Context, *BlockVerifyRequest) (*BlockVerifyResponse, error)
+	BlockPreVerify(context.Context, *BlockPreVerifyRequest) (*emptypb.Empty, error)
 	BlockAccept(context.Context, *BlockAcceptRequest) (*emptypb.Empty, error)
 	BlockReject(context.Context, *BlockRejectRequest) (*emptypb.Empty, error)
 	// StateSummary
@@ -481,6 +492,9 @@ func (UnimplementedVMServer) GetStateSummary(context.Context, *GetStateSummaryRe
 func (UnimplementedVMServer) BlockVerify(context.Context, *BlockVerifyRequest) (*BlockVerifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockVerify not implemented")
 }
+func (UnimplementedVMServer) BlockPreVerify(context.Context, *BlockPreVerifyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockPreVerify not implemented")
+}
 func (UnimplementedVMServer) BlockAccept(context.Context, *BlockAcceptRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockAccept not implemented")
 }
@@ -1007,6 +1021,24 @@ func _VM_BlockVerify_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VM_BlockPreVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockPreVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServer).BlockPreVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vm.VM/BlockPreVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServer).BlockPreVerify(ctx, req.(*BlockPreVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VM_BlockAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockAcceptRequest)
 	if err := dec(in); err != nil {
@@ -1180,6 +1212,10 @@ var VM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockVerify",
 			Handler:    _VM_BlockVerify_Handler,
 		},
+		{
+			MethodName: "BlockPreVerify",
+			Handler:    _VM_BlockPreVerify_Handler,
+		},
 		{
 			MethodName: "BlockAccept",
 			Handler:    _VM_BlockAccept_Handler,
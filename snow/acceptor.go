@@ -64,6 +64,8 @@ func (a *AcceptorTracker) IsAccepted(containerID ids.ID) (int, bool) {
 type acceptorWrapper struct {
 	Acceptor
 
+	name string
+
 	// If true and Accept returns an error, the chain this callback corresponds
 	// to will stop.
 	dieOnError bool
@@ -71,7 +73,7 @@ type acceptorWrapper struct {
 
 type AcceptorGroup interface {
 	// Calling Accept() calls all of the registered acceptors for the relevant
-	// chain.
+	// chain, in the order that they were registered.
 	Acceptor
 
 	// RegisterAcceptor causes [acceptor] to be called every time an operation
@@ -87,14 +89,14 @@ type acceptorGroup struct {
 	log logging.Logger
 
 	lock sync.RWMutex
-	// Chain ID --> Acceptor Name --> Acceptor
-	acceptors map[ids.ID]map[string]acceptorWrapper
+	// Chain ID --> Acceptors in the order they were registered
+	acceptors map[ids.ID][]acceptorWrapper
 }
 
 func NewAcceptorGroup(log logging.Logger) AcceptorGroup {
 	return &acceptorGroup{
 		log:       log,
-		acceptors: make(map[ids.ID]map[string]acceptorWrapper),
+		acceptors: make(map[ids.ID][]acceptorWrapper),
 	}
 }
 
@@ -102,11 +104,11 @@ func (a *acceptorGroup) Accept(ctx *ConsensusContext, containerID ids.ID, contai
 	a.lock.RLock()
 	defer a.lock.RUnlock()
 
-	for acceptorName, acceptor := range a.acceptors[ctx.ChainID] {
+	for _, acceptor := range a.acceptors[ctx.ChainID] {
 		if err := acceptor.Accept(ctx, containerID, container); err != nil {
-			a.log.Error("acceptor %s on chain %s erred while accepting %s: %s", acceptorName, ctx.ChainID, containerID, err)
+			a.log.Error("acceptor %s on chain %s erred while accepting %s: %s", acceptor.name, ctx.ChainID, containerID, err)
 			if acceptor.dieOnError {
-				return fmt.Errorf("acceptor %s on chain %s erred while accepting %s: %w", acceptorName, ctx.ChainID, containerID, err)
+				return fmt.Errorf("acceptor %s on chain %s erred while accepting %s: %w", acceptor.name, ctx.ChainID, containerID, err)
 			}
 		}
 	}
@@ -117,20 +119,18 @@ func (a *acceptorGroup) RegisterAcceptor(chainID ids.ID, acceptorName string, ac
 	a.lock.Lock()
 	defer a.lock.Unlock()
 
-	acceptors, exist := a.acceptors[chainID]
-	if !exist {
-		acceptors = make(map[string]acceptorWrapper)
-		a.acceptors[chainID] = acceptors
-	}
-
-	if _, ok := acceptors[acceptorName]; ok {
-		return fmt.Errorf("callback %s already exists on chain %s", acceptorName, chainID)
+	acceptors := a.acceptors[chainID]
+	for _, existing := range acceptors {
+		if existing.name == acceptorName {
+			return fmt.Errorf("callback %s already exists on chain %s", acceptorName, chainID)
+		}
 	}
 
-	acceptors[acceptorName] = acceptorWrapper{
+	a.acceptors[chainID] = append(acceptors, acceptorWrapper{
 		Acceptor:   acceptor,
+		name:       acceptorName,
 		dieOnError: dieOnError,
-	}
+	})
 	return nil
 }
 
@@ -143,14 +143,22 @@ func (a *acceptorGroup) DeregisterAcceptor(chainID ids.ID, acceptorName string)
 		return fmt.Errorf("chain %s has no callbacks", chainID)
 	}
 
-	if _, ok := acceptors[acceptorName]; !ok {
-		return fmt.Errorf("callback %s does not exist on chain %s", acceptorName, chainID)
-	}
+	for i, acceptor := range acceptors {
+		if acceptor.name != acceptorName {
+			continue
+		}
 
-	if len(acceptors) == 1 {
-		delete(a.acceptors, chainID)
-	} else {
-		delete(acceptors, acceptorName)
+		if len(acceptors) == 1 {
+			delete(a.acceptors, chainID)
+			return nil
+		}
+
+		// Preserve the order of the remaining acceptors
+		newAcceptors := make([]acceptorWrapper, 0, len(acceptors)-1)
+		newAcceptors = append(newAcceptors, acceptors[:i]...)
+		newAcceptors = append(newAcceptors, acceptors[i+1:]...)
+		a.acceptors[chainID] = newAcceptors
+		return nil
 	}
-	return nil
+	return fmt.Errorf("callback %s does not exist on chain %s", acceptorName, chainID)
 }
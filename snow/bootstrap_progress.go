@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snow
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// BootstrapPhase is the step of syncing a chain is in
+type BootstrapPhase uint8
+
+const (
+	// WaitingPhase is the phase of a chain that hasn't started syncing, such
+	// as a chain that waits for enough stake to be connected
+	WaitingPhase BootstrapPhase = iota
+	// StateSyncingPhase is the phase of a chain that is state syncing
+	StateSyncingPhase
+	// FrontierPhase is the phase of a chain that fetches the accepted
+	// frontier from the beacons
+	FrontierPhase
+	// FetchingPhase is the phase of a chain that fetches the containers
+	// between its last accepted container and the accepted frontier
+	FetchingPhase
+	// ExecutingPhase is the phase of a chain that executes the containers it
+	// fetched
+	ExecutingPhase
+	// DonePhase is the phase of a chain that finished bootstrapping
+	DonePhase
+)
+
+func (p BootstrapPhase) String() string {
+	switch p {
+	case WaitingPhase:
+		return "waiting"
+	case StateSyncingPhase:
+		return "stateSyncing"
+	case FrontierPhase:
+		return "frontier"
+	case FetchingPhase:
+		return "fetching"
+	case ExecutingPhase:
+		return "executing"
+	case DonePhase:
+		return "done"
+	default:
+		return "unknown"
+	}
+}
+
+// BootstrapStatus is a snapshot of the bootstrapping progress of a chain
+type BootstrapStatus struct {
+	Phase BootstrapPhase
+	// Height of the last accepted block when fetching started. 0 if the chain
+	// doesn't have heights.
+	StartingHeight uint64
+	// Greatest height of the blocks being fetched. 0 if the chain doesn't have
+	// heights.
+	TargetHeight uint64
+	// Number of containers fetched into the execution queue, including the
+	// containers fetched by previous runs
+	Fetched uint64
+	// Number of containers executed in the current execution
+	Executed uint64
+	// Number of containers to execute in the current execution
+	ToExecute uint64
+	// Number of containers fetched or executed per second in the current
+	// phase
+	Throughput float64
+	// Estimated time until the current phase finishes. 0 if unknown.
+	ETA time.Duration
+}
+
+// BootstrapProgress tracks the progress of the bootstrapping of a chain. The
+// zero value is ready to use. It's safe to call the methods of
+// BootstrapProgress concurrently.
+type BootstrapProgress struct {
+	clock mockable.Clock
+
+	lock   sync.Mutex
+	status BootstrapStatus
+	// Time the current phase started
+	phaseStartTime time.Time
+	// Number of containers fetched or executed when the current phase
+	// started
+	phaseStartCount uint64
+}
+
+// FetchingFrontier marks the accepted frontier as being fetched. Called every
+// time bootstrapping is started or restarted.
+func (p *BootstrapProgress) FetchingFrontier() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.startPhase(FrontierPhase, 0)
+}
+
+// Fetching marks the containers as being fetched, when [numFetched]
+// containers were already fetched by a previous run
+func (p *BootstrapProgress) Fetching(numFetched uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.status.Fetched = numFetched
+	p.startPhase(FetchingPhase, numFetched)
+}
+
+// SetHeights sets the height of the last accepted block when fetching started
+// and the greatest height of the blocks being fetched
+func (p *BootstrapProgress) SetHeights(startingHeight, targetHeight uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.status.StartingHeight = startingHeight
+	p.status.TargetHeight = targetHeight
+}
+
+// Fetched sets the number of containers fetched into the execution queue
+func (p *BootstrapProgress) Fetched(numFetched uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.status.Fetched = numFetched
+}
+
+// Executing marks [numToExecute] containers as being executed
+func (p *BootstrapProgress) Executing(numToExecute uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.status.Executed = 0
+	p.status.ToExecute = numToExecute
+	p.startPhase(ExecutingPhase, 0)
+}
+
+// Executed sets the number of containers executed in the current execution
+func (p *BootstrapProgress) Executed(numExecuted uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.status.Executed = numExecuted
+}
+
+// Status returns the progress of the chain. [state] is the current state of
+// the chain, which takes precedence over the tracked phase when the chain is
+// state syncing or done bootstrapping.
+func (p *BootstrapProgress) Status(state State) BootstrapStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.status
+	switch state {
+	case StateSyncing:
+		status.Phase = StateSyncingPhase
+		return status
+	case NormalOp:
+		status.Phase = DonePhase
+		return status
+	}
+
+	var progress, remaining uint64
+	switch status.Phase {
+	case FetchingPhase:
+		progress = status.Fetched
+		// The number of containers to fetch is only known for chains with
+		// heights
+		if status.TargetHeight > status.StartingHeight {
+			if total := status.TargetHeight - status.StartingHeight; total > status.Fetched {
+				remaining = total - status.Fetched
+			}
+		}
+	case ExecutingPhase:
+		progress = status.Executed
+		if status.ToExecute > status.Executed {
+			remaining = status.ToExecute - status.Executed
+		}
+	default:
+		return status
+	}
+
+	elapsed := p.clock.Time().Sub(p.phaseStartTime)
+	if progress <= p.phaseStartCount || elapsed <= 0 {
+		return status
+	}
+	status.Throughput = float64(progress-p.phaseStartCount) / elapsed.Seconds()
+	status.ETA = time.Duration(float64(remaining) / status.Throughput * float64(time.Second)).Round(time.Second)
+	return status
+}
+
+// startPhase assumes [p.lock] is held
+func (p *BootstrapProgress) startPhase(phase BootstrapPhase, count uint64) {
+	p.status.Phase = phase
+	p.phaseStartTime = p.clock.Time()
+	p.phaseStartCount = count
+}
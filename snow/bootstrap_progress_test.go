@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBootstrapProgress(t *testing.T) {
+	assert := assert.New(t)
+
+	p := BootstrapProgress{}
+	now := time.Unix(1, 0)
+	p.clock.Set(now)
+
+	assert.Equal(WaitingPhase, p.Status(Initializing).Phase)
+
+	p.FetchingFrontier()
+	assert.Equal(FrontierPhase, p.Status(Bootstrapping).Phase)
+
+	// 100 blocks were fetched before a restart and 1000 blocks are being
+	// fetched in total
+	p.Fetching(100)
+	p.SetHeights(10, 1010)
+	status := p.Status(Bootstrapping)
+	assert.Equal(FetchingPhase, status.Phase)
+	assert.EqualValues(100, status.Fetched)
+	assert.Zero(status.Throughput)
+	assert.Zero(status.ETA)
+
+	now = now.Add(10 * time.Second)
+	p.clock.Set(now)
+	p.Fetched(300)
+	status = p.Status(Bootstrapping)
+	assert.EqualValues(300, status.Fetched)
+	assert.EqualValues(10, status.StartingHeight)
+	assert.EqualValues(1010, status.TargetHeight)
+	assert.Equal(20.0, status.Throughput)
+	assert.Equal(35*time.Second, status.ETA)
+
+	p.Executing(1000)
+	now = now.Add(5 * time.Second)
+	p.clock.Set(now)
+	p.Executed(250)
+	status = p.Status(Bootstrapping)
+	assert.Equal(ExecutingPhase, status.Phase)
+	assert.EqualValues(250, status.Executed)
+	assert.EqualValues(1000, status.ToExecute)
+	assert.Equal(50.0, status.Throughput)
+	assert.Equal(15*time.Second, status.ETA)
+
+	// The state of the chain takes precedence over the tracked phase
+	assert.Equal(StateSyncingPhase, p.Status(StateSyncing).Phase)
+	assert.Equal(DonePhase, p.Status(NormalOp).Phase)
+}
+
+func TestBootstrapProgressUnknownTarget(t *testing.T) {
+	assert := assert.New(t)
+
+	p := BootstrapProgress{}
+	now := time.Unix(1, 0)
+	p.clock.Set(now)
+
+	p.Fetching(0)
+	now = now.Add(4 * time.Second)
+	p.clock.Set(now)
+	p.Fetched(100)
+
+	status := p.Status(Bootstrapping)
+	assert.Equal(25.0, status.Throughput)
+	assert.Zero(status.ETA)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/choices"
 	"github.com/ava-labs/avalanchego/snow/consensus/metrics"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/trace"
 )
 
 var (
@@ -32,6 +33,10 @@ type Topological struct {
 	metrics.Polls
 	metrics.Height
 
+	// Tracer, if non-nil, records the effect of each poll on the processing
+	// blocks
+	Tracer trace.Tracer
+
 	// pollNumber is the number of times RecordPolls has been called
 	pollNumber uint64
 
@@ -159,6 +164,10 @@ func (ts *Topological) Add(blk Block) error {
 		ts.tail = blkID
 		ts.preferredIDs.Add(blkID)
 	}
+
+	if ts.Tracer != nil {
+		ts.Tracer.Issued(blkID, parentID, blk.Height(), ts.preferredIDs.Contains(blkID))
+	}
 	return nil
 }
 
@@ -216,6 +225,40 @@ func (ts *Topological) Preference() ids.ID { return ts.tail }
 // - Runtime = 3 * |live set| + |votes|
 // - Space = 2 * |live set| + |votes|
 func (ts *Topological) RecordPoll(voteBag ids.Bag) error {
+	if ts.Tracer == nil {
+		return ts.recordPoll(voteBag)
+	}
+
+	// The blocks and votes must be collected before the poll is recorded, as
+	// recording the poll may decide blocks.
+	processing := make([]Block, 0, len(ts.blocks)-1)
+	for _, block := range ts.blocks {
+		if !block.Accepted() {
+			processing = append(processing, block.blk)
+		}
+	}
+	votes := ts.transitiveVotes(voteBag)
+
+	if err := ts.recordPoll(voteBag); err != nil {
+		return err
+	}
+
+	results := make([]trace.BlockResult, len(processing))
+	for i, blk := range processing {
+		blkID := blk.ID()
+		results[i] = trace.BlockResult{
+			BlockID:    blkID,
+			Votes:      votes[blkID],
+			Successful: votes[blkID] >= ts.params.Alpha,
+			Preferred:  ts.IsPreferred(blk),
+		}
+	}
+	ts.Tracer.Polled(ts.pollNumber, results)
+	return nil
+}
+
+// recordPoll implements RecordPoll
+func (ts *Topological) recordPoll(voteBag ids.Bag) error {
 	// Register a new poll call
 	ts.pollNumber++
 
@@ -271,6 +314,20 @@ func (ts *Topological) RecordPoll(voteBag ids.Bag) error {
 
 func (ts *Topological) Finalized() bool { return len(ts.blocks) == 1 }
 
+// transitiveVotes returns the number of votes in [voteBag] for each processing
+// block and its descendants. Only used for tracing, as RecordPoll pushes the
+// votes more efficiently.
+func (ts *Topological) transitiveVotes(voteBag ids.Bag) map[ids.ID]int {
+	votes := make(map[ids.ID]int)
+	for _, vote := range voteBag.List() {
+		count := voteBag.Count(vote)
+		for block, ok := ts.blocks[vote]; ok && !block.Accepted(); block, ok = ts.blocks[block.blk.Parent()] {
+			votes[block.blk.ID()] += count
+		}
+	}
+	return votes
+}
+
 // HealthCheck returns information about the consensus health.
 func (ts *Topological) HealthCheck() (interface{}, error) {
 	numOutstandingBlks := ts.Latency.NumProcessing()
@@ -546,6 +603,9 @@ func (ts *Topological) accept(n *snowmanBlock) error {
 	if err := child.Accept(); err != nil {
 		return err
 	}
+	if ts.Tracer != nil {
+		ts.Tracer.Accepted(pref)
+	}
 
 	// Because this is the newest accepted block, this is the new head.
 	ts.head = pref
@@ -571,6 +631,9 @@ func (ts *Topological) accept(n *snowmanBlock) error {
 		if err := child.Reject(); err != nil {
 			return err
 		}
+		if ts.Tracer != nil {
+			ts.Tracer.Rejected(childID)
+		}
 		ts.Latency.Rejected(childID, ts.pollNumber)
 
 		// Track which blocks have been directly rejected
@@ -599,6 +662,9 @@ func (ts *Topological) rejectTransitively(rejected []ids.ID) error {
 			if err := child.Reject(); err != nil {
 				return err
 			}
+			if ts.Tracer != nil {
+				ts.Tracer.Rejected(childID)
+			}
 			ts.Latency.Rejected(childID, ts.pollNumber)
 
 			// add the newly rejected block to the end of the queue
@@ -5,6 +5,115 @@ package snowman
 
 import (
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/trace"
 )
 
 func TestTopological(t *testing.T) { runConsensusTests(t, TopologicalFactory{}) }
+
+func TestTopologicalTracer(t *testing.T) {
+	assert := assert.New(t)
+
+	store := trace.NewStore(trace.Config{
+		Enabled:          true,
+		MaxBlocks:        10,
+		MaxPollsPerBlock: 10,
+		Retention:        time.Minute,
+	})
+	sm := &Topological{Tracer: store}
+
+	ctx := snow.DefaultConsensusContextTest()
+	params := snowball.Parameters{
+		K:                     1,
+		Alpha:                 1,
+		BetaVirtuous:          1,
+		BetaRogue:             2,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+	}
+	assert.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight))
+
+	block0 := &TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.Empty.Prefix(1),
+			StatusV: choices.Processing,
+		},
+		ParentV: Genesis.IDV,
+		HeightV: Genesis.HeightV + 1,
+	}
+	block1 := &TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.Empty.Prefix(2),
+			StatusV: choices.Processing,
+		},
+		ParentV: Genesis.IDV,
+		HeightV: Genesis.HeightV + 1,
+	}
+	assert.NoError(sm.Add(block0))
+	assert.NoError(sm.Add(block1))
+
+	trace0, ok := store.Get(block0.ID())
+	assert.True(ok)
+	assert.True(trace0.Preferred)
+	trace1, ok := store.Get(block1.ID())
+	assert.True(ok)
+	assert.False(trace1.Preferred)
+
+	vdr := ids.GenerateTestNodeID()
+	vdrs := ids.NodeIDBag{}
+	vdrs.Add(vdr)
+
+	votes := ids.Bag{}
+	votes.Add(block1.ID())
+
+	// The vote for [block1] flips the preference
+	store.PollStarted(1, vdrs)
+	store.Responded(1, vdr, block1.ID())
+	assert.NoError(sm.RecordPoll(votes))
+	assert.Equal(block1.ID(), sm.Preference())
+
+	trace0, _ = store.Get(block0.ID())
+	assert.Len(trace0.Polls, 1)
+	assert.EqualValues(1, trace0.Polls[0].RequestID)
+	assert.Equal([]trace.Response{{NodeID: vdr, Count: 1, Vote: block1.ID()}}, trace0.Polls[0].Responses)
+	assert.EqualValues(0, trace0.Polls[0].Votes)
+	assert.False(trace0.Polls[0].Successful)
+	assert.False(trace0.Polls[0].Preferred)
+	assert.True(trace0.Polls[0].PreferenceChanged)
+
+	trace1, _ = store.Get(block1.ID())
+	assert.Len(trace1.Polls, 1)
+	assert.EqualValues(1, trace1.Polls[0].Votes)
+	assert.True(trace1.Polls[0].Successful)
+	assert.EqualValues(1, trace1.Polls[0].Confidence)
+	assert.True(trace1.Polls[0].Preferred)
+	assert.True(trace1.Polls[0].PreferenceChanged)
+
+	// The second vote for [block1] decides both blocks
+	store.PollStarted(2, vdrs)
+	store.Responded(2, vdr, block1.ID())
+	assert.NoError(sm.RecordPoll(votes))
+	assert.Equal(choices.Accepted, block1.Status())
+
+	trace0, _ = store.Get(block0.ID())
+	assert.Equal(choices.Rejected, trace0.Status)
+	assert.NotNil(trace0.Decided)
+	assert.Len(trace0.Polls, 2)
+	assert.False(trace0.Polls[1].PreferenceChanged)
+
+	trace1, _ = store.Get(block1.ID())
+	assert.Equal(choices.Accepted, trace1.Status)
+	assert.EqualValues(2, trace1.NumPolls)
+	assert.EqualValues(2, trace1.Confidence)
+	assert.EqualValues(2, trace1.Polls[1].RequestID)
+	assert.False(trace1.Polls[1].PreferenceChanged)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+)
+
+var _ Client = &client{}
+
+// Client interface for a chain's consensus traces API Endpoint
+type Client interface {
+	// GetBlockTrace returns the history of the polls of [blkID]
+	GetBlockTrace(ctx context.Context, blkID ids.ID, options ...rpc.Option) (*BlockTrace, error)
+	// GetBlockTraces returns the history of the polls of all the traced
+	// blocks. If [processingOnly], only processing blocks are returned.
+	GetBlockTraces(ctx context.Context, processingOnly bool, options ...rpc.Option) ([]BlockTrace, error)
+}
+
+// Client implementation for a chain's consensus traces API Endpoint
+type client struct {
+	requester rpc.EndpointRequester
+}
+
+// NewClient returns a client to interact with the consensus traces API of
+// [chain]
+func NewClient(uri, chain string) Client {
+	return &client{
+		requester: rpc.NewEndpointRequester(uri, fmt.Sprintf("/ext/%s/debug", constants.ChainAliasPrefix+chain), "debug"),
+	}
+}
+
+func (c *client) GetBlockTrace(ctx context.Context, blkID ids.ID, options ...rpc.Option) (*BlockTrace, error) {
+	res := &BlockTrace{}
+	err := c.requester.SendRequest(ctx, "getBlockTrace", &GetBlockTraceArgs{
+		BlockID: blkID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetBlockTraces(ctx context.Context, processingOnly bool, options ...rpc.Option) ([]BlockTrace, error) {
+	res := &GetBlockTracesReply{}
+	err := c.requester.SendRequest(ctx, "getBlockTraces", &GetBlockTracesArgs{
+		ProcessingOnly: processingOnly,
+	}, res, options...)
+	return res.Blocks, err
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/rpc/v2"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var errNoBlockID = errors.New("argument 'blockID' not given")
+
+// Service is the API service of a chain's consensus traces
+type Service struct {
+	log   logging.Logger
+	store Store
+}
+
+// NewService returns a new consensus traces API service
+func NewService(log logging.Logger, store Store) (*common.HTTPHandler, error) {
+	newServer := rpc.NewServer()
+	codec := json.NewCodec()
+	newServer.RegisterCodec(codec, "application/json")
+	newServer.RegisterCodec(codec, "application/json;charset=UTF-8")
+	service := &Service{
+		log:   log,
+		store: store,
+	}
+	// The store has its own lock, so the chain's lock isn't needed
+	return &common.HTTPHandler{
+		LockOptions: common.NoLock,
+		Handler:     newServer,
+	}, newServer.RegisterService(service, "debug")
+}
+
+// GetBlockTraceArgs are the arguments for calling GetBlockTrace
+type GetBlockTraceArgs struct {
+	BlockID ids.ID `json:"blockID"`
+}
+
+// GetBlockTrace returns the history of the polls of a block
+func (s *Service) GetBlockTrace(_ *http.Request, args *GetBlockTraceArgs, reply *BlockTrace) error {
+	s.log.Debug("Debug: GetBlockTrace called with %s", args.BlockID)
+
+	if args.BlockID == ids.Empty {
+		return errNoBlockID
+	}
+	trace, ok := s.store.Get(args.BlockID)
+	if !ok {
+		return fmt.Errorf("no trace of block %s", args.BlockID)
+	}
+	*reply = trace
+	return nil
+}
+
+// GetBlockTracesArgs are the arguments for calling GetBlockTraces
+type GetBlockTracesArgs struct {
+	// ProcessingOnly is true if only the traces of processing blocks should
+	// be returned
+	ProcessingOnly bool `json:"processingOnly"`
+}
+
+// GetBlockTracesReply are the results from calling GetBlockTraces
+type GetBlockTracesReply struct {
+	Blocks []BlockTrace `json:"blocks"`
+}
+
+// GetBlockTraces returns the history of the polls of all the traced blocks,
+// in the order the blocks were issued
+func (s *Service) GetBlockTraces(_ *http.Request, args *GetBlockTracesArgs, reply *GetBlockTracesReply) error {
+	s.log.Debug("Debug: GetBlockTraces called with processingOnly %t", args.ProcessingOnly)
+
+	traces := s.store.GetAll()
+	reply.Blocks = make([]BlockTrace, 0, len(traces))
+	for _, trace := range traces {
+		if args.ProcessingOnly && trace.Status != choices.Processing {
+			continue
+		}
+		reply.Blocks = append(reply.Blocks, trace)
+	}
+	return nil
+}
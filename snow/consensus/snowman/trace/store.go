@@ -0,0 +1,295 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/linkedhashmap"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+var _ Store = &store{}
+
+type Config struct {
+	// Enabled is true if the polls of processing blocks should be traced
+	Enabled bool `json:"enabled"`
+	// MaxBlocks is the max number of blocks whose traces are kept. Once
+	// exceeded, the traces of the oldest issued blocks are dropped.
+	MaxBlocks int `json:"maxBlocks"`
+	// MaxPollsPerBlock is the max number of polls kept in the trace of a
+	// block. Once exceeded, the oldest polls of the block are dropped.
+	MaxPollsPerBlock int `json:"maxPollsPerBlock"`
+	// Retention is how long the trace of a block is kept after the block is
+	// decided.
+	Retention time.Duration `json:"retention"`
+}
+
+// Response of a validator to a poll
+type Response struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Count is the number of times [NodeID] was sampled in the poll
+	Count json.Uint32 `json:"count"`
+	// Vote is the block that [NodeID] voted for. Empty if [NodeID] didn't
+	// respond before the poll finished or failed to respond.
+	Vote ids.ID `json:"vote"`
+}
+
+// PollTrace is the effect of a poll on a block
+type PollTrace struct {
+	PollNumber json.Uint64 `json:"pollNumber"`
+	RequestID  json.Uint32 `json:"requestID"`
+	Started    time.Time   `json:"started"`
+	Applied    time.Time   `json:"applied"`
+	// Responses are shared by all the blocks that were processing when the
+	// poll was applied, and must not be modified.
+	Responses []Response `json:"responses"`
+	// Votes is the number of votes for the block and its descendants
+	Votes json.Uint32 `json:"votes"`
+	// Successful is true if [Votes] reached the alpha threshold
+	Successful bool `json:"successful"`
+	// Confidence is the number of consecutive successful polls of the block,
+	// including this poll
+	Confidence json.Uint32 `json:"confidence"`
+	// Preferred is true if the block was preferred after the poll
+	Preferred bool `json:"preferred"`
+	// PreferenceChanged is true if the poll changed whether the block is
+	// preferred
+	PreferenceChanged bool `json:"preferenceChanged"`
+}
+
+// BlockTrace is the history of the polls of a block
+type BlockTrace struct {
+	BlockID    ids.ID         `json:"blockID"`
+	ParentID   ids.ID         `json:"parentID"`
+	Height     json.Uint64    `json:"height"`
+	Status     choices.Status `json:"status"`
+	Issued     time.Time      `json:"issued"`
+	Decided    *time.Time     `json:"decided,omitempty"`
+	Confidence json.Uint32    `json:"confidence"`
+	Preferred  bool           `json:"preferred"`
+	// NumPolls is the number of polls applied while the block was processing
+	NumPolls json.Uint64 `json:"numPolls"`
+	// Polls are the most recent polls of the block, oldest first
+	Polls []PollTrace `json:"polls"`
+}
+
+// Store is a bounded Tracer whose traces can be read concurrently
+type Store interface {
+	Tracer
+
+	// Get returns the trace of [blkID], if it's kept
+	Get(blkID ids.ID) (BlockTrace, bool)
+
+	// GetAll returns all the kept traces, in the order the blocks were issued
+	GetAll() []BlockTrace
+}
+
+type pendingPoll struct {
+	requestID uint32
+	started   time.Time
+	responses []Response
+	// validator --> index in [responses]
+	indices map[ids.NodeID]int
+}
+
+type store struct {
+	config Config
+	clock  mockable.Clock
+
+	lock sync.Mutex
+	// request ID --> *pendingPoll, oldest started first
+	pendingPolls linkedhashmap.LinkedHashmap
+	// block ID --> *BlockTrace, oldest issued first
+	blocks linkedhashmap.LinkedHashmap
+	// IDs of the decided blocks, oldest decided first
+	decided []ids.ID
+}
+
+// NewStore returns a new, empty, store of block traces
+func NewStore(config Config) Store {
+	return &store{
+		config:       config,
+		pendingPolls: linkedhashmap.New(),
+		blocks:       linkedhashmap.New(),
+	}
+}
+
+func (s *store) PollStarted(requestID uint32, vdrs ids.NodeIDBag) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	vdrList := vdrs.List()
+	p := &pendingPoll{
+		requestID: requestID,
+		started:   s.clock.Time(),
+		responses: make([]Response, len(vdrList)),
+		indices:   make(map[ids.NodeID]int, len(vdrList)),
+	}
+	for i, vdr := range vdrList {
+		p.responses[i] = Response{
+			NodeID: vdr,
+			Count:  json.Uint32(vdrs.Count(vdr)),
+		}
+		p.indices[vdr] = i
+	}
+	s.pendingPolls.Put(requestID, p)
+}
+
+func (s *store) Responded(requestID uint32, vdr ids.NodeID, vote ids.ID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	pIntf, ok := s.pendingPolls.Get(requestID)
+	if !ok {
+		// The poll already finished
+		return
+	}
+	p := pIntf.(*pendingPoll)
+	if i, ok := p.indices[vdr]; ok {
+		p.responses[i].Vote = vote
+	}
+}
+
+func (s *store) Issued(blkID, parentID ids.ID, height uint64, preferred bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.prune()
+	s.blocks.Put(blkID, &BlockTrace{
+		BlockID:   blkID,
+		ParentID:  parentID,
+		Height:    json.Uint64(height),
+		Status:    choices.Processing,
+		Issued:    s.clock.Time(),
+		Preferred: preferred,
+	})
+	for s.blocks.Len() > s.config.MaxBlocks {
+		oldestID, _, _ := s.blocks.Oldest()
+		s.blocks.Delete(oldestID)
+	}
+}
+
+func (s *store) Polled(pollNumber uint64, results []BlockResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.clock.Time()
+	template := PollTrace{
+		PollNumber: json.Uint64(pollNumber),
+		Applied:    now,
+	}
+	if requestID, pIntf, ok := s.pendingPolls.Oldest(); ok {
+		s.pendingPolls.Delete(requestID)
+
+		p := pIntf.(*pendingPoll)
+		template.RequestID = json.Uint32(p.requestID)
+		template.Started = p.started
+		template.Responses = p.responses
+	}
+
+	for _, result := range results {
+		blkIntf, ok := s.blocks.Get(result.BlockID)
+		if !ok {
+			continue
+		}
+		blk := blkIntf.(*BlockTrace)
+
+		if result.Successful {
+			blk.Confidence++
+		} else {
+			blk.Confidence = 0
+		}
+
+		poll := template
+		poll.Votes = json.Uint32(result.Votes)
+		poll.Successful = result.Successful
+		poll.Confidence = blk.Confidence
+		poll.Preferred = result.Preferred
+		poll.PreferenceChanged = result.Preferred != blk.Preferred
+		blk.Preferred = result.Preferred
+
+		blk.NumPolls++
+		blk.Polls = append(blk.Polls, poll)
+		if numDropped := len(blk.Polls) - s.config.MaxPollsPerBlock; numDropped > 0 {
+			blk.Polls = append(blk.Polls[:0], blk.Polls[numDropped:]...)
+		}
+	}
+}
+
+func (s *store) Accepted(blkID ids.ID) { s.decide(blkID, choices.Accepted) }
+
+func (s *store) Rejected(blkID ids.ID) { s.decide(blkID, choices.Rejected) }
+
+func (s *store) decide(blkID ids.ID, status choices.Status) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	blkIntf, ok := s.blocks.Get(blkID)
+	if !ok {
+		return
+	}
+	blk := blkIntf.(*BlockTrace)
+
+	now := s.clock.Time()
+	blk.Status = status
+	blk.Decided = &now
+	blk.Preferred = status == choices.Accepted
+	s.decided = append(s.decided, blkID)
+}
+
+func (s *store) Get(blkID ids.ID) (BlockTrace, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.prune()
+	blkIntf, ok := s.blocks.Get(blkID)
+	if !ok {
+		return BlockTrace{}, false
+	}
+	return copyTrace(blkIntf.(*BlockTrace)), true
+}
+
+func (s *store) GetAll() []BlockTrace {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.prune()
+	traces := make([]BlockTrace, 0, s.blocks.Len())
+	iter := s.blocks.NewIterator()
+	for iter.Next() {
+		traces = append(traces, copyTrace(iter.Value().(*BlockTrace)))
+	}
+	return traces
+}
+
+// prune drops the traces of the blocks that were decided more than
+// [Retention] ago. Assumes [s.lock] is held.
+func (s *store) prune() {
+	now := s.clock.Time()
+	for len(s.decided) > 0 {
+		blkID := s.decided[0]
+		blkIntf, ok := s.blocks.Get(blkID)
+		if ok {
+			blk := blkIntf.(*BlockTrace)
+			if now.Sub(*blk.Decided) < s.config.Retention {
+				return
+			}
+			s.blocks.Delete(blkID)
+		}
+		s.decided = s.decided[1:]
+	}
+}
+
+// copyTrace returns a copy of [blk] that isn't modified by future polls
+func copyTrace(blk *BlockTrace) BlockTrace {
+	trace := *blk
+	trace.Polls = make([]PollTrace, len(blk.Polls))
+	copy(trace.Polls, blk.Polls)
+	return trace
+}
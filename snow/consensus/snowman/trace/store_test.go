@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/choices"
+)
+
+func TestStorePolls(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore(Config{
+		MaxBlocks:        10,
+		MaxPollsPerBlock: 2,
+	}).(*store)
+
+	blkID := ids.GenerateTestID()
+	s.Issued(blkID, ids.GenerateTestID(), 1, true)
+
+	vdr0, vdr1 := ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
+	vdrs := ids.NodeIDBag{}
+	vdrs.Add(vdr0, vdr1, vdr1)
+	s.PollStarted(1, vdrs)
+	s.PollStarted(2, vdrs)
+	s.Responded(1, vdr1, blkID)
+	s.Responded(2, vdr0, blkID)
+
+	// The results are attributed to the oldest poll
+	s.Polled(1, []BlockResult{{
+		BlockID:    blkID,
+		Votes:      2,
+		Successful: true,
+		Preferred:  true,
+	}})
+	// Responses to finished polls are dropped
+	s.Responded(1, vdr0, blkID)
+
+	trace, ok := s.Get(blkID)
+	assert.True(ok)
+	assert.Len(trace.Polls, 1)
+	poll := trace.Polls[0]
+	assert.EqualValues(1, poll.RequestID)
+	assert.ElementsMatch(
+		[]Response{
+			{NodeID: vdr0, Count: 1},
+			{NodeID: vdr1, Count: 2, Vote: blkID},
+		},
+		poll.Responses,
+	)
+	assert.EqualValues(1, poll.Confidence)
+	assert.False(poll.PreferenceChanged)
+
+	s.Polled(2, []BlockResult{{
+		BlockID: blkID,
+	}})
+	s.Polled(3, []BlockResult{{
+		BlockID:    blkID,
+		Votes:      2,
+		Successful: true,
+	}})
+
+	// Only the most recent polls are kept
+	trace, _ = s.Get(blkID)
+	assert.EqualValues(3, trace.NumPolls)
+	assert.EqualValues(1, trace.Confidence)
+	assert.False(trace.Preferred)
+	assert.Len(trace.Polls, 2)
+	assert.EqualValues(2, trace.Polls[0].RequestID)
+	assert.EqualValues(0, trace.Polls[0].Confidence)
+	assert.True(trace.Polls[0].PreferenceChanged)
+	assert.EqualValues(0, trace.Polls[1].RequestID)
+	assert.Empty(trace.Polls[1].Responses)
+}
+
+func TestStoreRetention(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore(Config{
+		MaxBlocks:        2,
+		MaxPollsPerBlock: 1,
+		Retention:        time.Minute,
+	}).(*store)
+	now := time.Now()
+	s.clock.Set(now)
+
+	blkID0, blkID1, blkID2 := ids.GenerateTestID(), ids.GenerateTestID(), ids.GenerateTestID()
+	s.Issued(blkID0, ids.Empty, 1, true)
+	s.Issued(blkID1, ids.Empty, 1, false)
+	s.Accepted(blkID0)
+	s.Rejected(blkID1)
+
+	trace, ok := s.Get(blkID0)
+	assert.True(ok)
+	assert.Equal(choices.Accepted, trace.Status)
+	assert.Equal(now, *trace.Decided)
+	trace, ok = s.Get(blkID1)
+	assert.True(ok)
+	assert.Equal(choices.Rejected, trace.Status)
+
+	// The oldest block is dropped once too many blocks are traced
+	s.Issued(blkID2, blkID0, 2, true)
+	_, ok = s.Get(blkID0)
+	assert.False(ok)
+	assert.Len(s.GetAll(), 2)
+
+	// Decided blocks are dropped after the retention window
+	s.clock.Set(now.Add(time.Minute))
+	traces := s.GetAll()
+	assert.Len(traces, 1)
+	assert.Equal(blkID2, traces[0].BlockID)
+	assert.Equal(choices.Processing, traces[0].Status)
+	assert.Empty(s.decided)
+}
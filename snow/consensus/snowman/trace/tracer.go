@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// Tracer records how the polls of the snowman engine affect the blocks that
+// are processing in consensus.
+//
+// The engine and the consensus instance of a chain call the Tracer while
+// holding the chain's lock, so calls are never concurrent with each other.
+type Tracer interface {
+	// PollStarted is called when the poll with [requestID] is sent to
+	// [vdrs].
+	PollStarted(requestID uint32, vdrs ids.NodeIDBag)
+
+	// Responded is called when [vdr] responds to the poll with [requestID].
+	// If [vdr] failed to respond, [vote] is ids.Empty.
+	Responded(requestID uint32, vdr ids.NodeID, vote ids.ID)
+
+	// Issued is called when a block is added to consensus.
+	Issued(blkID, parentID ids.ID, height uint64, preferred bool)
+
+	// Polled is called when the result of a finished poll is applied to
+	// consensus. Polls are applied in the order they were started, so
+	// [results] are attributed to the oldest started poll that hasn't been
+	// applied yet.
+	//
+	// [results] contains an entry for every block that was processing when
+	// the poll was applied.
+	Polled(pollNumber uint64, results []BlockResult)
+
+	// Accepted is called when a block is accepted.
+	Accepted(blkID ids.ID)
+
+	// Rejected is called when a block is rejected.
+	Rejected(blkID ids.ID)
+}
+
+// BlockResult is the effect of a poll on a processing block
+type BlockResult struct {
+	BlockID ids.ID
+	// Votes is the number of votes for the block and its descendants
+	Votes int
+	// Successful is true if [Votes] reached the alpha threshold
+	Successful bool
+	// Preferred is true if the block is preferred after the poll
+	Preferred bool
+}
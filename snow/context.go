@@ -80,6 +80,9 @@ type ConsensusContext struct {
 
 	// Indicates this chain is available to only validators.
 	validatorOnly utils.AtomicBool
+
+	// Tracks the progress of the bootstrapping of this chain.
+	bootstrapProgress BootstrapProgress
 }
 
 func (ctx *ConsensusContext) SetState(newState State) {
@@ -102,6 +105,17 @@ func (ctx *ConsensusContext) Executing(b bool) {
 	ctx.executing.SetValue(b)
 }
 
+// BootstrapProgress returns the tracker of the bootstrapping progress of this
+// chain.
+func (ctx *ConsensusContext) BootstrapProgress() *BootstrapProgress {
+	return &ctx.bootstrapProgress
+}
+
+// BootstrapStatus returns the bootstrapping progress of this chain.
+func (ctx *ConsensusContext) BootstrapStatus() BootstrapStatus {
+	return ctx.bootstrapProgress.Status(ctx.GetState())
+}
+
 // IsValidatorOnly returns true iff this chain is available only to validators
 func (ctx *ConsensusContext) IsValidatorOnly() bool {
 	return ctx.validatorOnly.GetValue()
@@ -393,6 +393,7 @@ func (b *bootstrapper) process(vtxs ...avalanche.Vertex) error {
 			b.numFetchedVts.Inc()
 
 			verticesFetchedSoFar := b.VtxBlocked.Jobs.PendingJobs()
+			b.Ctx.BootstrapProgress().Fetched(verticesFetchedSoFar)
 			if verticesFetchedSoFar%common.StatusUpdateFrequency == 0 { // Periodically print progress
 				if !b.Config.SharedCfg.Restarted {
 					b.Ctx.Log.Info("fetched %d vertices", verticesFetchedSoFar)
@@ -448,6 +449,7 @@ func (b *bootstrapper) ForceAccepted(acceptedContainerIDs []ids.ID) error {
 	// we iterate over every container that must be traversed.
 	pendingContainerIDs = append(pendingContainerIDs, acceptedContainerIDs...)
 	b.Ctx.Log.Debug("Starting bootstrapping with %d missing vertices and %d from the accepted frontier", len(pendingContainerIDs), len(acceptedContainerIDs))
+	b.Ctx.BootstrapProgress().Fetching(b.VtxBlocked.PendingJobs())
 	toProcess := make([]avalanche.Vertex, 0, len(pendingContainerIDs))
 	for _, vtxID := range pendingContainerIDs {
 		if vtx, err := b.Manager.GetVtx(vtxID); err == nil {
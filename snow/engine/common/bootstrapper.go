@@ -246,6 +246,8 @@ func (b *bootstrapper) GetAcceptedFailed(validatorID ids.NodeID, requestID uint3
 }
 
 func (b *bootstrapper) Startup() error {
+	b.Ctx.BootstrapProgress().FetchingFrontier()
+
 	beacons, err := b.Beacons.Sample(b.Config.SampleK)
 	if err != nil {
 		return err
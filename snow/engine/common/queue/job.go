@@ -16,3 +16,19 @@ type Job interface {
 	Execute() error
 	Bytes() []byte
 }
+
+// PreExecutable is a Job with a step that can be run ahead of Execute, while
+// other jobs are executed.
+type PreExecutable interface {
+	Job
+
+	// PreExecute runs the checks of Execute that don't depend on the state
+	// modified by other jobs, such as verifying signatures. PreExecute is
+	// called from a pool of workers, so it may be called concurrently with
+	// the methods of other jobs, including PreExecute.
+	//
+	// PreExecute must not have side effects, other than caching results that
+	// Execute can reuse. If PreExecute returns an error, the job isn't
+	// executed and the execution of the queue fails.
+	PreExecute() error
+}
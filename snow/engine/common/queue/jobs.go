@@ -29,6 +29,9 @@ type Jobs struct {
 	db *versiondb.Database
 	// state writes the job queue to [db].
 	state *state
+	// preExecutionWorkers is the number of workers that pre-execute upcoming
+	// jobs while jobs are executed
+	preExecutionWorkers int
 }
 
 // New attempts to create a new job queue from the provided database.
@@ -52,6 +55,11 @@ func New(
 // SetParser tells this job queue how to parse jobs from the database.
 func (j *Jobs) SetParser(parser Parser) error { j.state.parser = parser; return nil }
 
+// SetPreExecutionWorkers sets the number of workers that run the PreExecute
+// step of upcoming PreExecutable jobs while jobs are executed. If 0, jobs are
+// only executed.
+func (j *Jobs) SetPreExecutionWorkers(numWorkers int) { j.preExecutionWorkers = numWorkers }
+
 func (j *Jobs) Has(jobID ids.ID) (bool, error) { return j.state.HasJob(jobID) }
 
 // Returns how many pending jobs are waiting in the queue.
@@ -100,6 +108,8 @@ func (j *Jobs) ExecuteAll(ctx *snow.ConsensusContext, halter common.Haltable, re
 	numExecuted := 0
 	numToExecute := j.state.numJobs
 	startTime := time.Now()
+	progress := ctx.BootstrapProgress()
+	progress.Executing(numToExecute)
 
 	// Disable and clear state caches to prevent us from attempting to execute
 	// a vertex that was previously parsed, but not saved to the VM. Some VMs
@@ -109,13 +119,30 @@ func (j *Jobs) ExecuteAll(ctx *snow.ConsensusContext, halter common.Haltable, re
 	// TODO remove DisableCaching when VM provides better interface for freeing
 	// blocks.
 	j.state.DisableCaching()
+
+	var preExecutor *preExecutor
+	getJob := j.state.GetJob
+	if j.preExecutionWorkers > 0 {
+		preExecutor = newPreExecutor(j.state, j.preExecutionWorkers)
+		defer preExecutor.stop()
+
+		// Execute the instances of the jobs that were pre-executed, rather
+		// than parsing the jobs again
+		getJob = preExecutor.getJob
+	}
 	for {
 		if halter.Halted() {
 			ctx.Log.Info("Interrupted execution after executing %d operations", numExecuted)
 			return numExecuted, nil
 		}
 
-		job, err := j.state.RemoveRunnableJob()
+		if preExecutor != nil {
+			if err := preExecutor.fill(); err != nil {
+				return 0, fmt.Errorf("failed to schedule pre-execution with %w", err)
+			}
+		}
+
+		job, err := j.state.removeRunnableJob(getJob)
 		if err == database.ErrNotFound {
 			break
 		}
@@ -124,6 +151,7 @@ func (j *Jobs) ExecuteAll(ctx *snow.ConsensusContext, halter common.Haltable, re
 		}
 
 		jobID := job.ID()
+
 		ctx.Log.Debug("Executing: %s", jobID)
 		jobBytes := job.Bytes()
 		// Note that acceptor.Accept must be called before executing [job] to
@@ -163,6 +191,7 @@ func (j *Jobs) ExecuteAll(ctx *snow.ConsensusContext, halter common.Haltable, re
 		}
 
 		numExecuted++
+		progress.Executed(uint64(numExecuted))
 		if numExecuted%StatusUpdateFrequency == 0 { // Periodically print progress
 			eta := timer.EstimateETA(
 				startTime,
@@ -5,6 +5,8 @@ package queue
 
 import (
 	"bytes"
+	"errors"
+	"sync"
 	"testing"
 
 	"github.com/ava-labs/avalanchego/database"
@@ -46,6 +48,14 @@ func testJob(t *testing.T, jobID ids.ID, executed *bool, parentID ids.ID, parent
 	}
 }
 
+// testPreExecutableJob is a TestJob that can be pre-executed
+type testPreExecutableJob struct {
+	*TestJob
+	PreExecuteF func() error
+}
+
+func (j *testPreExecutableJob) PreExecute() error { return j.PreExecuteF() }
+
 // Test that creating a new queue can be created and that it is initially empty.
 func TestNew(t *testing.T) {
 	assert := assert.New(t)
@@ -517,3 +527,108 @@ func TestClearAll(t *testing.T) {
 	assert.NoError(err)
 	assert.False(hasJob1)
 }
+
+// Test that jobs are pre-executed before they are executed, that the
+// dependents of the runnable jobs are pre-executed ahead of time, and that the
+// instance of a job that was pre-executed is the one that is executed.
+func TestPreExecute(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := &TestParser{T: t}
+	db := memdb.New()
+
+	jobs, err := New(db, "", prometheus.NewRegistry())
+	assert.NoError(err)
+	assert.NoError(jobs.SetParser(parser))
+	jobs.SetPreExecutionWorkers(2)
+
+	const numJobs = 5
+	var (
+		jobIDs   = make([]ids.ID, numJobs)
+		executed = make([]bool, numJobs)
+
+		lock        sync.Mutex
+		preExecuted = make([]int, numJobs)
+	)
+	for i := range jobIDs {
+		jobIDs[i] = ids.GenerateTestID()
+	}
+	newJob := func(i int) Job {
+		parentID, parentExecuted := ids.Empty, (*bool)(nil)
+		if i > 0 {
+			parentID, parentExecuted = jobIDs[i-1], &executed[i-1]
+		}
+		job := testJob(t, jobIDs[i], &executed[i], parentID, parentExecuted)
+		job.BytesF = func() []byte { return []byte{byte(i)} }
+		instancePreExecuted := false
+		job.ExecuteF = func() error {
+			lock.Lock()
+			defer lock.Unlock()
+
+			assert.Equal(1, preExecuted[i])
+			assert.True(instancePreExecuted)
+			executed[i] = true
+			return nil
+		}
+		return &testPreExecutableJob{
+			TestJob: job,
+			PreExecuteF: func() error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				preExecuted[i]++
+				instancePreExecuted = true
+				return nil
+			},
+		}
+	}
+	parser.ParseF = func(b []byte) (Job, error) {
+		return newJob(int(b[0])), nil
+	}
+
+	// Push the jobs so that only the first job is runnable
+	for i := numJobs - 1; i >= 0; i-- {
+		pushed, err := jobs.Push(newJob(i))
+		assert.NoError(err)
+		assert.True(pushed)
+	}
+
+	count, err := jobs.ExecuteAll(snow.DefaultConsensusContextTest(), &common.Halter{}, false)
+	assert.NoError(err)
+	assert.Equal(numJobs, count)
+	for i := 0; i < numJobs; i++ {
+		assert.True(executed[i])
+		assert.Equal(1, preExecuted[i])
+	}
+}
+
+// Test that a job that fails to be pre-executed isn't executed
+func TestPreExecuteFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := &TestParser{T: t}
+	db := memdb.New()
+
+	jobs, err := New(db, "", prometheus.NewRegistry())
+	assert.NoError(err)
+	assert.NoError(jobs.SetParser(parser))
+	jobs.SetPreExecutionWorkers(1)
+
+	jobID, executed := ids.GenerateTestID(), false
+	errPreExecute := errors.New("invalid job")
+	newJob := func() Job {
+		return &testPreExecutableJob{
+			TestJob:     testJob(t, jobID, &executed, ids.Empty, nil),
+			PreExecuteF: func() error { return errPreExecute },
+		}
+	}
+	parser.ParseF = func([]byte) (Job, error) { return newJob(), nil }
+
+	pushed, err := jobs.Push(newJob())
+	assert.NoError(err)
+	assert.True(pushed)
+
+	_, err = jobs.ExecuteAll(snow.DefaultConsensusContextTest(), &common.Halter{}, false)
+	assert.ErrorIs(err, errPreExecute)
+	assert.False(executed)
+}
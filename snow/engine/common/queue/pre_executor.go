@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package queue
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// preExecutionWindowPerWorker is the number of upcoming jobs per worker that
+// are parsed and scheduled for pre-execution ahead of their execution
+const preExecutionWindowPerWorker = 8
+
+type preExecution struct {
+	job Job
+	// closed once [err] is set
+	done chan struct{}
+	err  error
+}
+
+// closedChan is the done channel of the jobs that aren't PreExecutable
+var closedChan = func() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+	return c
+}()
+
+// preExecutor runs the PreExecute step of the upcoming jobs of a queue in a
+// pool of workers, while the queue executes jobs in order.
+//
+// The upcoming jobs are the runnable jobs and, transitively, the jobs that
+// depend on them. They are parsed once, by the goroutine executing the queue,
+// and the instance that was pre-executed is the one that is executed. Only the
+// worker pool calls PreExecute. All the other methods must be called from the
+// goroutine executing the queue.
+type preExecutor struct {
+	state  *state
+	window int
+
+	// job ID --> pre-execution of the job, for the jobs that were scheduled
+	// but haven't been executed yet
+	scheduled map[ids.ID]*preExecution
+	// IDs of the scheduled jobs whose dependents haven't been scheduled yet,
+	// in the order they were scheduled
+	frontier []ids.ID
+
+	toPreExecute chan *preExecution
+	workers      sync.WaitGroup
+}
+
+func newPreExecutor(state *state, numWorkers int) *preExecutor {
+	window := numWorkers * preExecutionWindowPerWorker
+	e := &preExecutor{
+		state:     state,
+		window:    window,
+		scheduled: make(map[ids.ID]*preExecution, window),
+		// There are never more than [window] pending pre-executions, so
+		// scheduling never blocks.
+		toPreExecute: make(chan *preExecution, window),
+	}
+	e.workers.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go e.work()
+	}
+	return e
+}
+
+func (e *preExecutor) work() {
+	defer e.workers.Done()
+
+	for p := range e.toPreExecute {
+		p.err = p.job.(PreExecutable).PreExecute()
+		close(p.done)
+	}
+}
+
+// fill schedules upcoming jobs until [window] jobs are scheduled
+func (e *preExecutor) fill() error {
+	if len(e.scheduled) >= e.window {
+		return nil
+	}
+
+	// Jobs that became runnable without depending on a scheduled job are
+	// scheduled first, as they will be executed first.
+	runnableIDs, err := e.state.RunnableJobIDs(e.window)
+	if err != nil {
+		return err
+	}
+	for _, jobID := range runnableIDs {
+		if len(e.scheduled) >= e.window {
+			return nil
+		}
+		if err := e.schedule(jobID); err != nil {
+			return err
+		}
+	}
+
+	for len(e.scheduled) < e.window && len(e.frontier) > 0 {
+		jobID := e.frontier[0]
+		dependentIDs, err := e.state.Dependents(jobID)
+		if err != nil {
+			return err
+		}
+		for _, dependentID := range dependentIDs {
+			if len(e.scheduled) >= e.window {
+				// The remaining dependents of [jobID] are scheduled once
+				// there is room in the window.
+				return nil
+			}
+			if err := e.schedule(dependentID); err != nil {
+				return err
+			}
+		}
+		e.frontier = e.frontier[1:]
+	}
+	return nil
+}
+
+// schedule the pre-execution of [jobID], if it wasn't already scheduled
+func (e *preExecutor) schedule(jobID ids.ID) error {
+	if _, ok := e.scheduled[jobID]; ok {
+		return nil
+	}
+
+	job, err := e.state.GetJob(jobID)
+	if err == database.ErrNotFound {
+		// The dependent job was already executed or was never pushed
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get job %s to pre-execute due to %w", jobID, err)
+	}
+
+	e.frontier = append(e.frontier, jobID)
+	if _, ok := job.(PreExecutable); !ok {
+		e.scheduled[jobID] = &preExecution{
+			job:  job,
+			done: closedChan,
+		}
+		return nil
+	}
+
+	p := &preExecution{
+		job:  job,
+		done: make(chan struct{}),
+	}
+	e.scheduled[jobID] = p
+	e.toPreExecute <- p
+	return nil
+}
+
+// getJob returns the instance of [jobID] that was pre-executed, once its
+// pre-execution finished. If [jobID] wasn't scheduled, it's parsed from the
+// state.
+func (e *preExecutor) getJob(jobID ids.ID) (Job, error) {
+	p, ok := e.scheduled[jobID]
+	if !ok {
+		return e.state.GetJob(jobID)
+	}
+	delete(e.scheduled, jobID)
+
+	<-p.done
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to pre-execute job %s due to %w", jobID, p.err)
+	}
+	return p.job, nil
+}
+
+// stop the workers and wait for the running pre-executions to finish
+func (e *preExecutor) stop() {
+	close(e.toPreExecute)
+	e.workers.Wait()
+}
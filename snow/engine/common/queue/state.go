@@ -164,8 +164,31 @@ func (s *state) HasRunnableJob() (bool, error) {
 	return !isEmpty, err
 }
 
+// RunnableJobIDs returns the IDs of up to [limit] jobs on the runnable queue,
+// starting with the next job
+func (s *state) RunnableJobIDs(limit int) ([]ids.ID, error) {
+	iterator := s.runnableJobIDs.NewIterator()
+	defer iterator.Release()
+
+	jobIDs := []ids.ID(nil)
+	for len(jobIDs) < limit && iterator.Next() {
+		jobID, err := ids.ToID(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+	return jobIDs, iterator.Error()
+}
+
 // RemoveRunnableJob fetches and deletes the next job from the runnable queue
 func (s *state) RemoveRunnableJob() (Job, error) {
+	return s.removeRunnableJob(s.GetJob)
+}
+
+// removeRunnableJob deletes the next job from the runnable queue and returns
+// the instance of the job that [getJob] returns
+func (s *state) removeRunnableJob(getJob func(ids.ID) (Job, error)) (Job, error) {
 	jobIDBytes, err := s.runnableJobIDs.HeadKey()
 	if err != nil {
 		return nil, err
@@ -178,7 +201,7 @@ func (s *state) RemoveRunnableJob() (Job, error) {
 	if err != nil {
 		return nil, fmt.Errorf("couldn't convert job ID bytes to job ID: %w", err)
 	}
-	job, err := s.GetJob(jobID)
+	job, err := getJob(jobID)
 	if err != nil {
 		return nil, err
 	}
@@ -267,6 +290,24 @@ func (s *state) RemoveDependencies(dependency ids.ID) ([]ids.ID, error) {
 	return dependents, iterator.Error()
 }
 
+// Dependents returns the set of IDs that are blocking on the completion of
+// [dependency]
+func (s *state) Dependents(dependency ids.ID) ([]ids.ID, error) {
+	dependentsDB := s.getDependentsDB(dependency)
+	iterator := dependentsDB.NewIterator()
+	defer iterator.Release()
+
+	dependents := []ids.ID(nil)
+	for iterator.Next() {
+		dependent, err := ids.ToID(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, dependent)
+	}
+	return dependents, iterator.Error()
+}
+
 func (s *state) DisableCaching() {
 	s.dependentsCache.Flush()
 	s.jobsCache.Flush()
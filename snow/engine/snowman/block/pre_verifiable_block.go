@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+)
+
+// PreVerifiableBlock is a block that can run the checks of Verify that don't
+// depend on the state of its ancestors, such as verifying its signatures,
+// ahead of Verify.
+//
+// While bootstrapping, upcoming blocks are pre-verified by a pool of workers
+// while the previous blocks are verified and accepted in order.
+type PreVerifiableBlock interface {
+	snowman.Block
+
+	// PreVerify may be called concurrently with any method of the VM and of
+	// its blocks, including PreVerify of other blocks, so it must only
+	// access state that is safe to access concurrently.
+	//
+	// PreVerify must not have side effects, other than caching results that
+	// Verify can reuse, such as recovered public keys. While bootstrapping, the
+	// instance that is pre-verified is the one that is verified, but Verify
+	// must not assume that PreVerify was called.
+	//
+	// Returns an error if the block is invalid.
+	PreVerify() error
+}
+
+// PreVerify pre-verifies [blk] if it's a PreVerifiableBlock. Otherwise, nil is
+// returned.
+func PreVerify(blk snowman.Block) error {
+	if blk, ok := blk.(PreVerifiableBlock); ok {
+		return blk.PreVerify()
+	}
+	return nil
+}
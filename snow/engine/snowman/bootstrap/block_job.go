@@ -17,7 +17,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 )
 
-var errMissingDependenciesOnAccept = errors.New("attempting to accept a block with missing dependencies")
+var (
+	_ queue.PreExecutable = &blockJob{}
+
+	errMissingDependenciesOnAccept = errors.New("attempting to accept a block with missing dependencies")
+)
 
 type parser struct {
 	log                     logging.Logger
@@ -66,6 +70,16 @@ func (b *blockJob) HasMissingDependencies() (bool, error) {
 	return false, nil
 }
 
+// PreExecute pre-verifies the block, if it's a block.PreVerifiableBlock
+func (b *blockJob) PreExecute() error {
+	if err := block.PreVerify(b.blk); err != nil {
+		blkID := b.blk.ID()
+		b.log.Error("block %s failed pre-verification during bootstrapping due to %s", blkID, err)
+		return fmt.Errorf("failed to pre-verify block in bootstrapping: %w", err)
+	}
+	return nil
+}
+
 func (b *blockJob) Execute() error {
 	hasMissingDeps, err := b.HasMissingDependencies()
 	if err != nil {
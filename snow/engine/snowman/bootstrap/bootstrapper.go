@@ -101,6 +101,7 @@ func New(config Config, onFinished func(lastReqID uint32) error) (common.Bootstr
 	if err := b.Blocked.SetParser(b.parser); err != nil {
 		return nil, err
 	}
+	b.Blocked.SetPreExecutionWorkers(config.PreVerificationWorkers)
 
 	config.Bootstrapable = b
 	b.Bootstrapper = common.NewCommonBootstrapper(config.Config)
@@ -129,6 +130,10 @@ func (b *bootstrapper) Start(startReqID uint32) error {
 	b.startingHeight = lastAccepted.Height()
 	b.Config.SharedCfg.RequestID = startReqID
 
+	if err := b.ingestArchives(); err != nil || b.Halted() {
+		return err
+	}
+
 	if !b.StartupTracker.ShouldStart() {
 		return nil
 	}
@@ -299,6 +304,8 @@ func (b *bootstrapper) ForceAccepted(acceptedContainerIDs []ids.ID) error {
 
 	b.initiallyFetched = b.Blocked.PendingJobs()
 	b.startTime = time.Now()
+	b.Ctx.BootstrapProgress().Fetching(b.initiallyFetched)
+	b.Ctx.BootstrapProgress().SetHeights(b.startingHeight, b.tipHeight)
 
 	// Process received blocks
 	for _, blk := range toProcess {
@@ -400,6 +407,7 @@ func (b *bootstrapper) process(blk snowman.Block, processingBlocks map[ids.ID]sn
 		// tipHeight for logging
 		if blkHeight > b.tipHeight {
 			b.tipHeight = blkHeight
+			b.Ctx.BootstrapProgress().SetHeights(b.startingHeight, b.tipHeight)
 		}
 
 		pushed, err := b.Blocked.Push(&blockJob{
@@ -428,6 +436,7 @@ func (b *bootstrapper) process(blk snowman.Block, processingBlocks map[ids.ID]sn
 
 		// Periodically log progress
 		blocksFetchedSoFar := b.Blocked.Jobs.PendingJobs()
+		b.Ctx.BootstrapProgress().Fetched(blocksFetchedSoFar)
 		if blocksFetchedSoFar%common.StatusUpdateFrequency == 0 {
 			totalBlocksToFetch := b.tipHeight - b.startingHeight
 			eta := timer.EstimateETA(
@@ -0,0 +1,395 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer/archive"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/utils/crypto"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// Number of blocks of the checkpoint archive that are pushed onto the jobs
+// queue between commits
+const checkpointCommitFrequency = 1024
+
+var (
+	errUntrustedCheckpoint     = errors.New("checkpoint isn't signed by a trusted signer")
+	errWrongArchiveChain       = errors.New("archive is of the wrong chain")
+	errArchiveMissingParent    = errors.New("archive doesn't contain the parent of a block")
+	errArchiveMissingHeight    = errors.New("archive is missing blocks")
+	errArchiveMissingLastBlock = errors.New("archive doesn't extend the last accepted block")
+	errArchiveWrongCheckpoint  = errors.New("archive doesn't contain the block it was ingested up to")
+	errArchiveModified         = errors.New("archive was modified after it was verified")
+	errArchiveWrongID          = errors.New("archived block has the wrong ID")
+
+	secp256k1rFactory = crypto.FactorySECP256K1R{}
+)
+
+// Checkpoint is an accepted block of a chain that the node operator trusts.
+// The chain can be bootstrapped up to the checkpoint from a local archive of
+// its blocks, rather than from peers.
+type Checkpoint struct {
+	ChainID ids.ID      `json:"chainID"`
+	BlockID ids.ID      `json:"blockID"`
+	Height  json.Uint64 `json:"height"`
+	// Hex encoded secp256k1 signature of [UnsignedBytes]
+	Signature string `json:"signature"`
+}
+
+// UnsignedBytes returns the bytes that the signature of the checkpoint signs
+func (c *Checkpoint) UnsignedBytes() []byte {
+	p := wrappers.Packer{Bytes: make([]byte, 2*hashing.HashLen+wrappers.LongLen)}
+	p.PackFixedBytes(c.ChainID[:])
+	p.PackFixedBytes(c.BlockID[:])
+	p.PackLong(uint64(c.Height))
+	return p.Bytes
+}
+
+// Sign sets the signature of the checkpoint to the signature of [key]
+func (c *Checkpoint) Sign(key crypto.PrivateKey) error {
+	sig, err := key.Sign(c.UnsignedBytes())
+	if err != nil {
+		return err
+	}
+	c.Signature, err = formatting.EncodeWithChecksum(formatting.Hex, sig)
+	return err
+}
+
+// Signer returns the address of the key that signed the checkpoint
+func (c *Checkpoint) Signer() (ids.ShortID, error) {
+	sig, err := formatting.Decode(formatting.Hex, c.Signature)
+	if err != nil {
+		return ids.ShortID{}, fmt.Errorf("couldn't decode checkpoint signature: %w", err)
+	}
+	pk, err := secp256k1rFactory.RecoverPublicKey(c.UnsignedBytes(), sig)
+	if err != nil {
+		return ids.ShortID{}, fmt.Errorf("couldn't recover checkpoint signer: %w", err)
+	}
+	return pk.Address(), nil
+}
+
+// Verify returns nil iff the checkpoint is signed by one of [trustedSigners]
+func (c *Checkpoint) Verify(trustedSigners ids.ShortSet) error {
+	signer, err := c.Signer()
+	if err != nil {
+		return err
+	}
+	if !trustedSigners.Contains(signer) {
+		return fmt.Errorf("%w: %s", errUntrustedCheckpoint, signer)
+	}
+	return nil
+}
+
+// CheckpointConfig is a checkpoint that a chain is bootstrapped from
+type CheckpointConfig struct {
+	// Checkpoint is the block that the chain is bootstrapped up to. It must
+	// have already been verified.
+	Checkpoint Checkpoint `json:"checkpoint"`
+	// ArchivePath is the path to an archive, produced by the indexer, of the
+	// blocks of the chain up to [Checkpoint]
+	ArchivePath string `json:"archivePath"`
+}
+
+// ArchiveImport is an archive of the blocks of the chain, imported into the
+// node by its operator, that the chain is bootstrapped from before peers are
+// contacted
+type ArchiveImport struct {
+	// BlockID is the last block of the archive, which the chain is
+	// bootstrapped up to
+	BlockID ids.ID
+	// ArchivePath is the path to the archive, produced by the indexer
+	ArchivePath string
+}
+
+// archiveTarget is a block that the chain is bootstrapped up to from an
+// archive of the blocks of the chain
+type archiveTarget struct {
+	// Describes the target in logs
+	name    string
+	blockID ids.ID
+	// Height of [blockID], or 0 if it isn't known
+	height      uint64
+	archivePath string
+}
+
+// ingestArchives executes the blocks of the checkpoint archive and of the
+// imported archive, if any, that are above the last accepted block, so that
+// the chain is bootstrapped up to them before peers are contacted.
+func (b *bootstrapper) ingestArchives() error {
+	if checkpoint := b.Config.Checkpoint; checkpoint != nil {
+		err := b.ingestArchive(archiveTarget{
+			name:        "checkpoint",
+			blockID:     checkpoint.Checkpoint.BlockID,
+			height:      uint64(checkpoint.Checkpoint.Height),
+			archivePath: checkpoint.ArchivePath,
+		})
+		if err != nil || b.Halted() {
+			return err
+		}
+	}
+	if archiveImport := b.Config.ArchiveImport; archiveImport != nil {
+		return b.ingestArchive(archiveTarget{
+			name:        "imported archive",
+			blockID:     archiveImport.BlockID,
+			archivePath: archiveImport.ArchivePath,
+		})
+	}
+	return nil
+}
+
+// ingestArchive executes the blocks of the archive of [target] that are above
+// the last accepted block and up to [target].
+//
+// The archive is read twice. The first time, it's verified that its checksum
+// is valid and that it contains [target], without parsing its blocks. The
+// second time, its blocks are parsed and pushed onto the jobs queue, as long as
+// they form a chain from the last accepted block to [target]. Blocks above
+// [target] are ignored.
+func (b *bootstrapper) ingestArchive(target archiveTarget) error {
+	if target.height != 0 && target.height <= b.startingHeight {
+		b.Ctx.Log.Info("skipping bootstrapping from %s %s at height %d, as the last accepted height is %d",
+			target.name, target.blockID, target.height, b.startingHeight)
+		return nil
+	}
+	if accepted, err := b.isAccepted(target.blockID); err != nil {
+		return err
+	} else if accepted {
+		b.Ctx.Log.Info("skipping bootstrapping from %s %s, as it's already accepted", target.name, target.blockID)
+		return nil
+	}
+
+	// The blocks may have been ingested before the node was restarted, without
+	// being executed yet
+	if has, err := b.Blocked.Has(target.blockID); err != nil {
+		return err
+	} else if has {
+		b.Ctx.Log.Info("%s %s was already ingested", target.name, target.blockID)
+	} else if err := b.pushArchiveBlocks(target); err != nil {
+		return err
+	}
+
+	b.Ctx.Log.Info("executing the blocks up to %s %s", target.name, target.blockID)
+	_, err := b.Blocked.ExecuteAll(
+		b.Config.Ctx,
+		b,
+		b.Config.SharedCfg.Restarted,
+		b.Ctx.ConsensusAcceptor,
+		b.Ctx.DecisionAcceptor,
+	)
+	if err != nil || b.Halted() {
+		return err
+	}
+
+	lastAcceptedID, err := b.VM.LastAccepted()
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted ID: %w", err)
+	}
+	lastAccepted, err := b.VM.GetBlock(lastAcceptedID)
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted block: %w", err)
+	}
+	b.startingHeight = lastAccepted.Height()
+	if accepted, err := b.isAccepted(target.blockID); err != nil {
+		return err
+	} else if !accepted {
+		return fmt.Errorf("%w: last accepted height is %d after executing the archive",
+			errArchiveWrongCheckpoint, b.startingHeight)
+	}
+	b.Ctx.Log.Info("bootstrapped up to %s %s at height %d", target.name, target.blockID, b.startingHeight)
+	return nil
+}
+
+// isAccepted returns true if the VM accepted [blkID]
+func (b *bootstrapper) isAccepted(blkID ids.ID) (bool, error) {
+	blk, err := b.VM.GetBlock(blkID)
+	if err != nil {
+		// The VM doesn't know the block yet
+		return false, nil
+	}
+	return blk.Status() == choices.Accepted, nil
+}
+
+// pushArchiveBlocks verifies the archive of [target] and pushes its blocks up
+// to [target] onto the jobs queue
+func (b *bootstrapper) pushArchiveBlocks(target archiveTarget) error {
+	b.Ctx.Log.Info("verifying the archive of the blocks up to %s %s", target.name, target.blockID)
+	if err := b.verifyArchive(target); err != nil {
+		return fmt.Errorf("couldn't verify archive %s: %w", target.archivePath, err)
+	}
+
+	b.Ctx.Log.Info("ingesting the blocks of the archive %s", target.archivePath)
+	var (
+		numIngested  = 0
+		targetHeight = target.height
+	)
+	err := b.forEachArchiveBlock(target, func(blk snowman.Block) error {
+		pushed, err := b.Blocked.Push(&blockJob{
+			parser:      b.parser,
+			log:         b.Ctx.Log,
+			numAccepted: b.numAccepted,
+			numDropped:  b.numDropped,
+			blk:         blk,
+			vm:          b.VM,
+		})
+		if err != nil {
+			return err
+		}
+		numIngested++
+		targetHeight = blk.Height()
+		if !pushed {
+			return nil
+		}
+		b.numFetched.Inc()
+
+		if numIngested%checkpointCommitFrequency == 0 {
+			if err := b.Blocked.Commit(); err != nil {
+				return err
+			}
+		}
+		if numIngested%common.StatusUpdateFrequency == 0 {
+			b.Ctx.Log.Info("ingested %d blocks from the archive", numIngested)
+		}
+		return nil
+	})
+	// Every pushed block extends the last accepted block, so they are kept
+	// even if the archive became invalid afterwards
+	if commitErr := b.Blocked.Commit(); commitErr != nil {
+		return commitErr
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't ingest archive %s: %w", target.archivePath, err)
+	}
+
+	if targetHeight > b.tipHeight {
+		b.tipHeight = targetHeight
+	}
+	b.Ctx.BootstrapProgress().SetHeights(b.startingHeight, b.tipHeight)
+	b.Ctx.BootstrapProgress().Fetched(b.Blocked.PendingJobs())
+	b.Ctx.Log.Info("ingested %d blocks from the archive", numIngested)
+	return nil
+}
+
+// verifyArchive returns nil if the archive of [target] is of this chain, has a
+// valid checksum and contains [target]. Its blocks aren't parsed.
+func (b *bootstrapper) verifyArchive(target archiveTarget) error {
+	file, err := os.Open(target.archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	r, err := b.newArchiveReader(file)
+	if err != nil {
+		return err
+	}
+	hasTarget := false
+	for {
+		container, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		hasTarget = hasTarget || container.ID == target.blockID
+	}
+	if !hasTarget {
+		return fmt.Errorf("%w: %s isn't archived", errArchiveWrongCheckpoint, target.blockID)
+	}
+	return nil
+}
+
+// forEachArchiveBlock calls [f] with the blocks of the archive of [target]
+// that are above the last accepted block and up to [target], in increasing
+// height. Returns an error, without calling [f] with the remaining blocks, once
+// a block doesn't extend the blocks before it.
+func (b *bootstrapper) forEachArchiveBlock(target archiveTarget, f func(snowman.Block) error) error {
+	file, err := os.Open(target.archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	r, err := b.newArchiveReader(file)
+	if err != nil {
+		return err
+	}
+
+	lastAcceptedID, err := b.VM.LastAccepted()
+	if err != nil {
+		return err
+	}
+	var (
+		// ID of the last block passed to [f]
+		prevID     = lastAcceptedID
+		prevHeight = b.startingHeight
+	)
+	for prevID != target.blockID {
+		container, err := r.Next()
+		if err == io.EOF {
+			// The archive contained the target when it was verified
+			return fmt.Errorf("%w: archive ends at height %d", errArchiveModified, prevHeight)
+		}
+		if err != nil {
+			return err
+		}
+
+		blk, err := b.VM.ParseBlock(container.Bytes)
+		if err != nil {
+			return fmt.Errorf("couldn't parse block %s: %w", container.ID, err)
+		}
+		blkID := blk.ID()
+		if blkID != container.ID {
+			return fmt.Errorf("%w: %s is archived as %s", errArchiveWrongID, blkID, container.ID)
+		}
+		height := blk.Height()
+		if height <= b.startingHeight {
+			continue
+		}
+		if height != prevHeight+1 {
+			return fmt.Errorf("%w: expected height %d but got %d", errArchiveMissingHeight, prevHeight+1, height)
+		}
+		if parentID := blk.Parent(); parentID != prevID {
+			if prevID == lastAcceptedID {
+				return fmt.Errorf("%w: %s has parent %s", errArchiveMissingLastBlock, blkID, parentID)
+			}
+			return fmt.Errorf("%w: %s has parent %s rather than %s", errArchiveMissingParent, blkID, parentID, prevID)
+		}
+		if height == target.height && blkID != target.blockID {
+			return fmt.Errorf("%w: block at height %d is %s", errArchiveWrongCheckpoint, height, blkID)
+		}
+
+		if err := f(blk); err != nil {
+			return err
+		}
+		prevID = blkID
+		prevHeight = height
+	}
+	return nil
+}
+
+// newArchiveReader returns a reader of the archive in [file], which must be of
+// this chain
+func (b *bootstrapper) newArchiveReader(file io.Reader) (*archive.Reader, error) {
+	r, err := archive.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	if chainID := r.ChainID(); chainID != b.Ctx.ChainID {
+		return nil, fmt.Errorf("%w: %s", errWrongArchiveChain, chainID)
+	}
+	return r, nil
+}
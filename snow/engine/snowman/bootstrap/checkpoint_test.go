@@ -0,0 +1,308 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer/archive"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils/crypto"
+)
+
+// newTestChain returns a chain of [numBlocks] blocks whose first block is
+// accepted
+func newTestChain(numBlocks int) []*snowman.TestBlock {
+	blks := make([]*snowman.TestBlock, numBlocks)
+	for i := range blks {
+		blks[i] = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.Empty.Prefix(uint64(i)),
+				StatusV: choices.Processing,
+			},
+			HeightV: uint64(i),
+			BytesV:  []byte{byte(i)},
+		}
+		if i > 0 {
+			blks[i].ParentV = blks[i-1].IDV
+		}
+	}
+	blks[0].StatusV = choices.Accepted
+	return blks
+}
+
+// writeTestArchive writes an archive of [blks] and returns its path
+func writeTestArchive(t *testing.T, chainID ids.ID, blks []*snowman.TestBlock) string {
+	path := filepath.Join(t.TempDir(), "archive")
+	file, err := os.Create(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	w, err := archive.NewWriter(file, chainID)
+	assert.NoError(t, err)
+	for i, blk := range blks {
+		assert.NoError(t, w.Write(archive.Container{
+			Index: uint64(i),
+			ID:    blk.ID(),
+			Bytes: blk.Bytes(),
+		}))
+	}
+	assert.NoError(t, w.Close())
+	return path
+}
+
+func TestCheckpointSignature(t *testing.T) {
+	assert := assert.New(t)
+
+	factory := crypto.FactorySECP256K1R{}
+	key, err := factory.NewPrivateKey()
+	assert.NoError(err)
+	otherKey, err := factory.NewPrivateKey()
+	assert.NoError(err)
+
+	checkpoint := Checkpoint{
+		ChainID: ids.GenerateTestID(),
+		BlockID: ids.GenerateTestID(),
+		Height:  10,
+	}
+	assert.NoError(checkpoint.Sign(key))
+
+	signer, err := checkpoint.Signer()
+	assert.NoError(err)
+	assert.Equal(key.PublicKey().Address(), signer)
+	assert.NoError(checkpoint.Verify(ids.ShortSet{signer: struct{}{}}))
+	assert.ErrorIs(checkpoint.Verify(ids.ShortSet{otherKey.PublicKey().Address(): struct{}{}}), errUntrustedCheckpoint)
+
+	// Changing the checkpoint invalidates the signature
+	checkpoint.Height++
+	assert.ErrorIs(checkpoint.Verify(ids.ShortSet{signer: struct{}{}}), errUntrustedCheckpoint)
+}
+
+// setCheckpointVM sets [vm] to serve the blocks of [blks], the last accepted
+// one being the last accepted block
+func setCheckpointVM(vm *block.TestVM, blks []*snowman.TestBlock) {
+	vm.CantLastAccepted = false
+	vm.LastAcceptedF = func() (ids.ID, error) {
+		lastAccepted := blks[0]
+		for _, blk := range blks {
+			if blk.Status() == choices.Accepted {
+				lastAccepted = blk
+			}
+		}
+		return lastAccepted.ID(), nil
+	}
+	vm.GetBlockF = func(blkID ids.ID) (snowman.Block, error) {
+		for _, blk := range blks {
+			if blk.ID() == blkID {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBlock
+	}
+	vm.ParseBlockF = func(blkBytes []byte) (snowman.Block, error) {
+		for _, blk := range blks {
+			if bytes.Equal(blk.Bytes(), blkBytes) {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBlock
+	}
+}
+
+func TestBootstrapperCheckpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	config, _, sender, vm := newConfig(t)
+	blks := newTestChain(5)
+	config.Checkpoint = &CheckpointConfig{
+		Checkpoint: Checkpoint{
+			ChainID: config.Ctx.ChainID,
+			BlockID: blks[3].ID(),
+			Height:  3,
+		},
+		ArchivePath: writeTestArchive(t, config.Ctx.ChainID, blks),
+	}
+	setCheckpointVM(vm, blks)
+
+	bs, err := New(
+		config,
+		func(lastReqID uint32) error { config.Ctx.SetState(snow.NormalOp); return nil },
+	)
+	assert.NoError(err)
+
+	// The blocks up to the checkpoint are executed before the frontier is
+	// fetched from peers
+	sender.SendGetAcceptedFrontierF = func(ids.NodeIDSet, uint32) {
+		for _, blk := range blks[1:4] {
+			assert.Equal(choices.Accepted, blk.Status())
+		}
+	}
+	vm.CantSetState = false
+	assert.NoError(bs.Start(0))
+	assert.Zero(config.Blocked.PendingJobs())
+	assert.Equal(choices.Processing, blks[4].Status())
+
+	// Peers report the checkpoint as the accepted frontier
+	assert.NoError(bs.ForceAccepted([]ids.ID{blks[3].ID()}))
+	assert.EqualValues(snow.NormalOp, config.Ctx.GetState())
+	assert.Equal(choices.Processing, blks[4].Status())
+}
+
+func TestBootstrapperCheckpointWithoutPeers(t *testing.T) {
+	assert := assert.New(t)
+
+	config, _, sender, vm := newConfig(t)
+	blks := newTestChain(5)
+	config.Checkpoint = &CheckpointConfig{
+		Checkpoint: Checkpoint{
+			ChainID: config.Ctx.ChainID,
+			BlockID: blks[3].ID(),
+			Height:  3,
+		},
+		ArchivePath: writeTestArchive(t, config.Ctx.ChainID, blks),
+	}
+	setCheckpointVM(vm, blks)
+	// No beacons are connected
+	config.StartupTracker = tracker.NewStartup(tracker.NewPeers(), config.Beacons.Weight())
+
+	bs, err := New(
+		config,
+		func(lastReqID uint32) error { config.Ctx.SetState(snow.NormalOp); return nil },
+	)
+	assert.NoError(err)
+
+	// No peers are contacted
+	sender.CantSendGetAcceptedFrontier = true
+	vm.CantSetState = false
+	assert.NoError(bs.Start(0))
+
+	// The chain reached the checkpoint
+	lastAcceptedID, err := vm.LastAccepted()
+	assert.NoError(err)
+	assert.Equal(blks[3].ID(), lastAcceptedID)
+	for _, blk := range blks[1:4] {
+		assert.Equal(choices.Accepted, blk.Status())
+	}
+	assert.Equal(choices.Processing, blks[4].Status())
+	assert.Zero(config.Blocked.PendingJobs())
+	assert.EqualValues(snow.Bootstrapping, config.Ctx.GetState())
+}
+
+func TestBootstrapperCheckpointWrongBlock(t *testing.T) {
+	assert := assert.New(t)
+
+	config, _, _, vm := newConfig(t)
+	blks := newTestChain(4)
+	config.Checkpoint = &CheckpointConfig{
+		Checkpoint: Checkpoint{
+			ChainID: config.Ctx.ChainID,
+			BlockID: ids.GenerateTestID(),
+			Height:  3,
+		},
+		ArchivePath: writeTestArchive(t, config.Ctx.ChainID, blks),
+	}
+
+	vm.CantLastAccepted = false
+	vm.LastAcceptedF = func() (ids.ID, error) { return blks[0].ID(), nil }
+	vm.GetBlockF = func(blkID ids.ID) (snowman.Block, error) {
+		if blkID != blks[0].ID() {
+			return nil, errUnknownBlock
+		}
+		return blks[0], nil
+	}
+	vm.ParseBlockF = func(blkBytes []byte) (snowman.Block, error) {
+		return blks[blkBytes[0]], nil
+	}
+
+	bs, err := New(
+		config,
+		func(lastReqID uint32) error { config.Ctx.SetState(snow.NormalOp); return nil },
+	)
+	assert.NoError(err)
+
+	vm.CantSetState = false
+	assert.ErrorIs(bs.Start(0), errArchiveWrongCheckpoint)
+	assert.Zero(config.Blocked.PendingJobs())
+}
+
+func TestBootstrapperCheckpointCorruptArchive(t *testing.T) {
+	assert := assert.New(t)
+
+	config, _, _, vm := newConfig(t)
+	blks := newTestChain(4)
+	archivePath := writeTestArchive(t, config.Ctx.ChainID, blks)
+	config.Checkpoint = &CheckpointConfig{
+		Checkpoint: Checkpoint{
+			ChainID: config.Ctx.ChainID,
+			BlockID: blks[3].ID(),
+			Height:  3,
+		},
+		ArchivePath: archivePath,
+	}
+	setCheckpointVM(vm, blks)
+
+	// Corrupt the checksum of the archive
+	archiveBytes, err := os.ReadFile(archivePath)
+	assert.NoError(err)
+	archiveBytes[len(archiveBytes)-1]++
+	assert.NoError(os.WriteFile(archivePath, archiveBytes, 0o600))
+
+	bs, err := New(
+		config,
+		func(lastReqID uint32) error { config.Ctx.SetState(snow.NormalOp); return nil },
+	)
+	assert.NoError(err)
+
+	// The archive is rejected before any of its blocks are parsed
+	vm.ParseBlockF = func([]byte) (snowman.Block, error) {
+		t.Fatal("parsed a block of a corrupt archive")
+		return nil, errUnknownBlock
+	}
+	vm.CantSetState = false
+	assert.Error(bs.Start(0))
+	assert.Zero(config.Blocked.PendingJobs())
+}
+
+func TestBootstrapperArchiveImport(t *testing.T) {
+	assert := assert.New(t)
+
+	config, _, _, vm := newConfig(t)
+	blks := newTestChain(5)
+	config.ArchiveImport = &ArchiveImport{
+		BlockID:     blks[4].ID(),
+		ArchivePath: writeTestArchive(t, config.Ctx.ChainID, blks),
+	}
+	setCheckpointVM(vm, blks)
+	// No beacons are connected
+	config.StartupTracker = tracker.NewStartup(tracker.NewPeers(), config.Beacons.Weight())
+
+	bs, err := New(
+		config,
+		func(lastReqID uint32) error { config.Ctx.SetState(snow.NormalOp); return nil },
+	)
+	assert.NoError(err)
+
+	vm.CantSetState = false
+	assert.NoError(bs.Start(0))
+
+	// The chain reached the last block of the archive
+	for _, blk := range blks[1:] {
+		assert.Equal(choices.Accepted, blk.Status())
+	}
+	assert.Zero(config.Blocked.PendingJobs())
+
+	// Once the archive was executed, it isn't read again
+	config.ArchiveImport.ArchivePath = filepath.Join(t.TempDir(), "missing")
+	assert.NoError(bs.Start(0))
+}
@@ -22,5 +22,20 @@ type Config struct {
 
 	VM block.ChainVM
 
+	// PreVerificationWorkers is the number of workers that pre-verify
+	// upcoming blocks while blocks are executed. If 0, blocks aren't
+	// pre-verified. See block.PreVerifiableBlock.
+	PreVerificationWorkers int
+
+	// Checkpoint that the chain is bootstrapped up to from a local archive,
+	// before the chain is bootstrapped from peers. nil if the chain is only
+	// bootstrapped from peers.
+	Checkpoint *CheckpointConfig
+
+	// Archive that was imported into the node, which the chain is
+	// bootstrapped up to after [Checkpoint], before the chain is bootstrapped
+	// from peers. nil if no archive of the chain was imported.
+	ArchiveImport *ArchiveImport
+
 	Bootstrapped func()
 }
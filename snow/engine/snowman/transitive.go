@@ -593,6 +593,10 @@ func (t *Transitive) pullQuery(blkID ids.ID) {
 
 	t.RequestID++
 	if t.polls.Add(t.RequestID, vdrBag) {
+		if t.Tracer != nil {
+			t.Tracer.PollStarted(t.RequestID, vdrBag)
+		}
+
 		vdrList := vdrBag.List()
 		vdrSet := ids.NewNodeIDSet(len(vdrList))
 		vdrSet.Add(vdrList...)
@@ -617,6 +621,10 @@ func (t *Transitive) sendMixedQuery(blk snowman.Block) {
 
 	t.RequestID++
 	if t.polls.Add(t.RequestID, vdrBag) {
+		if t.Tracer != nil {
+			t.Tracer.PollStarted(t.RequestID, vdrBag)
+		}
+
 		// Send a push query to some of the validators, and a pull query to the rest.
 		numPushTo := t.Params.MixedQueryNumPushVdr
 		if !t.Validators.Contains(t.Ctx.NodeID) {
@@ -32,6 +32,10 @@ func (v *voter) Update() {
 		return
 	}
 
+	if v.t.Tracer != nil {
+		v.t.Tracer.Responded(v.requestID, v.vdr, v.response)
+	}
+
 	var results []ids.Bag
 	if v.response == ids.Empty {
 		results = v.t.polls.Drop(v.requestID, v.vdr)
@@ -76,11 +76,11 @@ type handler struct {
 	// Tracks cpu/disk usage caused by each peer.
 	resourceTracker tracker.ResourceTracker
 
-	// Holds messages that [engine] hasn't processed yet.
-	// [unprocessedMsgsCond.L] must be held while accessing [syncMessageQueue].
+	// Holds messages that [engine] hasn't processed yet, in lanes.
+	messageQueue PrioritizedMessageQueue
+	// Pops the consensus and bootstrapping messages of [messageQueue].
 	syncMessageQueue MessageQueue
-	// Holds messages that [engine] hasn't processed yet.
-	// [unprocessedAsyncMsgsCond.L] must be held while accessing [asyncMessageQueue].
+	// Pops the app messages of [messageQueue].
 	asyncMessageQueue MessageQueue
 	// Worker pool for handling asynchronous consensus messages
 	asyncMessagePool worker.Pool
@@ -103,6 +103,7 @@ func New(
 	preemptTimeouts chan struct{},
 	gossipFrequency time.Duration,
 	resourceTracker tracker.ResourceTracker,
+	laneWeights LaneWeights,
 ) (Handler, error) {
 	h := &handler{
 		ctx:              ctx,
@@ -125,14 +126,12 @@ func New(
 		return nil, fmt.Errorf("initializing handler metrics errored with: %w", err)
 	}
 	cpuTracker := resourceTracker.CPUTracker()
-	h.syncMessageQueue, err = NewMessageQueue(h.ctx.Log, h.validators, cpuTracker, "handler", h.ctx.Registerer, message.SynchronousOps)
+	h.messageQueue, err = NewPrioritizedMessageQueue(h.ctx.Log, h.validators, cpuTracker, "handler", h.ctx.Registerer, laneWeights.laneConfigs())
 	if err != nil {
-		return nil, fmt.Errorf("initializing sync message queue errored with: %w", err)
-	}
-	h.asyncMessageQueue, err = NewMessageQueue(h.ctx.Log, h.validators, cpuTracker, "handler_async", h.ctx.Registerer, message.AsynchronousOps)
-	if err != nil {
-		return nil, fmt.Errorf("initializing async message queue errored with: %w", err)
+		return nil, fmt.Errorf("initializing message queue errored with: %w", err)
 	}
+	h.syncMessageQueue = h.messageQueue.Lanes(consensusLane, bootstrappingLane)
+	h.asyncMessageQueue = h.messageQueue.Lanes(appLane)
 	return h, nil
 }
 
@@ -219,14 +218,9 @@ func (h *handler) HealthCheck() (interface{}, error) {
 	return engine.HealthCheck()
 }
 
-// Push the message onto the handler's queue
+// Push the message onto the lane of the handler's queue for the message's op
 func (h *handler) Push(msg message.InboundMessage) {
-	switch msg.Op() {
-	case message.AppRequest, message.AppGossip, message.AppRequestFailed, message.AppResponse:
-		h.asyncMessageQueue.Push(msg)
-	default:
-		h.syncMessageQueue.Push(msg)
-	}
+	h.messageQueue.Push(msg)
 }
 
 func (h *handler) RegisterTimeout(d time.Duration) {
@@ -253,8 +247,7 @@ func (h *handler) Stop() {
 	h.closeOnce.Do(func() {
 		// Must hold the locks here to ensure there's no race condition in where
 		// we check the value of [h.closing] after the call to [Signal].
-		h.syncMessageQueue.Shutdown()
-		h.asyncMessageQueue.Shutdown()
+		h.messageQueue.Shutdown()
 		close(h.closingChan)
 
 		// TODO: switch this to use a [context.Context] with a cancel function.
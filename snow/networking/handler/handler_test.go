@@ -46,6 +46,7 @@ func TestHandlerDropsTimedOutMessages(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 	handler := handlerIntf.(*handler)
@@ -124,6 +125,7 @@ func TestHandlerClosesOnError(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 	handler := handlerIntf.(*handler)
@@ -195,6 +197,7 @@ func TestHandlerDropsGossipDuringBootstrapping(t *testing.T) {
 		nil,
 		1,
 		resourceTracker,
+		DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 	handler := handlerIntf.(*handler)
@@ -258,6 +261,7 @@ func TestHandlerDispatchInternal(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
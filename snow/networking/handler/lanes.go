@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package handler
+
+import (
+	"github.com/ava-labs/avalanchego/message"
+)
+
+// Lanes of the handler's message queue, in order of priority
+const (
+	consensusLane = iota
+	bootstrappingLane
+	appLane
+)
+
+var (
+	// DefaultLaneWeights handles up to 4 consensus messages for every 2
+	// bootstrapping messages while messages of both lanes are queued.
+	DefaultLaneWeights = LaneWeights{
+		Consensus:     4,
+		Bootstrapping: 2,
+	}
+
+	consensusLaneOps = []message.Op{
+		message.Get,
+		message.Put,
+		message.PushQuery,
+		message.PullQuery,
+		message.Chits,
+		message.GetFailed,
+		message.QueryFailed,
+		message.Connected,
+		message.Disconnected,
+	}
+	bootstrappingLaneOps = []message.Op{
+		message.GetAcceptedFrontier,
+		message.AcceptedFrontier,
+		message.GetAccepted,
+		message.Accepted,
+		message.GetAncestors,
+		message.Ancestors,
+		message.GetAcceptedFrontierFailed,
+		message.GetAcceptedFailed,
+		message.GetAncestorsFailed,
+
+		// State sync
+		message.GetStateSummaryFrontier,
+		message.StateSummaryFrontier,
+		message.GetAcceptedStateSummary,
+		message.AcceptedStateSummary,
+		message.GetStateSummaryFrontierFailed,
+		message.GetAcceptedStateSummaryFailed,
+	}
+	appLaneOps = message.AsynchronousOps
+)
+
+// LaneWeights are the number of messages of each lane that are handled in
+// each round while messages of multiple lanes are queued. App messages are
+// handled by a separate dispatcher, so slow app message handling never delays
+// consensus and bootstrapping messages. Since the app lane is the only lane of
+// its dispatcher, it doesn't have a weight.
+type LaneWeights struct {
+	// Weight of the lane of consensus messages, such as queries and votes
+	Consensus int `json:"consensus"`
+	// Weight of the lane of bootstrapping and state sync messages
+	Bootstrapping int `json:"bootstrapping"`
+}
+
+func (w LaneWeights) laneConfigs() []LaneConfig {
+	return []LaneConfig{
+		consensusLane: {
+			Name:   "consensus",
+			Ops:    consensusLaneOps,
+			Weight: w.Consensus,
+		},
+		bootstrappingLane: {
+			Name:   "bootstrapping",
+			Ops:    bootstrappingLaneOps,
+			Weight: w.Bootstrapping,
+		},
+		appLane: {
+			Name:   "app",
+			Ops:    appLaneOps,
+			Weight: 1,
+		},
+	}
+}
@@ -4,6 +4,7 @@
 package handler
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -16,7 +17,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 )
 
-var _ MessageQueue = &messageQueue{}
+var (
+	_ MessageQueue            = &messageQueue{}
+	_ PrioritizedMessageQueue = &messageQueue{}
+	_ MessageQueue            = &laneQueue{}
+)
 
 type MessageQueue interface {
 	// Add a message.
@@ -38,6 +43,46 @@ type MessageQueue interface {
 	Shutdown()
 }
 
+// PrioritizedMessageQueue queues messages in lanes. While multiple lanes have
+// messages, messages are popped from the lanes in rounds. In each round, at
+// most [Weight] messages are popped from each lane.
+type PrioritizedMessageQueue interface {
+	MessageQueue
+
+	// Lanes returns a MessageQueue whose Pop only returns messages of
+	// [lanes], where lanes are identified by their index in the configs that
+	// the queue was created with. Pop prefers the earlier of [lanes] when
+	// multiple of them can be popped from.
+	//
+	// Rounds are tracked separately for each returned MessageQueue, so a
+	// MessageQueue whose messages aren't being popped never blocks the Pop of
+	// another one. The lanes of different returned MessageQueues must not
+	// overlap.
+	//
+	// Calling Push or Shutdown on the returned MessageQueue pushes to, or
+	// shuts down, the whole queue.
+	Lanes(lanes ...int) MessageQueue
+}
+
+type LaneConfig struct {
+	// Name of the lane that is used in the lane's metrics
+	Name string
+	// Ops of the messages that are queued in the lane
+	Ops []message.Op
+	// Weight is the number of messages that are popped from the lane in each
+	// round. Must be > 0.
+	Weight int
+}
+
+type lane struct {
+	weight int
+	// Number of messages that can still be popped from this lane in the
+	// current round
+	credits int
+	// Unprocessed messages
+	msgs []message.InboundMessage
+}
+
 // TODO: Use a better data structure for this.
 // We can do something better than pushing to the back of a queue. A multi-level
 // queue?
@@ -54,12 +99,19 @@ type messageQueue struct {
 
 	cond   *sync.Cond
 	closed bool
-	// Node ID --> Messages this node has in [msgs]
+	// Node ID --> Messages this node has in [lanes]
 	nodeToUnprocessedMsgs map[ids.NodeID]int
-	// Unprocessed messages
-	msgs []message.InboundMessage
+	// Op --> index of the lane that messages with the op are queued in.
+	// Messages with ops that aren't in any lane are queued in the first lane.
+	opToLane map[message.Op]int
+	lanes    []*lane
+	// Indices of all the lanes
+	allLanes []int
 }
 
+// NewMessageQueue returns a queue of messages with [ops] that pops messages
+// in FIFO order, except for messages of nodes that have recently used
+// excessive CPU.
 func NewMessageQueue(
 	log logging.Logger,
 	vdrs validators.Set,
@@ -68,14 +120,66 @@ func NewMessageQueue(
 	metricsRegisterer prometheus.Registerer,
 	ops []message.Op,
 ) (MessageQueue, error) {
+	m := newMessageQueue(log, vdrs, cpuTracker, []LaneConfig{{
+		Ops:    ops,
+		Weight: 1,
+	}})
+	return m, m.metrics.initialize(metricsNamespace, metricsRegisterer, ops, nil)
+}
+
+// NewPrioritizedMessageQueue returns a queue that queues messages in
+// [lanes]. Within a lane, messages are popped in FIFO order, except for
+// messages of nodes that have recently used excessive CPU.
+func NewPrioritizedMessageQueue(
+	log logging.Logger,
+	vdrs validators.Set,
+	cpuTracker tracker.Tracker,
+	metricsNamespace string,
+	metricsRegisterer prometheus.Registerer,
+	lanes []LaneConfig,
+) (PrioritizedMessageQueue, error) {
+	var (
+		ops       []message.Op
+		laneNames = make([]string, len(lanes))
+	)
+	for i, laneConfig := range lanes {
+		if laneConfig.Weight <= 0 {
+			return nil, fmt.Errorf("lane %q has non-positive weight %d", laneConfig.Name, laneConfig.Weight)
+		}
+		ops = append(ops, laneConfig.Ops...)
+		laneNames[i] = laneConfig.Name
+	}
+	m := newMessageQueue(log, vdrs, cpuTracker, lanes)
+	return m, m.metrics.initialize(metricsNamespace, metricsRegisterer, ops, laneNames)
+}
+
+func newMessageQueue(
+	log logging.Logger,
+	vdrs validators.Set,
+	cpuTracker tracker.Tracker,
+	lanes []LaneConfig,
+) *messageQueue {
 	m := &messageQueue{
 		log:                   log,
 		vdrs:                  vdrs,
 		cpuTracker:            cpuTracker,
 		cond:                  sync.NewCond(&sync.Mutex{}),
 		nodeToUnprocessedMsgs: make(map[ids.NodeID]int),
+		opToLane:              make(map[message.Op]int),
+		lanes:                 make([]*lane, len(lanes)),
+		allLanes:              make([]int, len(lanes)),
 	}
-	return m, m.metrics.initialize(metricsNamespace, metricsRegisterer, ops)
+	for i, laneConfig := range lanes {
+		for _, op := range laneConfig.Ops {
+			m.opToLane[op] = i
+		}
+		m.lanes[i] = &lane{
+			weight:  laneConfig.Weight,
+			credits: laneConfig.Weight,
+		}
+		m.allLanes[i] = i
+	}
+	return m
 }
 
 func (m *messageQueue) Push(msg message.InboundMessage) {
@@ -88,49 +192,114 @@ func (m *messageQueue) Push(msg message.InboundMessage) {
 	}
 
 	// Add the message to the queue
-	m.msgs = append(m.msgs, msg)
+	laneIndex := m.opToLane[msg.Op()]
+	l := m.lanes[laneIndex]
+	l.msgs = append(l.msgs, msg)
 	m.nodeToUnprocessedMsgs[msg.NodeID()]++
 
 	// Update metrics
 	m.metrics.nodesWithMessages.Set(float64(len(m.nodeToUnprocessedMsgs)))
 	m.metrics.len.Inc()
 	m.metrics.ops[msg.Op()].Inc()
+	m.metrics.incLane(laneIndex)
 
-	// Signal a waiting thread
-	m.cond.Signal()
+	// Signal the waiting threads. Waiting threads may be popping from
+	// different lanes, so all of them are woken up.
+	m.cond.Broadcast()
 }
 
-// FIFO, but skip over messages whose senders whose messages have caused us to
-// use excessive CPU recently.
 func (m *messageQueue) Pop() (message.InboundMessage, bool) {
+	return m.pop(m.allLanes)
+}
+
+func (m *messageQueue) Lanes(lanes ...int) MessageQueue {
+	return &laneQueue{
+		messageQueue: m,
+		lanes:        lanes,
+	}
+}
+
+// pop waits until a message can be popped from one of [lanes] and pops it.
+func (m *messageQueue) pop(lanes []int) (message.InboundMessage, bool) {
 	m.cond.L.Lock()
 	defer m.cond.L.Unlock()
 
+	var laneIndex int
 	for {
 		if m.closed {
 			return nil, false
 		}
-		if len(m.msgs) != 0 {
+		var ok bool
+		laneIndex, ok = m.nextLane(lanes)
+		if ok {
 			break
 		}
 		m.cond.Wait()
 	}
 
-	n := len(m.msgs)
+	l := m.lanes[laneIndex]
+	msg := m.popLane(l)
+	l.credits--
+	m.metrics.decLane(laneIndex)
+	if len(lanes) > 1 {
+		// Popping from a lane may allow another of [lanes] to be popped from
+		m.cond.Broadcast()
+	}
+	return msg, true
+}
+
+// nextLane returns the first of [lanes] that has messages and hasn't used
+// up its credits in the current round of [lanes]. If every one of [lanes]
+// with messages has used up its credits, the next round of [lanes] is
+// started. The credits of other lanes are never considered, so that lanes
+// popped by another caller can't block this one.
+//
+// Assumes [m.cond.L] is held.
+func (m *messageQueue) nextLane(lanes []int) (int, bool) {
+	roundOver := true
+	for _, laneIndex := range lanes {
+		l := m.lanes[laneIndex]
+		if len(l.msgs) != 0 && l.credits > 0 {
+			roundOver = false
+			break
+		}
+	}
+	if roundOver {
+		for _, laneIndex := range lanes {
+			l := m.lanes[laneIndex]
+			l.credits = l.weight
+		}
+	}
+
+	for _, laneIndex := range lanes {
+		l := m.lanes[laneIndex]
+		if len(l.msgs) != 0 && l.credits > 0 {
+			return laneIndex, true
+		}
+	}
+	return 0, false
+}
+
+// FIFO, but skip over messages whose senders whose messages have caused us to
+// use excessive CPU recently.
+//
+// Assumes [m.cond.L] is held and [l] has at least one message.
+func (m *messageQueue) popLane(l *lane) message.InboundMessage {
+	n := len(l.msgs)
 	i := 0
 	for {
 		if i == n {
 			m.log.Debug("canPop is false for all %d unprocessed messages", n)
 		}
-		msg := m.msgs[0]
-		m.msgs[0] = nil
+		msg := l.msgs[0]
+		l.msgs[0] = nil
 		nodeID := msg.NodeID()
 		// See if it's OK to process [msg] next
 		if m.canPop(msg) || i == n { // i should never == n but handle anyway as a fail-safe
-			if cap(m.msgs) == 1 {
-				m.msgs = nil // Give back memory if possible
+			if cap(l.msgs) == 1 {
+				l.msgs = nil // Give back memory if possible
 			} else {
-				m.msgs = m.msgs[1:]
+				l.msgs = l.msgs[1:]
 			}
 			m.nodeToUnprocessedMsgs[nodeID]--
 			if m.nodeToUnprocessedMsgs[nodeID] == 0 {
@@ -139,22 +308,30 @@ func (m *messageQueue) Pop() (message.InboundMessage, bool) {
 			m.metrics.nodesWithMessages.Set(float64(len(m.nodeToUnprocessedMsgs)))
 			m.metrics.len.Dec()
 			m.metrics.ops[msg.Op()].Dec()
-			return msg, true
+			return msg
 		}
 		// [msg.nodeID] is causing excessive CPU usage.
-		// Push [msg] to back of [m.msgs] and handle it later.
-		m.msgs = append(m.msgs, msg)
-		m.msgs = m.msgs[1:]
+		// Push [msg] to back of [l.msgs] and handle it later.
+		l.msgs = append(l.msgs, msg)
+		l.msgs = l.msgs[1:]
 		i++
 		m.metrics.numExcessiveCPU.Inc()
 	}
 }
 
 func (m *messageQueue) Len() int {
+	return m.len(m.allLanes)
+}
+
+func (m *messageQueue) len(lanes []int) int {
 	m.cond.L.Lock()
 	defer m.cond.L.Unlock()
 
-	return len(m.msgs)
+	n := 0
+	for _, laneIndex := range lanes {
+		n += len(m.lanes[laneIndex].msgs)
+	}
+	return n
 }
 
 func (m *messageQueue) Shutdown() {
@@ -162,10 +339,13 @@ func (m *messageQueue) Shutdown() {
 	defer m.cond.L.Unlock()
 
 	// Remove all the current messages from the queue
-	for _, msg := range m.msgs {
-		msg.OnFinishedHandling()
+	for laneIndex, l := range m.lanes {
+		for _, msg := range l.msgs {
+			msg.OnFinishedHandling()
+		}
+		l.msgs = nil
+		m.metrics.resetLane(laneIndex)
 	}
-	m.msgs = nil
 	m.nodeToUnprocessedMsgs = nil
 
 	// Update metrics
@@ -177,6 +357,16 @@ func (m *messageQueue) Shutdown() {
 	m.cond.Broadcast()
 }
 
+// laneQueue only pops the messages of [lanes] of the underlying queue
+type laneQueue struct {
+	*messageQueue
+	lanes []int
+}
+
+func (q *laneQueue) Pop() (message.InboundMessage, bool) { return q.pop(q.lanes) }
+
+func (q *laneQueue) Len() int { return q.len(q.lanes) }
+
 // canPop will return true for at least one message in [m.msgs]
 func (m *messageQueue) canPop(msg message.InboundMessage) bool {
 	// Always pop connected and disconnected messages.
@@ -17,12 +17,16 @@ type messageQueueMetrics struct {
 	len               prometheus.Gauge
 	nodesWithMessages prometheus.Gauge
 	numExcessiveCPU   prometheus.Counter
+	// Lane index --> number of messages in the lane. nil if the queue's lanes
+	// aren't reported individually.
+	lanes []prometheus.Gauge
 }
 
 func (m *messageQueueMetrics) initialize(
 	metricsNamespace string,
 	metricsRegisterer prometheus.Registerer,
 	ops []message.Op,
+	laneNames []string,
 ) error {
 	namespace := fmt.Sprintf("%s_%s", metricsNamespace, "unprocessed_msgs")
 	m.len = prometheus.NewGauge(prometheus.GaugeOpts{
@@ -55,6 +59,17 @@ func (m *messageQueueMetrics) initialize(
 		errs.Add(metricsRegisterer.Register(opMetric))
 	}
 
+	m.lanes = make([]prometheus.Gauge, len(laneNames))
+	for i, laneName := range laneNames {
+		laneMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      fmt.Sprintf("%s_lane_len", laneName),
+			Help:      fmt.Sprintf("Number of messages in the %s lane of the message queue.", laneName),
+		})
+		m.lanes[i] = laneMetric
+		errs.Add(metricsRegisterer.Register(laneMetric))
+	}
+
 	errs.Add(
 		metricsRegisterer.Register(m.len),
 		metricsRegisterer.Register(m.nodesWithMessages),
@@ -62,3 +77,21 @@ func (m *messageQueueMetrics) initialize(
 	)
 	return errs.Err
 }
+
+func (m *messageQueueMetrics) incLane(lane int) {
+	if lane < len(m.lanes) {
+		m.lanes[lane].Inc()
+	}
+}
+
+func (m *messageQueueMetrics) decLane(lane int) {
+	if lane < len(m.lanes) {
+		m.lanes[lane].Dec()
+	}
+}
+
+func (m *messageQueueMetrics) resetLane(lane int) {
+	if lane < len(m.lanes) {
+		m.lanes[lane].Set(0)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	"github.com/stretchr/testify/assert"
 
@@ -144,3 +145,177 @@ func TestQueue(t *testing.T) {
 	assert.EqualValues(msg3, gotMsg3)
 	assert.EqualValues(0, u.Len())
 }
+
+func TestPrioritizedQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assert := assert.New(t)
+	cpuTracker := tracker.NewMockTracker(ctrl)
+	cpuTracker.EXPECT().Usage(gomock.Any(), gomock.Any()).Return(0.0).AnyTimes()
+	vdrs := validators.NewSet()
+	vdrID := ids.GenerateTestNodeID()
+	assert.NoError(vdrs.AddWeight(vdrID, 1))
+
+	_, err := NewPrioritizedMessageQueue(logging.NoLog{}, vdrs, cpuTracker, "", prometheus.NewRegistry(), []LaneConfig{
+		{Name: "consensus", Ops: []message.Op{message.PushQuery}, Weight: 0},
+	})
+	assert.Error(err)
+
+	qIntf, err := NewPrioritizedMessageQueue(logging.NoLog{}, vdrs, cpuTracker, "", prometheus.NewRegistry(), []LaneConfig{
+		{Name: "consensus", Ops: []message.Op{message.PushQuery}, Weight: 2},
+		{Name: "app", Ops: []message.Op{message.AppRequest}, Weight: 1},
+	})
+	assert.NoError(err)
+	q := qIntf.(*messageQueue)
+
+	mc, err := message.NewCreator(prometheus.NewRegistry(), true, "dummyNamespace", 10*time.Second)
+	assert.NoError(err)
+	appMsgs := make([]message.InboundMessage, 3)
+	consensusMsgs := make([]message.InboundMessage, 3)
+	for i := range appMsgs {
+		appMsgs[i] = mc.InboundAppRequest(ids.Empty, uint32(i), time.Minute, nil, vdrID)
+		q.Push(appMsgs[i])
+	}
+	for i := range consensusMsgs {
+		consensusMsgs[i] = mc.InboundPushQuery(ids.Empty, uint32(i), time.Minute, ids.Empty, nil, vdrID)
+		q.Push(consensusMsgs[i])
+	}
+	assert.Equal(6, q.Len())
+	assert.Equal(3.0, testutil.ToFloat64(q.metrics.lanes[0]))
+	assert.Equal(3.0, testutil.ToFloat64(q.metrics.lanes[1]))
+
+	// In each round, 2 consensus messages are popped for each app message
+	expected := []message.InboundMessage{
+		consensusMsgs[0],
+		consensusMsgs[1],
+		appMsgs[0],
+		consensusMsgs[2],
+		appMsgs[1],
+		appMsgs[2],
+	}
+	for _, expectedMsg := range expected {
+		msg, ok := q.Pop()
+		assert.True(ok)
+		assert.Equal(expectedMsg, msg)
+	}
+	assert.Zero(q.Len())
+	assert.Zero(testutil.ToFloat64(q.metrics.lanes[0]))
+	assert.Zero(testutil.ToFloat64(q.metrics.lanes[1]))
+}
+
+func TestPrioritizedQueueLanes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assert := assert.New(t)
+	cpuTracker := tracker.NewMockTracker(ctrl)
+	cpuTracker.EXPECT().Usage(gomock.Any(), gomock.Any()).Return(0.0).AnyTimes()
+	vdrs := validators.NewSet()
+	vdrID := ids.GenerateTestNodeID()
+	assert.NoError(vdrs.AddWeight(vdrID, 1))
+
+	q, err := NewPrioritizedMessageQueue(logging.NoLog{}, vdrs, cpuTracker, "", prometheus.NewRegistry(), []LaneConfig{
+		{Name: "consensus", Ops: []message.Op{message.PushQuery}, Weight: 1},
+		{Name: "app", Ops: []message.Op{message.AppRequest}, Weight: 1},
+	})
+	assert.NoError(err)
+	consensusQueue := q.Lanes(0)
+	appQueue := q.Lanes(1)
+
+	mc, err := message.NewCreator(prometheus.NewRegistry(), true, "dummyNamespace", 10*time.Second)
+	assert.NoError(err)
+	appMsg0 := mc.InboundAppRequest(ids.Empty, 0, time.Minute, nil, vdrID)
+	appMsg1 := mc.InboundAppRequest(ids.Empty, 1, time.Minute, nil, vdrID)
+	consensusMsg0 := mc.InboundPushQuery(ids.Empty, 0, time.Minute, ids.Empty, nil, vdrID)
+	consensusMsg1 := mc.InboundPushQuery(ids.Empty, 1, time.Minute, ids.Empty, nil, vdrID)
+	appQueue.Push(appMsg0)
+	appQueue.Push(appMsg1)
+	consensusQueue.Push(consensusMsg0)
+	consensusQueue.Push(consensusMsg1)
+	assert.Equal(2, appQueue.Len())
+	assert.Equal(2, consensusQueue.Len())
+	assert.Equal(4, q.Len())
+
+	// Each of the queues has its own rounds, so the app queue doesn't wait for
+	// the consensus lane to use up its weight
+	msg, ok := appQueue.Pop()
+	assert.True(ok)
+	assert.Equal(appMsg0, msg)
+	msg, ok = appQueue.Pop()
+	assert.True(ok)
+	assert.Equal(appMsg1, msg)
+	assert.Zero(appQueue.Len())
+
+	msg, ok = consensusQueue.Pop()
+	assert.True(ok)
+	assert.Equal(consensusMsg0, msg)
+	assert.Equal(1, consensusQueue.Len())
+
+	popped := make(chan message.InboundMessage)
+
+	// Shutting down the queue unblocks waiting pops
+	go func() {
+		_, ok := appQueue.Pop()
+		assert.False(ok)
+		close(popped)
+	}()
+	q.Shutdown()
+	<-popped
+}
+
+func TestPrioritizedQueueBlockedAppLane(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assert := assert.New(t)
+	cpuTracker := tracker.NewMockTracker(ctrl)
+	cpuTracker.EXPECT().Usage(gomock.Any(), gomock.Any()).Return(0.0).AnyTimes()
+	vdrs := validators.NewSet()
+	vdrID := ids.GenerateTestNodeID()
+	assert.NoError(vdrs.AddWeight(vdrID, 1))
+
+	q, err := NewPrioritizedMessageQueue(logging.NoLog{}, vdrs, cpuTracker, "", prometheus.NewRegistry(), []LaneConfig{
+		{Name: "consensus", Ops: []message.Op{message.PushQuery}, Weight: 1},
+		{Name: "bootstrapping", Ops: []message.Op{message.GetAccepted}, Weight: 1},
+		{Name: "app", Ops: []message.Op{message.AppRequest}, Weight: 2},
+	})
+	assert.NoError(err)
+	syncQueue := q.Lanes(0, 1)
+	appQueue := q.Lanes(2)
+
+	mc, err := message.NewCreator(prometheus.NewRegistry(), true, "dummyNamespace", 10*time.Second)
+	assert.NoError(err)
+	for i := 0; i < 3; i++ {
+		appQueue.Push(mc.InboundAppRequest(ids.Empty, uint32(i), time.Minute, nil, vdrID))
+	}
+
+	// The app dispatcher pops an app message and then blocks handling it, so
+	// the other app messages stay queued while the app lane has credits left
+	_, ok := appQueue.Pop()
+	assert.True(ok)
+
+	numConsensusMsgs := 10
+	for i := 0; i < numConsensusMsgs; i++ {
+		syncQueue.Push(mc.InboundPushQuery(ids.Empty, uint32(i), time.Minute, ids.Empty, nil, vdrID))
+	}
+
+	// The consensus messages still drain
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		for i := 0; i < numConsensusMsgs; i++ {
+			msg, ok := syncQueue.Pop()
+			assert.True(ok)
+			assert.Equal(message.PushQuery, msg.Op())
+		}
+	}()
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		t.Fatal("consensus messages were blocked by queued app messages")
+	}
+	assert.Zero(syncQueue.Len())
+	assert.Equal(2, appQueue.Len())
+	q.Shutdown()
+}
@@ -71,6 +71,7 @@ func TestShutdown(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
@@ -175,6 +176,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
@@ -287,6 +289,7 @@ func TestRouterTimeout(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
@@ -404,6 +407,7 @@ func TestRouterClearTimeouts(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
@@ -520,6 +524,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
@@ -87,6 +87,7 @@ func TestTimeout(t *testing.T) {
 		nil,
 		time.Hour,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
@@ -176,6 +177,7 @@ func TestReliableMessages(t *testing.T) {
 		nil,
 		1,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
@@ -272,6 +274,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		nil,
 		time.Second,
 		resourceTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
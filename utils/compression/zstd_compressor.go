@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var _ Compressor = &zstdCompressor{}
+
+// zstdCompressor is safe for concurrent use. Unlike gzip, concurrent calls to
+// Compress aren't serialized. Concurrent calls to Decompress are, as they
+// stream through the same decoder.
+type zstdCompressor struct {
+	maxSize int64
+
+	encoder *zstd.Encoder
+
+	lock        sync.Mutex
+	bytesReader *bytes.Reader
+	decoder     *zstd.Decoder
+}
+
+// NewZstdCompressor returns a new zstd Compressor that compresses messages of
+// at most [maxSize] bytes
+func NewZstdCompressor(maxSize int64) (Compressor, error) {
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(uint64(maxSize)))
+	if err != nil {
+		return nil, err
+	}
+	return &zstdCompressor{
+		maxSize:     maxSize,
+		encoder:     encoder,
+		bytesReader: &bytes.Reader{},
+		decoder:     decoder,
+	}, nil
+}
+
+// Compress [msg] and returns the compressed bytes.
+func (z *zstdCompressor) Compress(msg []byte) ([]byte, error) {
+	if int64(len(msg)) > z.maxSize {
+		return nil, fmt.Errorf("msg length (%d) > maximum msg length (%d)", len(msg), z.maxSize)
+	}
+	return z.encoder.EncodeAll(msg, nil), nil
+}
+
+// Decompress decompresses [msg].
+func (z *zstdCompressor) Decompress(msg []byte) ([]byte, error) {
+	z.lock.Lock()
+	defer z.lock.Unlock()
+
+	// [msg] is decoded as a stream, rather than with DecodeAll, so that no
+	// more than [z.maxSize] bytes are decompressed, even if [msg] has frames
+	// that don't declare their size.
+	z.bytesReader.Reset(msg)
+	if err := z.decoder.Reset(z.bytesReader); err != nil {
+		return nil, err
+	}
+	defer func() {
+		// Release the reference to [msg]
+		_ = z.decoder.Reset(nil)
+	}()
+
+	// We allow [io.LimitReader] to read up to [z.maxSize + 1] bytes, so that if
+	// the decompressed payload is greater than the maximum size, this function
+	// will return the appropriate error instead of an incomplete byte slice.
+	limitedReader := io.LimitReader(z.decoder, z.maxSize+1)
+
+	decompressed, err := io.ReadAll(limitedReader)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(decompressed)) > z.maxSize {
+		return nil, fmt.Errorf("msg length > maximum msg length (%d)", z.maxSize)
+	}
+	return decompressed, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"bytes"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZstdCompressDecompress(t *testing.T) {
+	data := make([]byte, 4096)
+	for i := 0; i < len(data); i++ {
+		data[i] = byte(rand.Intn(256)) // #nosec G404
+	}
+
+	data2 := make([]byte, 4096)
+	for i := 0; i < len(data); i++ {
+		data2[i] = byte(rand.Intn(256)) // #nosec G404
+	}
+
+	compressor, err := NewZstdCompressor(2 * units.MiB)
+	assert.NoError(t, err)
+
+	dataCompressed, err := compressor.Compress(data)
+	assert.NoError(t, err)
+
+	data2Compressed, err := compressor.Compress(data2)
+	assert.NoError(t, err)
+
+	dataDecompressed, err := compressor.Decompress(dataCompressed)
+	assert.NoError(t, err)
+	assert.EqualValues(t, data, dataDecompressed)
+
+	data2Decompressed, err := compressor.Decompress(data2Compressed)
+	assert.NoError(t, err)
+	assert.EqualValues(t, data2, data2Decompressed)
+
+	nonZstdData := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	_, err = compressor.Decompress(nonZstdData)
+	assert.Error(t, err)
+}
+
+func TestZstdSizeLimiting(t *testing.T) {
+	data := make([]byte, 3*units.MiB)
+	compressor, err := NewZstdCompressor(2 * units.MiB)
+	assert.NoError(t, err)
+	_, err = compressor.Compress(data) // should be too large
+	assert.Error(t, err)
+
+	compressor2, err := NewZstdCompressor(4 * units.MiB)
+	assert.NoError(t, err)
+	dataCompressed, err := compressor2.Compress(data)
+	assert.NoError(t, err)
+
+	_, err = compressor.Decompress(dataCompressed) // should be too large
+	assert.Error(t, err)
+
+	// Multiple frames that are each within the limit
+	frame, err := compressor.Compress(make([]byte, 2*units.MiB))
+	assert.NoError(t, err)
+	_, err = compressor.Decompress(append(frame, frame...)) // should be too large
+	assert.Error(t, err)
+}
+
+func TestZstdDecompressionBomb(t *testing.T) {
+	assert := assert.New(t)
+
+	// Streamed frames don't declare their content size, so the decoder can't
+	// reject them before decompressing them
+	bombSize := 256 * units.MiB
+	buf := &bytes.Buffer{}
+	encoder, err := zstd.NewWriter(buf, zstd.WithWindowSize(units.MiB))
+	assert.NoError(err)
+	_, err = encoder.Write(make([]byte, bombSize))
+	assert.NoError(err)
+	assert.NoError(encoder.Close())
+	bomb := buf.Bytes()
+	assert.Less(len(bomb), 64*units.KiB)
+
+	compressor, err := NewZstdCompressor(2 * units.MiB)
+	assert.NoError(err)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err = compressor.Decompress(bomb)
+	runtime.ReadMemStats(&after)
+	assert.Error(err)
+	assert.Less(after.TotalAlloc-before.TotalAlloc, uint64(bombSize/4))
+}
+
+func TestTypeFromString(t *testing.T) {
+	for _, typ := range []Type{TypeNone, TypeGzip, TypeZstd} {
+		parsedType, err := TypeFromString(typ.String())
+		assert.NoError(t, err)
+		assert.Equal(t, typ, parsedType)
+	}
+
+	_, err := TypeFromString("lz4")
+	assert.ErrorIs(t, err, errUnknownCompressionType)
+}
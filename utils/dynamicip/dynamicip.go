@@ -20,8 +20,14 @@ import (
 var (
 	errNoResolver  = errors.New("invalid resolver")
 	errOpenDNSNoIP = errors.New("opendns returned no ip")
+	errNoIPv6      = errors.New("no ipv6 address resolved")
 )
 
+// Dialer connects to an address on the named network
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
 // Resolver resolves our public IP
 type Resolver interface {
 	// Resolve our public IP
@@ -44,18 +50,38 @@ func (r *NoResolver) Resolve() (net.IP, error) {
 // IFConfigResolves resolves our public IP using openDNS
 type OpenDNSResolver struct {
 	*net.Resolver
+	// If true, our public IPv6 address is resolved
+	ipv6 bool
 }
 
 func NewOpenDNSResolver() *OpenDNSResolver {
-	return &OpenDNSResolver{&net.Resolver{
-		PreferGo: true,
-		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
-			d := net.Dialer{
-				Timeout: 10 * time.Second,
-			}
-			return d.DialContext(ctx, "udp", "resolver1.opendns.com:53")
+	return newOpenDNSResolver(nil, false)
+}
+
+// newOpenDNSResolver returns a resolver that queries openDNS through
+// [dialer]. If [dialer] is nil, openDNS is queried directly over UDP.
+// Otherwise, openDNS is queried over TCP, as proxies may not relay UDP.
+func newOpenDNSResolver(dialer Dialer, ipv6 bool) *OpenDNSResolver {
+	network := "udp"
+	if dialer == nil {
+		dialer = &net.Dialer{
+			Timeout: 10 * time.Second,
+		}
+	} else {
+		network = "tcp"
+	}
+	if ipv6 {
+		network += "6"
+	}
+	return &OpenDNSResolver{
+		Resolver: &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, _, address string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, "resolver1.opendns.com:53")
+			},
 		},
-	}}
+		ipv6: ipv6,
+	}
 }
 
 func (r *OpenDNSResolver) IsResolver() bool {
@@ -72,10 +98,13 @@ func (r *OpenDNSResolver) Resolve() (net.IP, error) {
 	}
 	for _, ipv := range ip {
 		ipResolved := net.ParseIP(ipv)
-		if ipResolved != nil && strings.Contains(ipv, ".") {
+		if ipResolved != nil && strings.Contains(ipv, ".") != r.ipv6 {
 			return ipResolved, nil
 		}
 	}
+	if r.ipv6 {
+		return nil, fmt.Errorf("%w: %v", errNoIPv6, ip)
+	}
 	ipResolved := net.ParseIP(ip[0])
 	if ipResolved == nil {
 		return nil, fmt.Errorf("invalid ip %s", ip[0])
@@ -86,6 +115,8 @@ func (r *OpenDNSResolver) Resolve() (net.IP, error) {
 // IFConfigResolves resolves our public IP using ifconfig's format
 type IFConfigResolver struct {
 	url string
+	// If non-nil, used to make the request rather than http.DefaultClient
+	client *http.Client
 }
 
 func (r *IFConfigResolver) IsResolver() bool {
@@ -93,7 +124,11 @@ func (r *IFConfigResolver) IsResolver() bool {
 }
 
 func (r *IFConfigResolver) Resolve() (net.IP, error) {
-	resp, err := http.Get(r.url)
+	client := r.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(r.url)
 	if err != nil {
 		return nil, err
 	}
@@ -116,16 +151,57 @@ func (r *IFConfigResolver) Resolve() (net.IP, error) {
 	return ipResolved, resp.Body.Close()
 }
 
-func NewResolver(opt string) Resolver {
+// NewResolver returns a resolver of our public IP. If [dialer] is non-nil,
+// the resolver connects to the external source through [dialer].
+func NewResolver(opt string, dialer Dialer) Resolver {
+	var client *http.Client
+	if dialer != nil {
+		client = &http.Client{
+			Transport: &http.Transport{
+				DialContext: dialer.DialContext,
+			},
+		}
+	}
 	switch opt {
 	case "opendns":
-		return NewOpenDNSResolver()
+		return newOpenDNSResolver(dialer, false)
 	case "ifconfig":
-		return &IFConfigResolver{url: "http://ifconfig.co"}
+		return &IFConfigResolver{url: "http://ifconfig.co", client: client}
 	case "ifconfigco":
-		return &IFConfigResolver{url: "http://ifconfig.co"}
+		return &IFConfigResolver{url: "http://ifconfig.co", client: client}
+	case "ifconfigme":
+		return &IFConfigResolver{url: "http://ifconfig.me", client: client}
+	default:
+		return &NoResolver{}
+	}
+}
+
+// NewIPv6Resolver returns a resolver of our public IPv6 address. [opt] is one
+// of the options of NewResolver. The request is made over IPv6, so the
+// resolved IP is the IPv6 address that our traffic originates from. If
+// [dialer] is non-nil, the resolver connects to the external source through
+// [dialer].
+func NewIPv6Resolver(opt string, dialer Dialer) Resolver {
+	ipv6Dialer := dialer
+	if ipv6Dialer == nil {
+		ipv6Dialer = &net.Dialer{
+			Timeout: 10 * time.Second,
+		}
+	}
+	ipv6Client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, address string) (net.Conn, error) {
+				return ipv6Dialer.DialContext(ctx, "tcp6", address)
+			},
+		},
+	}
+	switch opt {
+	case "opendns":
+		return newOpenDNSResolver(dialer, true)
+	case "ifconfig", "ifconfigco":
+		return &IFConfigResolver{url: "http://ifconfig.co", client: ipv6Client}
 	case "ifconfigme":
-		return &IFConfigResolver{url: "http://ifconfig.me"}
+		return &IFConfigResolver{url: "http://ifconfig.me", client: ipv6Client}
 	default:
 		return &NoResolver{}
 	}
@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package filesystem
+
+import (
+	"fmt"
+	"os"
+)
+
+// Rotate renames the file at [path] to [path].1, renames the previously
+// rotated files to [path].2 and so on, and only keeps the [maxFiles] most
+// recent rotated files. If [maxFiles] <= 0, the file at [path] is removed.
+// The file at [path] must exist and must not be open for writing.
+func Rotate(path string, maxFiles int) error {
+	if maxFiles <= 0 {
+		return os.Remove(path)
+	}
+
+	err := os.Remove(rotatedPath(path, maxFiles))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := maxFiles - 1; i > 0; i-- {
+		if _, err := RenameIfExists(rotatedPath(path, i), rotatedPath(path, i+1)); err != nil {
+			return err
+		}
+	}
+	return os.Rename(path, rotatedPath(path, 1))
+}
+
+func rotatedPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
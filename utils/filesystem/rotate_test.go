@@ -0,0 +1,40 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotate(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "file")
+	for i := 0; i < 3; i++ {
+		assert.NoError(os.WriteFile(path, []byte{byte(i)}, 0o600))
+		assert.NoError(Rotate(path, 2))
+	}
+
+	_, err := os.Stat(path)
+	assert.True(os.IsNotExist(err))
+	contents, err := os.ReadFile(path + ".1")
+	assert.NoError(err)
+	assert.Equal([]byte{2}, contents)
+	contents, err = os.ReadFile(path + ".2")
+	assert.NoError(err)
+	assert.Equal([]byte{1}, contents)
+	_, err = os.Stat(path + ".3")
+	assert.True(os.IsNotExist(err))
+
+	// Without rotated files, the file is removed
+	assert.NoError(os.WriteFile(path, nil, 0o600))
+	assert.NoError(Rotate(path, 0))
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+}
@@ -35,6 +35,12 @@ func (ipPort IPPort) IsZero() bool {
 		ip.Equal(net.IPv6zero)
 }
 
+// IsIPv4 returns if the IP is an IPv4 address. IPv4 addresses in their IPv6
+// representation are IPv4 addresses.
+func (ipPort IPPort) IsIPv4() bool {
+	return ipPort.IP.To4() != nil
+}
+
 func ToIPPort(str string) (IPPort, error) {
 	host, portStr, err := net.SplitHostPort(str)
 	if err != nil {
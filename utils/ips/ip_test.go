@@ -86,6 +86,27 @@ func TestIPPortString(t *testing.T) {
 	}
 }
 
+func TestIPPortIsIPv4(t *testing.T) {
+	tests := []struct {
+		ipPort IPPort
+		result bool
+	}{
+		{IPPort{net.IPv4(127, 0, 0, 1), 0}, true},
+		{IPPort{net.ParseIP("127.0.0.1"), 0}, true},
+		{IPPort{net.ParseIP("::ffff:127.0.0.1"), 0}, true},
+		{IPPort{net.ParseIP("::1"), 0}, false},
+		{IPPort{net.ParseIP("2001:db8::1"), 0}, false},
+		{IPPort{nil, 0}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ipPort.String(), func(t *testing.T) {
+			if result := tt.ipPort.IsIPv4(); result != tt.result {
+				t.Errorf("Expected %t, got %t", tt.result, result)
+			}
+		})
+	}
+}
+
 func TestToIPPortError(t *testing.T) {
 	tests := []struct {
 		in  string
@@ -436,6 +436,29 @@ func TryUnpackStr(packer *Packer) interface{} {
 	return packer.UnpackStr()
 }
 
+// TryPackStrs attempts to pack the value as a list of strings
+func TryPackStrs(packer *Packer, valIntf interface{}) {
+	strs, ok := valIntf.([]string)
+	if !ok {
+		packer.Add(errBadType)
+		return
+	}
+	packer.PackInt(uint32(len(strs)))
+	for _, str := range strs {
+		packer.PackStr(str)
+	}
+}
+
+// TryUnpackStrs attempts to unpack the value as a list of strings
+func TryUnpackStrs(packer *Packer) interface{} {
+	sliceSize := packer.UnpackInt()
+	strs := []string(nil)
+	for i := uint32(0); i < sliceSize && !packer.Errored(); i++ {
+		strs = append(strs, packer.UnpackStr())
+	}
+	return strs
+}
+
 // TryPackIP attempts to pack the value as an ip port pair
 func TryPackIP(packer *Packer, valIntf interface{}) {
 	if val, ok := valIntf.(ips.IPPort); ok {
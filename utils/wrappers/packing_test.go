@@ -649,3 +649,20 @@ func TestPackClaimedIPPortList(t *testing.T) {
 	assert.Equal(t, ip.Signature, resolvedUnpackedIPCertList[0].Signature)
 	assert.Equal(t, ip.Timestamp, resolvedUnpackedIPCertList[0].Timestamp)
 }
+
+func TestPackStrs(t *testing.T) {
+	strs := []string{"proto", "", "zstd"}
+
+	p := Packer{MaxSize: 10000}
+	TryPackStrs(&p, strs)
+	assert.NoError(t, p.Err)
+
+	p.Offset = 0
+	unpackedStrs := TryUnpackStrs(&p)
+	assert.NoError(t, p.Err)
+	assert.Equal(t, strs, unpackedStrs)
+	assert.Equal(t, len(p.Bytes), p.Offset)
+
+	TryPackStrs(&p, "proto")
+	assert.ErrorIs(t, p.Err, errBadType)
+}
@@ -35,6 +35,16 @@ type Client interface {
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// IssueStopVertex issues a stop vertex.
 	IssueStopVertex(ctx context.Context, options ...rpc.Option) error
+	// SimulateTx verifies [tx] against the current state without issuing it
+	SimulateTx(ctx context.Context, tx []byte, options ...rpc.Option) (*SimulateTxReply, error)
+	// GetPendingTxs returns the txs that haven't been sent to consensus yet
+	GetPendingTxs(ctx context.Context, options ...rpc.Option) ([]PendingTx, error)
+	// GetDroppedTxReason returns the reason [txID] was dropped, if it was
+	// recently dropped
+	GetDroppedTxReason(ctx context.Context, txID ids.ID, options ...rpc.Option) (bool, string, error)
+	// EvictPendingTx removes [txID] from the txs that haven't been sent to
+	// consensus yet
+	EvictPendingTx(ctx context.Context, txID ids.ID, reason string, options ...rpc.Option) (bool, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
 	GetUTXOs(
 		ctx context.Context,
@@ -198,6 +208,42 @@ func (c *client) IssueStopVertex(ctx context.Context, options ...rpc.Option) err
 	return c.requester.SendRequest(ctx, "issueStopVertex", &struct{}{}, &struct{}{}, options...)
 }
 
+func (c *client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.EncodeWithChecksum(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	res := &SimulateTxReply{}
+	err = c.requester.SendRequest(ctx, "simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetPendingTxs(ctx context.Context, options ...rpc.Option) ([]PendingTx, error) {
+	res := &GetPendingTxsReply{}
+	err := c.requester.SendRequest(ctx, "getPendingTxs", struct{}{}, res, options...)
+	return res.Txs, err
+}
+
+func (c *client) GetDroppedTxReason(ctx context.Context, txID ids.ID, options ...rpc.Option) (bool, string, error) {
+	res := &GetDroppedTxReasonReply{}
+	err := c.requester.SendRequest(ctx, "getDroppedTxReason", &api.JSONTxID{
+		TxID: txID,
+	}, res, options...)
+	return res.Dropped, res.Reason, err
+}
+
+func (c *client) EvictPendingTx(ctx context.Context, txID ids.ID, reason string, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "evictPendingTx", &EvictPendingTxArgs{
+		TxID:   txID,
+		Reason: reason,
+	}, res, options...)
+	return res.Success, err
+}
+
 func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error) {
 	res := &GetTxStatusReply{}
 	err := c.requester.SendRequest(ctx, "getTxStatus", &api.JSONTxID{
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
@@ -47,6 +48,8 @@ var (
 	errNoAddresses            = errors.New("no addresses provided")
 	errNoKeys                 = errors.New("from addresses have no keys or funds")
 	errMissingPrivateKey      = errors.New("argument 'privateKey' not given")
+	errTxNotPending           = errors.New("tx isn't pending")
+	errCorruptedReason        = errors.New("dropped tx reason corrupted")
 )
 
 // Service defines the base service for the asset vm
@@ -74,13 +77,275 @@ func (service *Service) IssueTx(r *http.Request, args *api.FormattedTx, reply *a
 	return nil
 }
 
+// SimulateTxReply defines the SimulateTx replies returned from the API
+type SimulateTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// True iff the tx passed verification against the current state
+	Valid bool `json:"valid"`
+	// The reason the tx failed verification, if it did
+	Error string `json:"error,omitempty"`
+	// UTXOs consumed by the tx, including imported UTXOs. UTXOs that don't
+	// exist are omitted.
+	ConsumedUTXOs []string `json:"consumedUTXOs"`
+	// UTXOs produced on this chain once the tx is accepted
+	ProducedUTXOs []string `json:"producedUTXOs"`
+	// Outputs exported by the tx to another chain
+	ExportedOutputs []string `json:"exportedOutputs"`
+	// Amount of each asset consumed by the tx but not produced
+	Burned map[ids.ID]json.Uint64 `json:"burned"`
+	// Encoding of the UTXOs and outputs
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// SimulateTx verifies a transaction against the current state without issuing
+// it into consensus
+func (service *Service) SimulateTx(r *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	service.vm.ctx.Log.Debug("AVM: SimulateTx called with %s", args.Tx)
+
+	if !service.vm.bootstrapped {
+		return errBootstrapping
+	}
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	// Unlike IssueTx, the tx isn't persisted as processing
+	tx, err := service.vm.parsePrivateTx(txBytes)
+	if err != nil {
+		return fmt.Errorf("problem parsing transaction: %w", err)
+	}
+
+	reply.TxID = tx.ID()
+	reply.Encoding = args.Encoding
+
+	var (
+		ins             []*avax.TransferableInput
+		importedIns     []*avax.TransferableInput
+		sourceChain     ids.ID
+		outs            []*avax.TransferableOutput
+		exportedOuts    []*avax.TransferableOutput
+		consumedUTXOs   []*avax.UTXO
+		consumedAmounts = make(map[ids.ID]uint64)
+		producedAmounts = make(map[ids.ID]uint64)
+	)
+	switch utx := tx.UnsignedTx.(type) {
+	case *BaseTx:
+		ins, outs = utx.Ins, utx.Outs
+	case *CreateAssetTx:
+		ins, outs = utx.Ins, utx.Outs
+	case *OperationTx:
+		ins, outs = utx.Ins, utx.Outs
+	case *ImportTx:
+		ins, importedIns, sourceChain, outs = utx.Ins, utx.ImportedIns, utx.SourceChain, utx.Outs
+	case *ExportTx:
+		ins, outs, exportedOuts = utx.Ins, utx.Outs, utx.ExportedOuts
+	}
+
+	for _, utxoID := range tx.InputUTXOs() {
+		if utxoID.Symbol {
+			continue
+		}
+		utxo, err := service.vm.getUTXO(utxoID)
+		if err == nil {
+			consumedUTXOs = append(consumedUTXOs, utxo)
+		}
+	}
+	if len(importedIns) > 0 {
+		utxoIDs := make([][]byte, len(importedIns))
+		for i, in := range importedIns {
+			inputID := in.InputID()
+			utxoIDs[i] = inputID[:]
+		}
+		// If the imported UTXOs can't be fetched, the verification error will
+		// report it.
+		if allUTXOBytes, err := service.vm.ctx.SharedMemory.Get(sourceChain, utxoIDs); err == nil {
+			for _, utxoBytes := range allUTXOBytes {
+				utxo := &avax.UTXO{}
+				if _, err := service.vm.codec.Unmarshal(utxoBytes, utxo); err != nil {
+					return fmt.Errorf("problem parsing imported UTXO: %w", err)
+				}
+				consumedUTXOs = append(consumedUTXOs, utxo)
+			}
+		}
+	}
+
+	for _, insList := range [][]*avax.TransferableInput{ins, importedIns} {
+		for _, in := range insList {
+			assetID := in.AssetID()
+			newAmount, err := safemath.Add64(consumedAmounts[assetID], in.Input().Amount())
+			if err != nil {
+				return errSpendOverflow
+			}
+			consumedAmounts[assetID] = newAmount
+		}
+	}
+	for _, outsList := range [][]*avax.TransferableOutput{outs, exportedOuts} {
+		for _, out := range outsList {
+			assetID := out.AssetID()
+			newAmount, err := safemath.Add64(producedAmounts[assetID], out.Output().Amount())
+			if err != nil {
+				return errSpendOverflow
+			}
+			producedAmounts[assetID] = newAmount
+		}
+	}
+	reply.Burned = make(map[ids.ID]json.Uint64, len(consumedAmounts))
+	for assetID, consumed := range consumedAmounts {
+		// If more is produced than consumed, the tx is invalid and there is
+		// nothing burned.
+		if produced := producedAmounts[assetID]; consumed > produced {
+			reply.Burned[assetID] = json.Uint64(consumed - produced)
+		}
+	}
+
+	reply.ConsumedUTXOs = make([]string, len(consumedUTXOs))
+	for i, utxo := range consumedUTXOs {
+		if reply.ConsumedUTXOs[i], err = service.encode(args.Encoding, utxo); err != nil {
+			return err
+		}
+	}
+	producedUTXOs := tx.UTXOs()
+	reply.ProducedUTXOs = make([]string, len(producedUTXOs))
+	for i, utxo := range producedUTXOs {
+		if reply.ProducedUTXOs[i], err = service.encode(args.Encoding, utxo); err != nil {
+			return err
+		}
+	}
+	reply.ExportedOutputs = make([]string, len(exportedOuts))
+	for i, out := range exportedOuts {
+		if reply.ExportedOutputs[i], err = service.encode(args.Encoding, out); err != nil {
+			return err
+		}
+	}
+
+	err = tx.SyntacticVerify(
+		service.vm.ctx,
+		service.vm.codec,
+		service.vm.feeAssetID,
+		service.vm.TxFee,
+		service.vm.CreateAssetTxFee,
+		len(service.vm.fxs),
+	)
+	if err == nil {
+		err = tx.SemanticVerify(service.vm, tx.UnsignedTx)
+	}
+	if err != nil {
+		reply.Error = err.Error()
+		return nil
+	}
+	reply.Valid = true
+	return nil
+}
+
+// encode marshals [value] and encodes it with [encoding]
+func (service *Service) encode(encoding formatting.Encoding, value interface{}) (string, error) {
+	bytes, err := service.vm.codec.Marshal(codecVersion, value)
+	if err != nil {
+		return "", fmt.Errorf("problem marshalling %T: %w", value, err)
+	}
+	str, err := formatting.EncodeWithChecksum(encoding, bytes)
+	if err != nil {
+		return "", fmt.Errorf("problem encoding %T: %w", value, err)
+	}
+	return str, nil
+}
+
 func (service *Service) IssueStopVertex(_ *http.Request, _ *struct{}, _ *struct{}) error {
 	return service.vm.issueStopVertex()
 }
 
+// PendingTx is a tx that was issued to this node but hasn't been sent to
+// consensus yet
+type PendingTx struct {
+	TxID ids.ID `json:"txID"`
+	// Size of the tx, in bytes
+	Size json.Uint64 `json:"size"`
+	// Number of seconds since the tx was issued
+	Age json.Uint64 `json:"age"`
+}
+
+// GetPendingTxsReply defines the GetPendingTxs replies returned from the API
+type GetPendingTxsReply struct {
+	Txs []PendingTx `json:"txs"`
+}
+
+// GetPendingTxs returns the txs that haven't been sent to consensus yet, in
+// the order they were issued
+func (service *Service) GetPendingTxs(_ *http.Request, _ *struct{}, reply *GetPendingTxsReply) error {
+	service.vm.ctx.Log.Debug("AVM: GetPendingTxs called")
+
+	now := service.vm.clock.Time()
+	reply.Txs = make([]PendingTx, len(service.vm.txs))
+	for i, tx := range service.vm.txs {
+		txID := tx.ID()
+		reply.Txs[i] = PendingTx{
+			TxID: txID,
+			Size: json.Uint64(len(tx.Bytes())),
+		}
+		if issueTime, ok := service.vm.txIssueTimes[txID]; ok && now.After(issueTime) {
+			reply.Txs[i].Age = json.Uint64(now.Sub(issueTime) / time.Second)
+		}
+	}
+	return nil
+}
+
+// GetDroppedTxReasonReply defines the GetDroppedTxReason replies returned from
+// the API
+type GetDroppedTxReasonReply struct {
+	// True iff the tx was recently dropped
+	Dropped bool `json:"dropped"`
+	// Reason the tx was dropped. Only non-empty if [Dropped] is true.
+	Reason string `json:"reason,omitempty"`
+}
+
+// GetDroppedTxReason returns the reason a tx was recently dropped before being
+// sent to consensus
+func (service *Service) GetDroppedTxReason(_ *http.Request, args *api.JSONTxID, reply *GetDroppedTxReasonReply) error {
+	service.vm.ctx.Log.Debug("AVM: GetDroppedTxReason called with %s", args.TxID)
+
+	reason, dropped := service.vm.droppedTxs.Get(args.TxID)
+	if !dropped {
+		return nil
+	}
+	reasonStr, ok := reason.(string)
+	if !ok {
+		return errCorruptedReason
+	}
+	reply.Dropped = true
+	reply.Reason = reasonStr
+	return nil
+}
+
+// EvictPendingTxArgs are arguments for calling EvictPendingTx
+type EvictPendingTxArgs struct {
+	TxID ids.ID `json:"txID"`
+	// Reason recorded as the reason the tx was dropped
+	Reason string `json:"reason"`
+}
+
+// EvictPendingTx removes a tx that hasn't been sent to consensus yet
+func (service *Service) EvictPendingTx(_ *http.Request, args *EvictPendingTxArgs, reply *api.SuccessResponse) error {
+	service.vm.ctx.Log.Info("AVM: EvictPendingTx called with %s", args.TxID)
+
+	reason := "evicted through the API"
+	if args.Reason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, args.Reason)
+	}
+	if !service.vm.evictPendingTx(args.TxID, reason) {
+		return fmt.Errorf("%w: %s", errTxNotPending, args.TxID)
+	}
+	reply.Success = true
+	return nil
+}
+
 // GetTxStatusReply defines the GetTxStatus replies returned from the API
 type GetTxStatusReply struct {
 	Status choices.Status `json:"status"`
+	// True iff the tx was recently dropped before being sent to consensus
+	Dropped bool `json:"dropped,omitempty"`
+	// Reason the tx was dropped. Only non-empty if [Dropped] is true.
+	Reason string `json:"reason,omitempty"`
 }
 
 type GetAddressTxsArgs struct {
@@ -152,6 +417,19 @@ func (service *Service) GetTxStatus(r *http.Request, args *api.JSONTxID, reply *
 	}
 
 	reply.Status = tx.Status()
+	if reply.Status.Decided() {
+		return nil
+	}
+	reason, dropped := service.vm.droppedTxs.Get(args.TxID)
+	if !dropped {
+		return nil
+	}
+	reasonStr, ok := reason.(string)
+	if !ok {
+		return errCorruptedReason
+	}
+	reply.Dropped = true
+	reply.Reason = reasonStr
 	return nil
 }
 
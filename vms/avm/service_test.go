@@ -5,8 +5,10 @@ package avm
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -216,6 +218,170 @@ func TestServiceIssueTx(t *testing.T) {
 	}
 }
 
+func TestServiceSimulateTx(t *testing.T) {
+	genesisBytes, vm, s, _, _ := setup(t, true)
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.ctx.Lock.Unlock()
+	}()
+
+	tx := NewTx(t, genesisBytes, vm)
+	txStr, err := formatting.EncodeWithChecksum(formatting.Hex, tx.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply := &SimulateTxReply{}
+	if err := s.SimulateTx(nil, &api.FormattedTx{Tx: txStr, Encoding: formatting.Hex}, reply); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Valid {
+		t.Fatalf("Expected tx to be valid but got %q", reply.Error)
+	}
+	if reply.TxID != tx.ID() {
+		t.Fatalf("Expected %q, got %q", tx.ID(), reply.TxID)
+	}
+	if len(reply.ConsumedUTXOs) != 1 {
+		t.Fatalf("Expected 1 consumed UTXO, got %d", len(reply.ConsumedUTXOs))
+	}
+	if len(reply.ProducedUTXOs) != 0 {
+		t.Fatalf("Expected no produced UTXOs, got %d", len(reply.ProducedUTXOs))
+	}
+	assetID := tx.UnsignedTx.(*BaseTx).Ins[0].AssetID()
+	if burned := uint64(reply.Burned[assetID]); burned != startBalance {
+		t.Fatalf("Expected %d to be burned, got %d", startBalance, burned)
+	}
+
+	// Simulating the tx must not issue or persist it
+	if len(vm.txs) != 0 {
+		t.Fatalf("Expected no txs to be issued, got %d", len(vm.txs))
+	}
+	if _, err := vm.state.GetTx(tx.ID()); err == nil {
+		t.Fatal("Expected simulated tx not to be persisted")
+	}
+
+	// A tx with an invalid signature fails verification
+	tx.Creds = nil
+	if err := tx.SignSECP256K1Fx(vm.codec, [][]*crypto.PrivateKeySECP256K1R{{keys[1]}}); err != nil {
+		t.Fatal(err)
+	}
+	txStr, err = formatting.EncodeWithChecksum(formatting.Hex, tx.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply = &SimulateTxReply{}
+	if err := s.SimulateTx(nil, &api.FormattedTx{Tx: txStr, Encoding: formatting.Hex}, reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Valid {
+		t.Fatal("Expected tx signed by the wrong key to be invalid")
+	}
+	if reply.Error == "" {
+		t.Fatal("Expected a verification error")
+	}
+}
+
+func TestServicePendingTxs(t *testing.T) {
+	genesisBytes, vm, s, _, _ := setup(t, true)
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.ctx.Lock.Unlock()
+	}()
+
+	tx := NewTx(t, genesisBytes, vm)
+	txStr, err := formatting.EncodeWithChecksum(formatting.Hex, tx.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.IssueTx(nil, &api.FormattedTx{Tx: txStr, Encoding: formatting.Hex}, &api.JSONTxID{}); err != nil {
+		t.Fatal(err)
+	}
+
+	vm.clock.Set(vm.clock.Time().Add(3 * time.Second))
+
+	pendingReply := &GetPendingTxsReply{}
+	if err := s.GetPendingTxs(nil, nil, pendingReply); err != nil {
+		t.Fatal(err)
+	}
+	if len(pendingReply.Txs) != 1 {
+		t.Fatalf("Expected 1 pending tx, got %d", len(pendingReply.Txs))
+	}
+	pendingTx := pendingReply.Txs[0]
+	if pendingTx.TxID != tx.ID() {
+		t.Fatalf("Expected %q, got %q", tx.ID(), pendingTx.TxID)
+	}
+	if size := int(pendingTx.Size); size != len(tx.Bytes()) {
+		t.Fatalf("Expected size %d, got %d", len(tx.Bytes()), size)
+	}
+	if age := uint64(pendingTx.Age); age != 3 {
+		t.Fatalf("Expected age 3, got %d", age)
+	}
+
+	evictReply := &api.SuccessResponse{}
+	if err := s.EvictPendingTx(nil, &EvictPendingTxArgs{TxID: tx.ID(), Reason: "stuck"}, evictReply); err != nil {
+		t.Fatal(err)
+	}
+	if !evictReply.Success {
+		t.Fatal("Expected the eviction to succeed")
+	}
+	if len(vm.txs) != 0 {
+		t.Fatalf("Expected no pending txs, got %d", len(vm.txs))
+	}
+	if err := s.EvictPendingTx(nil, &EvictPendingTxArgs{TxID: tx.ID()}, evictReply); !errors.Is(err, errTxNotPending) {
+		t.Fatalf("Expected %q, got %v", errTxNotPending, err)
+	}
+
+	droppedReply := &GetDroppedTxReasonReply{}
+	if err := s.GetDroppedTxReason(nil, &api.JSONTxID{TxID: tx.ID()}, droppedReply); err != nil {
+		t.Fatal(err)
+	}
+	if !droppedReply.Dropped {
+		t.Fatal("Expected the evicted tx to be marked as dropped")
+	}
+	if !strings.Contains(droppedReply.Reason, "stuck") {
+		t.Fatalf("Expected the reason to contain %q, got %q", "stuck", droppedReply.Reason)
+	}
+
+	// The evicted tx is reported as dropped, but is kept in the database as it
+	// may still be issued in a vertex by a peer
+	statusReply := &GetTxStatusReply{}
+	if err := s.GetTxStatus(nil, &api.JSONTxID{TxID: tx.ID()}, statusReply); err != nil {
+		t.Fatal(err)
+	}
+	if statusReply.Status != choices.Processing {
+		t.Fatalf("Expected status %s, got %s", choices.Processing, statusReply.Status)
+	}
+	if !statusReply.Dropped || statusReply.Reason != droppedReply.Reason {
+		t.Fatalf("Expected the evicted tx to be reported as dropped with reason %q", droppedReply.Reason)
+	}
+	if _, err := vm.state.GetTx(tx.ID()); err != nil {
+		t.Fatalf("Expected the evicted tx to be kept in the database, got %v", err)
+	}
+
+	// A tx that fails verification is recorded as dropped
+	tx.Creds = nil
+	if err := tx.SignSECP256K1Fx(vm.codec, [][]*crypto.PrivateKeySECP256K1R{{keys[1]}}); err != nil {
+		t.Fatal(err)
+	}
+	txStr, err = formatting.EncodeWithChecksum(formatting.Hex, tx.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.IssueTx(nil, &api.FormattedTx{Tx: txStr, Encoding: formatting.Hex}, &api.JSONTxID{}); err == nil {
+		t.Fatal("Expected tx signed by the wrong key to fail verification")
+	}
+	droppedReply = &GetDroppedTxReasonReply{}
+	if err := s.GetDroppedTxReason(nil, &api.JSONTxID{TxID: tx.ID()}, droppedReply); err != nil {
+		t.Fatal(err)
+	}
+	if !droppedReply.Dropped || droppedReply.Reason == "" {
+		t.Fatal("Expected the invalid tx to be marked as dropped with a reason")
+	}
+}
+
 func TestServiceGetTxStatus(t *testing.T) {
 	genesisBytes, vm, s, _, _ := setup(t, true)
 	defer func() {
@@ -44,9 +44,10 @@ import (
 )
 
 const (
-	batchTimeout       = time.Second
-	batchSize          = 30
-	assetToFxCacheSize = 1024
+	batchTimeout        = time.Second
+	batchSize           = 30
+	assetToFxCacheSize  = 1024
+	droppedTxsCacheSize = 64
 )
 
 var (
@@ -93,8 +94,12 @@ type VM struct {
 	timer        *timer.Timer
 	batchTimeout time.Duration
 	txs          []snowstorm.Tx
+	txIssueTimes map[ids.ID]time.Time // txID -> time the tx was issued
 	toEngine     chan<- common.Message
 
+	// txID -> reason the tx was dropped before being sent to consensus
+	droppedTxs *cache.LRU
+
 	baseDB database.Database
 	db     *versiondb.Database
 
@@ -161,6 +166,8 @@ func (vm *VM) Initialize(
 	vm.baseDB = db
 	vm.db = versiondb.New(db)
 	vm.assetToFxCache = &cache.LRU{Size: assetToFxCacheSize}
+	vm.droppedTxs = &cache.LRU{Size: droppedTxsCacheSize}
+	vm.txIssueTimes = make(map[ids.ID]time.Time)
 
 	vm.pubsub = pubsub.New(ctx.NetworkID, ctx.Log)
 
@@ -336,6 +343,7 @@ func (vm *VM) PendingTxs() []snowstorm.Tx {
 
 	txs := vm.txs
 	vm.txs = nil
+	vm.txIssueTimes = make(map[ids.ID]time.Time)
 	return txs
 }
 
@@ -372,12 +380,40 @@ func (vm *VM) IssueTx(b []byte) (ids.ID, error) {
 		return ids.ID{}, err
 	}
 	if err := tx.verifyWithoutCacheWrites(); err != nil {
+		vm.droppedTxs.Put(tx.ID(), err.Error())
 		return ids.ID{}, err
 	}
+	// The tx may have been dropped before and is now valid
+	vm.droppedTxs.Evict(tx.ID())
 	vm.issueTx(tx)
 	return tx.ID(), nil
 }
 
+// evictPendingTx removes [txID] from the txs that are waiting to be sent to
+// consensus and records [reason] as the reason it was dropped. Returns false if
+// [txID] isn't pending.
+//
+// The stored tx and its status are left untouched, as the tx may also be in a
+// vertex issued by a peer that is still being processed.
+func (vm *VM) evictPendingTx(txID ids.ID, reason string) bool {
+	for i, tx := range vm.txs {
+		if tx.ID() != txID {
+			continue
+		}
+
+		// Preserve the issuance order of the remaining txs
+		vm.txs = append(vm.txs[:i], vm.txs[i+1:]...)
+		if len(vm.txs) == 0 {
+			vm.timer.Cancel()
+		}
+
+		delete(vm.txIssueTimes, txID)
+		vm.droppedTxs.Put(txID, reason)
+		return true
+	}
+	return false
+}
+
 func (vm *VM) issueStopVertex() error {
 	select {
 	case vm.toEngine <- common.StopVertex:
@@ -525,6 +561,7 @@ func (vm *VM) parsePrivateTx(txBytes []byte) (*Tx, error) {
 
 func (vm *VM) issueTx(tx snowstorm.Tx) {
 	vm.txs = append(vm.txs, tx)
+	vm.txIssueTimes[tx.ID()] = vm.clock.Time()
 	switch {
 	case len(vm.txs) == batchSize:
 		vm.FlushTxs()
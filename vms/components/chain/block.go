@@ -5,8 +5,11 @@ package chain
 
 import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 )
 
+var _ block.PreVerifiableBlock = &BlockWrapper{}
+
 // BlockWrapper wraps a snowman Block while adding a smart caching layer to improve
 // VM performance.
 type BlockWrapper struct {
@@ -34,6 +37,11 @@ func (bw *BlockWrapper) Verify() error {
 	return nil
 }
 
+// PreVerify pre-verifies the underlying block, if it supports it
+func (bw *BlockWrapper) PreVerify() error {
+	return block.PreVerify(bw.Block)
+}
+
 // Accept accepts the underlying block, removes it from verifiedBlocks, caches it as a decided
 // block, and updates the last accepted block.
 func (bw *BlockWrapper) Accept() error {
@@ -3,11 +3,15 @@
 
 package metervm
 
-import "github.com/ava-labs/avalanchego/snow/consensus/snowman"
+import (
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
 
 var (
-	_ snowman.Block       = &meterBlock{}
-	_ snowman.OracleBlock = &meterBlock{}
+	_ snowman.Block            = &meterBlock{}
+	_ snowman.OracleBlock      = &meterBlock{}
+	_ block.PreVerifiableBlock = &meterBlock{}
 )
 
 type meterBlock struct {
@@ -29,6 +33,10 @@ func (mb *meterBlock) Verify() error {
 	return err
 }
 
+func (mb *meterBlock) PreVerify() error {
+	return block.PreVerify(mb.Block)
+}
+
 func (mb *meterBlock) Accept() error {
 	start := mb.vm.clock.Time()
 	err := mb.Block.Accept()
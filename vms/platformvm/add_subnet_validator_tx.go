@@ -199,15 +199,19 @@ func (tx *UnsignedAddSubnetValidatorTx) Execute(
 			)
 		}
 
-		subnet, ok := subnetIntf.UnsignedTx.(*UnsignedCreateSubnetTx)
-		if !ok {
+		if _, ok := subnetIntf.UnsignedTx.(*UnsignedCreateSubnetTx); !ok {
 			return nil, nil, fmt.Errorf(
 				"%s is not a subnet",
 				tx.Validator.Subnet,
 			)
 		}
 
-		if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, subnet.Owner); err != nil {
+		subnetOwner, err := parentState.GetSubnetOwner(tx.Validator.Subnet)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, subnetOwner); err != nil {
 			return nil, nil, err
 		}
 
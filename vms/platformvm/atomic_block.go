@@ -9,14 +9,16 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 )
 
 var (
 	errConflictingParentTxs = errors.New("block contains a transaction that conflicts with a transaction in a parent block")
 
-	_ Block    = &AtomicBlock{}
-	_ decision = &AtomicBlock{}
+	_ Block                    = &AtomicBlock{}
+	_ decision                 = &AtomicBlock{}
+	_ block.PreVerifiableBlock = &AtomicBlock{}
 )
 
 // AtomicBlock being accepted results in the atomic transaction contained in the
@@ -64,6 +66,13 @@ func (ab *AtomicBlock) conflicts(s ids.Set) (bool, error) {
 	return parent.conflicts(s)
 }
 
+// PreVerify syntactically verifies the transaction of this block and recovers
+// its signers, which doesn't depend on the state of its ancestors. The results
+// are cached, so Verify doesn't repeat them.
+func (ab *AtomicBlock) PreVerify() error {
+	return ab.Tx.preVerify(ab.vm)
+}
+
 // Verify this block performs a valid state transition.
 //
 // The parent block must be a decision block
@@ -67,7 +67,7 @@ func (m *blockBuilder) Initialize(
 	m.toEngine = toEngine
 
 	m.vm.ctx.Log.Verbo("initializing platformVM mempool")
-	mempool, err := NewMempool("mempool", registerer)
+	mempool, err := NewMempool("mempool", registerer, &vm.clock, vm.MempoolFeePriority)
 	if err != nil {
 		return err
 	}
@@ -202,7 +202,7 @@ func (m *blockBuilder) BuildBlock() (snowman.Block, error) {
 	}
 
 	// Get the proposal transaction that should be issued.
-	tx := m.PopProposalTx()
+	tx := m.PeekProposalTx()
 	startTime := tx.UnsignedTx.(TimedTx).StartTime()
 
 	// If the chain timestamp is too far in the past to issue this transaction
@@ -210,8 +210,6 @@ func (m *blockBuilder) BuildBlock() (snowman.Block, error) {
 	// advance the timestamp, so it can be issued.
 	maxChainStartTime := preferredState.GetTimestamp().Add(maxFutureStartTime)
 	if startTime.After(maxChainStartTime) {
-		m.AddProposalTx(tx)
-
 		advanceTimeTx, err := m.vm.newAdvanceTimeTx(m.vm.clock.Time())
 		if err != nil {
 			return nil, err
@@ -219,6 +217,7 @@ func (m *blockBuilder) BuildBlock() (snowman.Block, error) {
 		return m.vm.newProposalBlock(preferredID, nextHeight, *advanceTimeTx)
 	}
 
+	m.RemoveProposalTx(tx)
 	return m.vm.newProposalBlock(preferredID, nextHeight, *tx)
 }
 
@@ -338,12 +337,12 @@ func (m *blockBuilder) dropTooEarlyMempoolProposalTxs() bool {
 	now := m.vm.clock.Time()
 	syncTime := now.Add(syncBound)
 	for m.HasProposalTx() {
-		tx := m.PopProposalTx()
+		tx := m.PeekProposalTx()
 		startTime := tx.UnsignedTx.(TimedTx).StartTime()
 		if !startTime.Before(syncTime) {
-			m.AddProposalTx(tx)
 			return true
 		}
+		m.RemoveProposalTx(tx)
 
 		txID := tx.ID()
 		errMsg := fmt.Sprintf(
@@ -40,6 +40,10 @@ type currentStakerChainState interface {
 		numTxsToRemove int,
 	) (currentStakerChainState, error)
 	DeleteNextStaker() (currentStakerChainState, error)
+	// DeleteSubnetValidator removes [nodeID] from the current validators of
+	// [subnetID] before its end time. Returns database.ErrNotFound if [nodeID]
+	// isn't currently validating [subnetID].
+	DeleteSubnetValidator(nodeID ids.NodeID, subnetID ids.ID) (currentStakerChainState, error)
 
 	// Stakers returns the current stakers on the network sorted in order of the
 	// order of their future removal from the validator set.
@@ -69,6 +73,11 @@ type currentStakerChainStateImpl struct {
 
 	addedStakers   []*validatorReward
 	deletedStakers []*Tx
+
+	// parent is the chain state this chain state was modified from, if its
+	// changes must be applied before the changes of this chain state. This
+	// allows a chain state to be modified multiple times in the same block.
+	parent *currentStakerChainStateImpl
 }
 
 type validatorReward struct {
@@ -267,18 +276,78 @@ func (cs *currentStakerChainStateImpl) DeleteNextStaker() (currentStakerChainSta
 	return newCS, nil
 }
 
+func (cs *currentStakerChainStateImpl) DeleteSubnetValidator(nodeID ids.NodeID, subnetID ids.ID) (currentStakerChainState, error) {
+	vdr, exists := cs.validatorsByNodeID[nodeID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	addSubnetValidatorTx, exists := vdr.subnets[subnetID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	removedTxID := addSubnetValidatorTx.ID()
+	removed, exists := cs.validatorsByTxID[removedTxID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+
+	newCS := &currentStakerChainStateImpl{
+		validatorsByNodeID: make(map[ids.NodeID]*currentValidatorImpl, len(cs.validatorsByNodeID)),
+		validatorsByTxID:   make(map[ids.ID]*validatorReward, len(cs.validatorsByTxID)-1),
+		validators:         make([]*Tx, 0, len(cs.validators)-1), // sorted in order of removal
+
+		deletedStakers: []*Tx{removed.addStakerTx},
+		parent:         cs,
+	}
+
+	for _, tx := range cs.validators {
+		if tx.ID() != removedTxID {
+			newCS.validators = append(newCS.validators, tx)
+		}
+	}
+
+	for nodeID, vdr := range cs.validatorsByNodeID {
+		newCS.validatorsByNodeID[nodeID] = vdr
+	}
+	newVdr := *vdr
+	newVdr.subnets = make(map[ids.ID]*UnsignedAddSubnetValidatorTx, len(vdr.subnets)-1)
+	for vdrSubnetID, addTx := range vdr.subnets {
+		if vdrSubnetID != subnetID {
+			newVdr.subnets[vdrSubnetID] = addTx
+		}
+	}
+	newCS.validatorsByNodeID[nodeID] = &newVdr
+
+	for txID, vdr := range cs.validatorsByTxID {
+		if txID != removedTxID {
+			newCS.validatorsByTxID[txID] = vdr
+		}
+	}
+
+	newCS.setNextStaker()
+	return newCS, nil
+}
+
 func (cs *currentStakerChainStateImpl) Stakers() []*Tx {
 	return cs.validators
 }
 
 func (cs *currentStakerChainStateImpl) Apply(is InternalState) {
+	cs.applyChanges(is)
+	is.SetCurrentStakerChainState(cs)
+}
+
+func (cs *currentStakerChainStateImpl) applyChanges(is InternalState) {
+	if cs.parent != nil {
+		cs.parent.applyChanges(is)
+		cs.parent = nil
+	}
 	for _, added := range cs.addedStakers {
 		is.AddCurrentStaker(added.addStakerTx, added.potentialReward)
 	}
 	for _, deleted := range cs.deletedStakers {
 		is.DeleteCurrentStaker(deleted)
 	}
-	is.SetCurrentStakerChainState(cs)
 
 	// Validator changes should only be applied once.
 	cs.addedStakers = nil
@@ -23,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 
 	safemath "github.com/ava-labs/avalanchego/utils/math"
@@ -41,19 +42,41 @@ var (
 	rewardUTXOsPrefix     = []byte("rewardUTXOs")
 	utxoPrefix            = []byte("utxo")
 	subnetPrefix          = []byte("subnet")
+	subnetOwnerPrefix     = []byte("subnetOwner")
 	chainPrefix           = []byte("chain")
 	singletonPrefix       = []byte("singleton")
-
-	timestampKey     = []byte("timestamp")
-	currentSupplyKey = []byte("current supply")
-	lastAcceptedKey  = []byte("last accepted")
-	initializedKey   = []byte("initialized")
-
-	errWrongNetworkID = errors.New("tx has wrong network ID")
+	historyPrefix         = []byte("history")
+	utxoDiffsPrefix       = []byte("utxoDiffs")
+	stakerDiffsPrefix     = []byte("stakerDiffs")
+	supplyDiffsPrefix     = []byte("supplyDiffs")
+	timestampDiffsPrefix  = []byte("timestampDiffs")
+	subnetHeightsPrefix   = []byte("subnetHeights")
+	chainHeightsPrefix    = []byte("chainHeights")
+	ownerDiffsPrefix      = []byte("subnetOwnerDiffs")
+
+	timestampKey          = []byte("timestamp")
+	currentSupplyKey      = []byte("current supply")
+	lastAcceptedKey       = []byte("last accepted")
+	initializedKey        = []byte("initialized")
+	historyStartHeightKey = []byte("history start height")
+
+	errWrongNetworkID       = errors.New("tx has wrong network ID")
+	errStateHistoryDisabled = errors.New("state history isn't recorded")
+	errHeightNotIndexed     = errors.New("state history isn't available at the requested height")
+	errInvalidStakerDiff    = errors.New("invalid staker diff")
+	errInvalidHistoryDiff   = errors.New("invalid history diff key")
 
 	_ InternalState = &internalStateImpl{}
 )
 
+const (
+	// stakerRemoved and stakerAdded are the values of a staker diff. They
+	// record whether a staker was removed from, or added to, the union of the
+	// current and pending staker sets at a height.
+	stakerRemoved byte = iota
+	stakerAdded
+)
+
 const (
 	// priority values are used as part of the keys in the pending/current
 	// validator state to ensure they are sorted in the order that they should
@@ -68,6 +91,7 @@ const (
 	rewardUTXOsCacheSize    = 2048
 	chainCacheSize          = 2048
 	chainDBCacheSize        = 2048
+	subnetOwnerCacheSize    = 2048
 )
 
 type InternalState interface {
@@ -81,6 +105,31 @@ type InternalState interface {
 	DeleteCurrentStaker(tx *Tx)
 	GetValidatorWeightDiffs(height uint64, subnetID ids.ID) (map[ids.NodeID]*ValidatorWeightDiff, error)
 
+	// GetHistoryStartHeight returns the lowest height that the state can be
+	// queried at, if the state history is recorded.
+	GetHistoryStartHeight() uint64
+	// GetUTXOsAt returns the UTXOs owned by [addrs] once the block at
+	// [height] was accepted.
+	GetUTXOsAt(height uint64, addrs ids.ShortSet) ([]*avax.UTXO, error)
+	// GetStakersAt returns the current and pending stakers once the block at
+	// [height] was accepted.
+	GetStakersAt(height uint64) ([]*Tx, error)
+	// GetCurrentSupplyAt returns the current supply once the block at
+	// [height] was accepted.
+	GetCurrentSupplyAt(height uint64) (uint64, error)
+	// GetTimestampAt returns the chain timestamp once the block at [height]
+	// was accepted.
+	GetTimestampAt(height uint64) (time.Time, error)
+	// GetSubnetsAt returns the subnets that existed once the block at
+	// [height] was accepted.
+	GetSubnetsAt(height uint64) ([]*Tx, error)
+	// GetChainsAt returns the chains of [subnetID] that existed once the block
+	// at [height] was accepted.
+	GetChainsAt(height uint64, subnetID ids.ID) ([]*Tx, error)
+	// GetSubnetOwnerAt returns the owner of [subnetID] once the block at
+	// [height] was accepted.
+	GetSubnetOwnerAt(height uint64, subnetID ids.ID) (fx.Owner, error)
+
 	AddPendingStaker(tx *Tx)
 	DeletePendingStaker(tx *Tx)
 
@@ -139,15 +188,33 @@ type InternalState interface {
  * |-. subnets
  * | '-. list
  * |   '-- txID -> nil
+ * |-. subnetOwners
+ * | '-- subnetID -> owner bytes
  * |-. chains
  * | '-. subnetID
  * |   '-. list
  * |     '-- txID -> nil
+ * |-. history
+ * | |-. utxoDiffs
+ * | | '-- height+utxoID -> utxo bytes before height, or nil if it didn't exist
+ * | |-. stakerDiffs
+ * | | '-- height+txID -> stakerAdded or stakerRemoved
+ * | |-. supplyDiffs
+ * | | '-- height -> currentSupply before height
+ * | |-. timestampDiffs
+ * | | '-- height -> timestamp before height
+ * | |-. subnetOwnerDiffs
+ * | | '-- height+subnetID -> owner bytes before height
+ * | |-. subnetHeights
+ * | | '-- subnetID -> height
+ * | '-. chainHeights
+ * |   '-- chainID -> height
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
  *   |-- currentSupplyKey -> currentSupply
- *   '-- lastAcceptedKey -> lastAccepted
+ *   |-- lastAcceptedKey -> lastAccepted
+ *   '-- historyStartHeightKey -> historyStartHeight
  */
 type internalStateImpl struct {
 	vm *VM
@@ -205,6 +272,10 @@ type internalStateImpl struct {
 	subnetBaseDB  database.Database
 	subnetDB      linkeddb.LinkedDB
 
+	modifiedSubnetOwners map[ids.ID]fx.Owner // map of subnetID -> the owner that replaced the subnet's previous owner
+	subnetOwnerCache     cache.Cacher        // cache of subnetID -> fx.Owner
+	subnetOwnerDB        database.Database
+
 	addedChains  map[ids.ID][]*Tx // maps subnetID -> the newly added chains to the subnet
 	chainCache   cache.Cacher     // cache of subnetID -> the chains after all local modifications []*Tx
 	chainDBCache cache.Cacher     // cache of subnetID -> linkedDB
@@ -214,6 +285,24 @@ type internalStateImpl struct {
 	originalCurrentSupply, currentSupply uint64
 	originalLastAccepted, lastAccepted   ids.ID
 	singletonDB                          database.Database
+
+	// historyRetention is the number of heights, below the last accepted
+	// height, that the state history is kept for. If 0, the state history
+	// isn't recorded.
+	historyRetention uint64
+	// historyStartHeight is the lowest height that the state history is
+	// available from. If [persistHistoryStart] is true, it hasn't been written
+	// to [singletonDB] yet.
+	historyStartHeight  uint64
+	persistHistoryStart bool
+	historyDB           database.Database
+	utxoDiffsDB         database.Database
+	stakerDiffsDB       database.Database
+	supplyDiffsDB       database.Database
+	timestampDiffsDB    database.Database
+	ownerDiffsDB        database.Database
+	subnetHeightsDB     database.Database
+	chainHeightsDB      database.Database
 }
 
 type ValidatorWeightDiff struct {
@@ -256,6 +345,7 @@ func newInternalStateDatabases(vm *VM, db database.Database) *internalStateImpl
 	rewardUTXODB := prefixdb.New(rewardUTXOsPrefix, baseDB)
 	utxoDB := prefixdb.New(utxoPrefix, baseDB)
 	subnetBaseDB := prefixdb.New(subnetPrefix, baseDB)
+	historyDB := prefixdb.New(historyPrefix, baseDB)
 	return &internalStateImpl{
 		vm: vm,
 
@@ -296,10 +386,23 @@ func newInternalStateDatabases(vm *VM, db database.Database) *internalStateImpl
 		subnetBaseDB: subnetBaseDB,
 		subnetDB:     linkeddb.NewDefault(subnetBaseDB),
 
+		modifiedSubnetOwners: make(map[ids.ID]fx.Owner),
+		subnetOwnerDB:        prefixdb.New(subnetOwnerPrefix, baseDB),
+
 		addedChains: make(map[ids.ID][]*Tx),
 		chainDB:     prefixdb.New(chainPrefix, baseDB),
 
 		singletonDB: prefixdb.New(singletonPrefix, baseDB),
+
+		historyRetention: vm.StateHistoryRetention,
+		historyDB:        historyDB,
+		utxoDiffsDB:      prefixdb.New(utxoDiffsPrefix, historyDB),
+		stakerDiffsDB:    prefixdb.New(stakerDiffsPrefix, historyDB),
+		supplyDiffsDB:    prefixdb.New(supplyDiffsPrefix, historyDB),
+		timestampDiffsDB: prefixdb.New(timestampDiffsPrefix, historyDB),
+		ownerDiffsDB:     prefixdb.New(ownerDiffsPrefix, historyDB),
+		subnetHeightsDB:  prefixdb.New(subnetHeightsPrefix, historyDB),
+		chainHeightsDB:   prefixdb.New(chainHeightsPrefix, historyDB),
 	}
 }
 
@@ -311,6 +414,7 @@ func (st *internalStateImpl) initCaches() {
 	st.utxoState = avax.NewUTXOState(st.utxoDB, GenesisCodec)
 	st.chainCache = &cache.LRU{Size: chainCacheSize}
 	st.chainDBCache = &cache.LRU{Size: chainDBCacheSize}
+	st.subnetOwnerCache = &cache.LRU{Size: subnetOwnerCacheSize}
 }
 
 func (st *internalStateImpl) initMeteredCaches(metrics prometheus.Registerer) error {
@@ -369,6 +473,15 @@ func (st *internalStateImpl) initMeteredCaches(metrics prometheus.Registerer) er
 		metrics,
 		&cache.LRU{Size: chainDBCacheSize},
 	)
+	if err != nil {
+		return err
+	}
+
+	subnetOwnerCache, err := metercacher.New(
+		"subnet_owner_cache",
+		metrics,
+		&cache.LRU{Size: subnetOwnerCacheSize},
+	)
 	st.validatorDiffsCache = validatorDiffsCache
 	st.blockCache = blockCache
 	st.txCache = txCache
@@ -376,6 +489,7 @@ func (st *internalStateImpl) initMeteredCaches(metrics prometheus.Registerer) er
 	st.utxoState = utxoState
 	st.chainCache = chainCache
 	st.chainDBCache = chainDBCache
+	st.subnetOwnerCache = subnetOwnerCache
 	return err
 }
 
@@ -484,6 +598,53 @@ func (st *internalStateImpl) AddSubnet(createSubnetTx *Tx) {
 	}
 }
 
+func (st *internalStateImpl) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
+	if owner, modified := st.modifiedSubnetOwners[subnetID]; modified {
+		return owner, nil
+	}
+	return st.getPersistedSubnetOwner(subnetID)
+}
+
+// getPersistedSubnetOwner returns the owner of [subnetID], ignoring the owner
+// that replaces it on the next commit, if any
+func (st *internalStateImpl) getPersistedSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
+	if ownerIntf, cached := st.subnetOwnerCache.Get(subnetID); cached {
+		return ownerIntf.(fx.Owner), nil
+	}
+
+	ownerBytes, err := st.subnetOwnerDB.Get(subnetID[:])
+	switch err {
+	case nil:
+		var owner fx.Owner
+		if _, err := GenesisCodec.Unmarshal(ownerBytes, &owner); err != nil {
+			return nil, err
+		}
+		owner.InitCtx(st.vm.ctx)
+		st.subnetOwnerCache.Put(subnetID, owner)
+		return owner, nil
+	case database.ErrNotFound:
+		// The owner of the subnet was never transferred, so the subnet is still
+		// owned by the owner it was created with.
+	default:
+		return nil, err
+	}
+
+	subnetIntf, _, err := st.GetTx(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	subnet, ok := subnetIntf.UnsignedTx.(*UnsignedCreateSubnetTx)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s isn't a subnet", errWrongTxType, subnetID)
+	}
+	st.subnetOwnerCache.Put(subnetID, subnet.Owner)
+	return subnet.Owner, nil
+}
+
+func (st *internalStateImpl) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	st.modifiedSubnetOwners[subnetID] = owner
+}
+
 func (st *internalStateImpl) GetChains(subnetID ids.ID) ([]*Tx, error) {
 	if chainsIntf, cached := st.chainCache.Get(subnetID); cached {
 		return chainsIntf.([]*Tx), nil
@@ -782,6 +943,271 @@ func (st *internalStateImpl) GetValidatorWeightDiffs(height uint64, subnetID ids
 	return weightDiffs, nil
 }
 
+func (st *internalStateImpl) GetHistoryStartHeight() uint64 { return st.historyStartHeight }
+
+// checkHistoryHeight returns nil iff the state history is available at
+// [height]
+func (st *internalStateImpl) checkHistoryHeight(height uint64) error {
+	switch {
+	case st.historyRetention == 0:
+		return errStateHistoryDisabled
+	case height < st.historyStartHeight:
+		return fmt.Errorf("%w: %d < %d", errHeightNotIndexed, height, st.historyStartHeight)
+	default:
+		return nil
+	}
+}
+
+func (st *internalStateImpl) GetUTXOsAt(height uint64, addrs ids.ShortSet) ([]*avax.UTXO, error) {
+	if err := st.checkHistoryHeight(height); err != nil {
+		return nil, err
+	}
+
+	currentUTXOs, err := avax.GetAllUTXOs(st, addrs)
+	if err != nil {
+		return nil, err
+	}
+	utxos := make(map[ids.ID]*avax.UTXO, len(currentUTXOs))
+	for _, utxo := range currentUTXOs {
+		utxos[utxo.InputID()] = utxo
+	}
+
+	// The first diff of a UTXO after [height] holds its value at [height].
+	seen := ids.Set{}
+	restoredUTXOs := []*avax.UTXO(nil)
+	diffIt := st.utxoDiffsDB.NewIteratorWithStart(database.PackUInt64(height + 1))
+	defer diffIt.Release()
+	for diffIt.Next() {
+		utxoID, err := parseHistoryKey(diffIt.Key())
+		if err != nil {
+			return nil, err
+		}
+		if seen.Contains(utxoID) {
+			continue
+		}
+		seen.Add(utxoID)
+
+		utxoBytes := diffIt.Value()
+		if len(utxoBytes) == 0 {
+			// The UTXO didn't exist at [height].
+			delete(utxos, utxoID)
+			continue
+		}
+
+		utxo := &avax.UTXO{}
+		if _, err := GenesisCodec.Unmarshal(utxoBytes, utxo); err != nil {
+			return nil, err
+		}
+		if _, exists := utxos[utxoID]; exists || !isOwnedBy(utxo, addrs) {
+			continue
+		}
+		utxos[utxoID] = utxo
+		restoredUTXOs = append(restoredUTXOs, utxo)
+	}
+	if err := diffIt.Error(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*avax.UTXO, 0, len(utxos))
+	for _, utxo := range currentUTXOs {
+		if _, exists := utxos[utxo.InputID()]; exists {
+			result = append(result, utxo)
+		}
+	}
+	return append(result, restoredUTXOs...), nil
+}
+
+func (st *internalStateImpl) GetStakersAt(height uint64) ([]*Tx, error) {
+	if err := st.checkHistoryHeight(height); err != nil {
+		return nil, err
+	}
+
+	currentStakers := st.currentStakerChainState.Stakers()
+	pendingStakers := st.pendingStakerChainState.Stakers()
+	stakers := make([]*Tx, 0, len(currentStakers)+len(pendingStakers))
+	stakers = append(stakers, currentStakers...)
+	stakers = append(stakers, pendingStakers...)
+
+	// The first diff of a staker after [height] determines whether it was a
+	// staker at [height].
+	removed := ids.Set{}
+	seen := ids.Set{}
+	restoredStakers := []*Tx(nil)
+	diffIt := st.stakerDiffsDB.NewIteratorWithStart(database.PackUInt64(height + 1))
+	defer diffIt.Release()
+	for diffIt.Next() {
+		txID, err := parseHistoryKey(diffIt.Key())
+		if err != nil {
+			return nil, err
+		}
+		if seen.Contains(txID) {
+			continue
+		}
+		seen.Add(txID)
+
+		diff := diffIt.Value()
+		if len(diff) != 1 {
+			return nil, errInvalidStakerDiff
+		}
+		switch diff[0] {
+		case stakerAdded:
+			removed.Add(txID)
+		case stakerRemoved:
+			tx, _, err := st.GetTx(txID)
+			if err != nil {
+				return nil, err
+			}
+			restoredStakers = append(restoredStakers, tx)
+		default:
+			return nil, errInvalidStakerDiff
+		}
+	}
+	if err := diffIt.Error(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*Tx, 0, len(stakers)+len(restoredStakers))
+	for _, tx := range stakers {
+		if !removed.Contains(tx.ID()) {
+			result = append(result, tx)
+		}
+	}
+	return append(result, restoredStakers...), nil
+}
+
+func (st *internalStateImpl) GetCurrentSupplyAt(height uint64) (uint64, error) {
+	if err := st.checkHistoryHeight(height); err != nil {
+		return 0, err
+	}
+
+	diffIt := st.supplyDiffsDB.NewIteratorWithStart(database.PackUInt64(height + 1))
+	defer diffIt.Release()
+	if diffIt.Next() {
+		return database.ParseUInt64(diffIt.Value())
+	}
+	return st.currentSupply, diffIt.Error()
+}
+
+func (st *internalStateImpl) GetTimestampAt(height uint64) (time.Time, error) {
+	if err := st.checkHistoryHeight(height); err != nil {
+		return time.Time{}, err
+	}
+
+	diffIt := st.timestampDiffsDB.NewIteratorWithStart(database.PackUInt64(height + 1))
+	defer diffIt.Release()
+	if diffIt.Next() {
+		return database.ParseTimestamp(diffIt.Value())
+	}
+	return st.timestamp, diffIt.Error()
+}
+
+func (st *internalStateImpl) GetSubnetsAt(height uint64) ([]*Tx, error) {
+	if err := st.checkHistoryHeight(height); err != nil {
+		return nil, err
+	}
+
+	subnets, err := st.GetSubnets()
+	if err != nil {
+		return nil, err
+	}
+	return st.filterCreatedAt(height, st.subnetHeightsDB, subnets)
+}
+
+func (st *internalStateImpl) GetChainsAt(height uint64, subnetID ids.ID) ([]*Tx, error) {
+	if err := st.checkHistoryHeight(height); err != nil {
+		return nil, err
+	}
+
+	chains, err := st.GetChains(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	return st.filterCreatedAt(height, st.chainHeightsDB, chains)
+}
+
+func (st *internalStateImpl) GetSubnetOwnerAt(height uint64, subnetID ids.ID) (fx.Owner, error) {
+	if err := st.checkHistoryHeight(height); err != nil {
+		return nil, err
+	}
+
+	// The first diff of the owner after [height] holds the owner at [height].
+	diffIt := st.ownerDiffsDB.NewIteratorWithStart(database.PackUInt64(height + 1))
+	defer diffIt.Release()
+	for diffIt.Next() {
+		diffSubnetID, err := parseHistoryKey(diffIt.Key())
+		if err != nil {
+			return nil, err
+		}
+		if diffSubnetID != subnetID {
+			continue
+		}
+
+		var owner fx.Owner
+		if _, err := GenesisCodec.Unmarshal(diffIt.Value(), &owner); err != nil {
+			return nil, err
+		}
+		owner.InitCtx(st.vm.ctx)
+		return owner, nil
+	}
+	if err := diffIt.Error(); err != nil {
+		return nil, err
+	}
+	return st.GetSubnetOwner(subnetID)
+}
+
+// filterCreatedAt returns the txs that were accepted at or below [height],
+// according to the heights recorded in [heightsDB]. Txs without a recorded
+// height were accepted before the history was recorded.
+func (st *internalStateImpl) filterCreatedAt(height uint64, heightsDB database.KeyValueReader, txs []*Tx) ([]*Tx, error) {
+	result := make([]*Tx, 0, len(txs))
+	for _, tx := range txs {
+		txID := tx.ID()
+		createdHeight, err := database.GetUInt64(heightsDB, txID[:])
+		switch err {
+		case nil:
+			if createdHeight > height {
+				continue
+			}
+		case database.ErrNotFound:
+		default:
+			return nil, err
+		}
+		result = append(result, tx)
+	}
+	return result, nil
+}
+
+// historyKey returns the key of the diff of [id] at the packed [heightBytes].
+func historyKey(heightBytes []byte, id ids.ID) []byte {
+	key := make([]byte, len(heightBytes)+len(id))
+	copy(key, heightBytes)
+	copy(key[len(heightBytes):], id[:])
+	return key
+}
+
+// parseHistoryKey returns the ID of a key created by historyKey.
+func parseHistoryKey(key []byte) (ids.ID, error) {
+	if len(key) != wrappers.LongLen+len(ids.Empty) {
+		return ids.ID{}, errInvalidHistoryDiff
+	}
+	return ids.ToID(key[wrappers.LongLen:])
+}
+
+// isOwnedBy returns true if any of the owners of [utxo] are in [addrs].
+func isOwnedBy(utxo *avax.UTXO, addrs ids.ShortSet) bool {
+	addressable, ok := utxo.Out.(avax.Addressable)
+	if !ok {
+		return false
+	}
+	for _, addrBytes := range addressable.Addresses() {
+		addr, err := ids.ToShortID(addrBytes)
+		if err == nil && addrs.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func (st *internalStateImpl) Abort() {
 	st.baseDB.Abort()
 }
@@ -796,6 +1222,11 @@ func (st *internalStateImpl) Commit() error {
 }
 
 func (st *internalStateImpl) CommitBatch() (database.Batch, error) {
+	// The history must be written first, as it relies on the state that is
+	// about to be overwritten.
+	if err := st.writeHistory(); err != nil {
+		return nil, fmt.Errorf("failed to write state history with: %w", err)
+	}
 	if err := st.writeCurrentStakers(); err != nil {
 		return nil, fmt.Errorf("failed to write current stakers with: %w", err)
 	}
@@ -820,6 +1251,9 @@ func (st *internalStateImpl) CommitBatch() (database.Batch, error) {
 	if err := st.writeSubnets(); err != nil {
 		return nil, fmt.Errorf("failed to write current subnets with: %w", err)
 	}
+	if err := st.writeSubnetOwners(); err != nil {
+		return nil, fmt.Errorf("failed to write subnet owners with: %w", err)
+	}
 	if err := st.writeChains(); err != nil {
 		return nil, fmt.Errorf("failed to write chains with: %w", err)
 	}
@@ -846,8 +1280,17 @@ func (st *internalStateImpl) Close() error {
 		st.rewardUTXODB.Close(),
 		st.utxoDB.Close(),
 		st.subnetBaseDB.Close(),
+		st.subnetOwnerDB.Close(),
 		st.chainDB.Close(),
 		st.singletonDB.Close(),
+		st.utxoDiffsDB.Close(),
+		st.stakerDiffsDB.Close(),
+		st.supplyDiffsDB.Close(),
+		st.timestampDiffsDB.Close(),
+		st.ownerDiffsDB.Close(),
+		st.subnetHeightsDB.Close(),
+		st.chainHeightsDB.Close(),
+		st.historyDB.Close(),
 		st.baseDB.Close(),
 	)
 	return errs.Err
@@ -1213,6 +1656,22 @@ func (st *internalStateImpl) writeSubnets() error {
 	return nil
 }
 
+func (st *internalStateImpl) writeSubnetOwners() error {
+	for subnetID, owner := range st.modifiedSubnetOwners {
+		delete(st.modifiedSubnetOwners, subnetID)
+
+		ownerBytes, err := GenesisCodec.Marshal(CodecVersion, &owner)
+		if err != nil {
+			return fmt.Errorf("failed to marshal subnet owner: %w", err)
+		}
+		if err := st.subnetOwnerDB.Put(subnetID[:], ownerBytes); err != nil {
+			return err
+		}
+		st.subnetOwnerCache.Put(subnetID, owner)
+	}
+	return nil
+}
+
 func (st *internalStateImpl) writeChains() error {
 	for subnetID, chains := range st.addedChains {
 		for _, chain := range chains {
@@ -1247,9 +1706,232 @@ func (st *internalStateImpl) writeSingletons() error {
 		}
 		st.originalLastAccepted = st.lastAccepted
 	}
+	if st.persistHistoryStart {
+		if err := database.PutUInt64(st.singletonDB, historyStartHeightKey, st.historyStartHeight); err != nil {
+			return err
+		}
+		st.persistHistoryStart = false
+	}
 	return nil
 }
 
+// writeHistory records, for every piece of state that is about to be written
+// at [currentHeight], the value that it had before [currentHeight]. If the
+// state is committed multiple times at the same height, only the first prior
+// value is kept.
+func (st *internalStateImpl) writeHistory() error {
+	if st.historyRetention == 0 {
+		return nil
+	}
+
+	heightBytes := database.PackUInt64(st.currentHeight)
+
+	for utxoID := range st.modifiedUTXOs {
+		key := historyKey(heightBytes, utxoID)
+		has, err := st.utxoDiffsDB.Has(key)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+
+		var utxoBytes []byte
+		utxo, err := st.utxoState.GetUTXO(utxoID)
+		switch err {
+		case nil:
+			utxoBytes, err = GenesisCodec.Marshal(CodecVersion, utxo)
+			if err != nil {
+				return err
+			}
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+		if err := st.utxoDiffsDB.Put(key, utxoBytes); err != nil {
+			return err
+		}
+	}
+
+	// Only the net change of the union of the current and pending staker sets
+	// is recorded. Moving a staker from the pending set to the current set
+	// doesn't change its membership.
+	stakerChanges := make(map[ids.ID]int)
+	for _, staker := range st.addedCurrentStakers {
+		stakerChanges[staker.addStakerTx.ID()]++
+	}
+	for _, tx := range st.deletedCurrentStakers {
+		stakerChanges[tx.ID()]--
+	}
+	for _, tx := range st.addedPendingStakers {
+		stakerChanges[tx.ID()]++
+	}
+	for _, tx := range st.deletedPendingStakers {
+		stakerChanges[tx.ID()]--
+	}
+	for txID, change := range stakerChanges {
+		if change == 0 {
+			continue
+		}
+		diff := stakerRemoved
+		if change > 0 {
+			diff = stakerAdded
+		}
+
+		key := historyKey(heightBytes, txID)
+		previousDiff, err := st.stakerDiffsDB.Get(key)
+		switch err {
+		case nil:
+			// A previous commit at this height made the opposite change, so
+			// the staker's membership is unchanged at this height.
+			if len(previousDiff) == 1 && previousDiff[0] != diff {
+				if err := st.stakerDiffsDB.Delete(key); err != nil {
+					return err
+				}
+			}
+			continue
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+		if err := st.stakerDiffsDB.Put(key, []byte{diff}); err != nil {
+			return err
+		}
+	}
+
+	if st.originalCurrentSupply != st.currentSupply {
+		has, err := st.supplyDiffsDB.Has(heightBytes)
+		if err != nil {
+			return err
+		}
+		if !has {
+			if err := database.PutUInt64(st.supplyDiffsDB, heightBytes, st.originalCurrentSupply); err != nil {
+				return err
+			}
+		}
+	}
+	if !st.originalTimestamp.Equal(st.timestamp) {
+		has, err := st.timestampDiffsDB.Has(heightBytes)
+		if err != nil {
+			return err
+		}
+		if !has {
+			if err := database.PutTimestamp(st.timestampDiffsDB, heightBytes, st.originalTimestamp); err != nil {
+				return err
+			}
+		}
+	}
+
+	for subnetID := range st.modifiedSubnetOwners {
+		key := historyKey(heightBytes, subnetID)
+		has, err := st.ownerDiffsDB.Has(key)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+
+		owner, err := st.getPersistedSubnetOwner(subnetID)
+		if err != nil {
+			return err
+		}
+		ownerBytes, err := GenesisCodec.Marshal(CodecVersion, &owner)
+		if err != nil {
+			return fmt.Errorf("failed to marshal subnet owner: %w", err)
+		}
+		if err := st.ownerDiffsDB.Put(key, ownerBytes); err != nil {
+			return err
+		}
+	}
+
+	for _, subnet := range st.addedSubnets {
+		subnetID := subnet.ID()
+		if err := database.PutUInt64(st.subnetHeightsDB, subnetID[:], st.currentHeight); err != nil {
+			return err
+		}
+	}
+	for _, chains := range st.addedChains {
+		for _, chain := range chains {
+			chainID := chain.ID()
+			if err := database.PutUInt64(st.chainHeightsDB, chainID[:], st.currentHeight); err != nil {
+				return err
+			}
+		}
+	}
+
+	if st.currentHeight <= st.historyRetention {
+		return nil
+	}
+	startHeight := st.currentHeight - st.historyRetention
+	if startHeight <= st.historyStartHeight {
+		return nil
+	}
+	return st.pruneHistory(startHeight)
+}
+
+// pruneHistory makes [startHeight] the lowest height that the state history is
+// available from, and deletes the diffs that are only read by queries below
+// it. Queries at [startHeight] only read the diffs above [startHeight].
+//
+// The heights of the subnets and chains are kept, as there is one per subnet
+// or chain rather than one per change.
+func (st *internalStateImpl) pruneHistory(startHeight uint64) error {
+	diffDBs := []database.Database{
+		st.utxoDiffsDB,
+		st.stakerDiffsDB,
+		st.supplyDiffsDB,
+		st.timestampDiffsDB,
+		st.ownerDiffsDB,
+	}
+	for _, diffDB := range diffDBs {
+		if err := deleteDiffsUpTo(diffDB, startHeight); err != nil {
+			return err
+		}
+	}
+	st.historyStartHeight = startHeight
+	st.persistHistoryStart = true
+	return nil
+}
+
+// deleteDiffsUpTo deletes the diffs of [diffDB] at heights up to and including
+// [height]. The keys of [diffDB] must start with the packed height of the
+// diff.
+func deleteDiffsUpTo(diffDB database.Database, height uint64) error {
+	diffIt := diffDB.NewIterator()
+	defer diffIt.Release()
+
+	for diffIt.Next() {
+		key := diffIt.Key()
+		if len(key) < wrappers.LongLen {
+			return errInvalidHistoryDiff
+		}
+		diffHeight, err := database.ParseUInt64(key[:wrappers.LongLen])
+		if err != nil {
+			return err
+		}
+		if diffHeight > height {
+			break
+		}
+		if err := diffDB.Delete(key); err != nil {
+			return err
+		}
+	}
+	return diffIt.Error()
+}
+
+// deleteHistory deletes the recorded state history, including the height it
+// starts at, and commits the deletion
+func (st *internalStateImpl) deleteHistory() error {
+	if err := database.Clear(st.historyDB, st.historyDB); err != nil {
+		return err
+	}
+	if err := st.singletonDB.Delete(historyStartHeightKey); err != nil {
+		return err
+	}
+	return st.baseDB.Commit()
+}
+
 func (st *internalStateImpl) load() error {
 	if err := st.loadSingletons(); err != nil {
 		return err
@@ -1282,6 +1964,29 @@ func (st *internalStateImpl) loadSingletons() error {
 	st.originalLastAccepted = lastAccepted
 	st.lastAccepted = lastAccepted
 
+	historyStartHeight, err := database.GetUInt64(st.singletonDB, historyStartHeightKey)
+	switch {
+	case err != nil && err != database.ErrNotFound:
+		return err
+	case st.historyRetention == 0:
+		if err == database.ErrNotFound {
+			return nil
+		}
+		// The state history was recorded, but isn't anymore. It's deleted, as
+		// it would have a gap if it was recorded again.
+		return st.deleteHistory()
+	case err == nil:
+		st.historyStartHeight = historyStartHeight
+	default:
+		// The state history wasn't recorded before, so it's only available
+		// from the last accepted block onwards.
+		lastAcceptedBlk, err := st.GetBlock(lastAccepted)
+		if err != nil {
+			return err
+		}
+		st.historyStartHeight = lastAcceptedBlk.Height()
+		st.persistHistoryStart = true
+	}
 	return nil
 }
 
@@ -1607,5 +2312,11 @@ func (st *internalStateImpl) init(genesisBytes []byte) error {
 		return err
 	}
 
+	// The history of a new database is recorded from genesis.
+	if st.historyRetention > 0 {
+		st.historyStartHeight = 0
+		st.persistHistoryStart = true
+	}
+
 	return st.Commit()
 }
@@ -23,6 +23,10 @@ type pendingStakerChainState interface {
 
 	AddStaker(addStakerTx *Tx) pendingStakerChainState
 	DeleteStakers(numToRemove int) pendingStakerChainState
+	// DeleteSubnetValidator removes [nodeID] from the pending validators of
+	// [subnetID]. Returns database.ErrNotFound if [nodeID] isn't pending to
+	// validate [subnetID].
+	DeleteSubnetValidator(nodeID ids.NodeID, subnetID ids.ID) (pendingStakerChainState, error)
 
 	// Stakers returns the list of pending validators in order of their removal
 	// from the pending staker set
@@ -45,6 +49,11 @@ type pendingStakerChainStateImpl struct {
 
 	addedStakers   []*Tx
 	deletedStakers []*Tx
+
+	// parent is the chain state this chain state was modified from, if its
+	// changes must be applied before the changes of this chain state. This
+	// allows a chain state to be modified multiple times in the same block.
+	parent *pendingStakerChainStateImpl
 }
 
 func (ps *pendingStakerChainStateImpl) GetValidatorTx(nodeID ids.NodeID) (addStakerTx *UnsignedAddValidatorTx, err error) {
@@ -194,18 +203,75 @@ func (ps *pendingStakerChainStateImpl) DeleteStakers(numToRemove int) pendingSta
 	return newPS
 }
 
+func (ps *pendingStakerChainStateImpl) DeleteSubnetValidator(nodeID ids.NodeID, subnetID ids.ID) (pendingStakerChainState, error) {
+	vdr, exists := ps.validatorExtrasByNodeID[nodeID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	addSubnetValidatorTx, exists := vdr.subnets[subnetID]
+	if !exists {
+		return nil, database.ErrNotFound
+	}
+	removedTxID := addSubnetValidatorTx.ID()
+
+	newPS := &pendingStakerChainStateImpl{
+		validatorsByNodeID:      ps.validatorsByNodeID,
+		validatorExtrasByNodeID: make(map[ids.NodeID]*validatorImpl, len(ps.validatorExtrasByNodeID)),
+		validators:              make([]*Tx, 0, len(ps.validators)-1),
+		parent:                  ps,
+	}
+
+	for _, tx := range ps.validators {
+		if tx.ID() == removedTxID {
+			newPS.deletedStakers = []*Tx{tx}
+		} else {
+			newPS.validators = append(newPS.validators, tx)
+		}
+	}
+	if len(newPS.deletedStakers) == 0 {
+		return nil, database.ErrNotFound
+	}
+
+	for vdrNodeID, vdr := range ps.validatorExtrasByNodeID {
+		if vdrNodeID != nodeID {
+			newPS.validatorExtrasByNodeID[vdrNodeID] = vdr
+		}
+	}
+	if len(vdr.delegators) != 0 || len(vdr.subnets) != 1 {
+		newSubnets := make(map[ids.ID]*UnsignedAddSubnetValidatorTx, len(vdr.subnets)-1)
+		for vdrSubnetID, subnetTx := range vdr.subnets {
+			if vdrSubnetID != subnetID {
+				newSubnets[vdrSubnetID] = subnetTx
+			}
+		}
+		newPS.validatorExtrasByNodeID[nodeID] = &validatorImpl{
+			delegators: vdr.delegators,
+			subnets:    newSubnets,
+		}
+	}
+	return newPS, nil
+}
+
 func (ps *pendingStakerChainStateImpl) Stakers() []*Tx {
 	return ps.validators
 }
 
 func (ps *pendingStakerChainStateImpl) Apply(is InternalState) {
+	ps.applyChanges(is)
+	is.SetPendingStakerChainState(ps)
+}
+
+func (ps *pendingStakerChainStateImpl) applyChanges(is InternalState) {
+	if ps.parent != nil {
+		ps.parent.applyChanges(is)
+		ps.parent = nil
+	}
 	for _, added := range ps.addedStakers {
 		is.AddPendingStaker(added)
 	}
 	for _, deleted := range ps.deletedStakers {
 		is.DeletePendingStaker(deleted)
 	}
-	is.SetPendingStakerChainState(ps)
 
 	// Validator changes should only be applied once.
 	ps.addedStakers = nil
@@ -9,6 +9,7 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 )
 
@@ -48,6 +49,11 @@ type MutableState interface {
 	GetSubnets() ([]*Tx, error)
 	AddSubnet(createSubnetTx *Tx)
 
+	// GetSubnetOwner returns the owner that must authorize modifications of
+	// [subnetID].
+	GetSubnetOwner(subnetID ids.ID) (fx.Owner, error)
+	SetSubnetOwner(subnetID ids.ID, owner fx.Owner)
+
 	GetChains(subnetID ids.ID) ([]*Tx, error)
 	AddChain(createChainTx *Tx)
 
@@ -58,6 +64,9 @@ type MutableState interface {
 type VersionedState interface {
 	MutableState
 
+	SetCurrentStakerChainState(currentStakerChainState)
+	SetPendingStakerChainState(pendingStakerChainState)
+
 	SetBase(MutableState)
 	Apply(InternalState)
 }
@@ -75,6 +84,9 @@ type versionedStateImpl struct {
 	addedSubnets  []*Tx
 	cachedSubnets []*Tx
 
+	// map of subnetID -> the owner that replaced the subnet's previous owner
+	modifiedSubnetOwners map[ids.ID]fx.Owner
+
 	addedChains  map[ids.ID][]*Tx
 	cachedChains map[ids.ID][]*Tx
 
@@ -155,6 +167,20 @@ func (vs *versionedStateImpl) AddSubnet(createSubnetTx *Tx) {
 	}
 }
 
+func (vs *versionedStateImpl) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
+	if owner, modified := vs.modifiedSubnetOwners[subnetID]; modified {
+		return owner, nil
+	}
+	return vs.parentState.GetSubnetOwner(subnetID)
+}
+
+func (vs *versionedStateImpl) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	if vs.modifiedSubnetOwners == nil {
+		vs.modifiedSubnetOwners = make(map[ids.ID]fx.Owner)
+	}
+	vs.modifiedSubnetOwners[subnetID] = owner
+}
+
 func (vs *versionedStateImpl) GetChains(subnetID ids.ID) ([]*Tx, error) {
 	if len(vs.addedChains) == 0 {
 		// No chains have been added
@@ -293,6 +319,14 @@ func (vs *versionedStateImpl) PendingStakerChainState() pendingStakerChainState
 	return vs.pendingStakerChainState
 }
 
+func (vs *versionedStateImpl) SetCurrentStakerChainState(cs currentStakerChainState) {
+	vs.currentStakerChainState = cs
+}
+
+func (vs *versionedStateImpl) SetPendingStakerChainState(ps pendingStakerChainState) {
+	vs.pendingStakerChainState = ps
+}
+
 func (vs *versionedStateImpl) SetBase(parentState MutableState) {
 	vs.parentState = parentState
 }
@@ -303,6 +337,9 @@ func (vs *versionedStateImpl) Apply(is InternalState) {
 	for _, subnet := range vs.addedSubnets {
 		is.AddSubnet(subnet)
 	}
+	for subnetID, owner := range vs.modifiedSubnetOwners {
+		is.SetSubnetOwner(subnetID, owner)
+	}
 	for _, chains := range vs.addedChains {
 		for _, chain := range chains {
 			is.AddChain(chain)
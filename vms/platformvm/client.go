@@ -30,6 +30,9 @@ type Client interface {
 	ImportKey(ctx context.Context, user api.UserPass, privateKey *crypto.PrivateKeySECP256K1R, options ...rpc.Option) (ids.ShortID, error)
 	// GetBalance returns the balance of [addrs] on the P Chain
 	GetBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetBalanceResponse, error)
+	// GetBalanceAt returns the balance of [addrs] on the P Chain once the
+	// block at [height] was accepted
+	GetBalanceAt(ctx context.Context, addrs []ids.ShortID, height uint64, options ...rpc.Option) (*GetBalanceResponse, error)
 	// CreateAddress creates a new address for [user]
 	CreateAddress(ctx context.Context, user api.UserPass, options ...rpc.Option) (ids.ShortID, error)
 	// ListAddresses returns an array of platform addresses controlled by [user]
@@ -56,6 +59,9 @@ type Client interface {
 	) ([][]byte, ids.ShortID, ids.ID, error)
 	// GetSubnets returns information about the specified subnets
 	GetSubnets(context.Context, []ids.ID, ...rpc.Option) ([]ClientSubnet, error)
+	// GetSubnetsAt returns information about the specified subnets that
+	// existed once the block at [height] was accepted
+	GetSubnetsAt(ctx context.Context, ids []ids.ID, height uint64, options ...rpc.Option) ([]ClientSubnet, error)
 	// GetStakingAssetID returns the assetID of the asset used for staking on
 	// subnet corresponding to [subnetID]
 	GetStakingAssetID(context.Context, ids.ID, ...rpc.Option) (ids.ID, error)
@@ -65,6 +71,9 @@ type Client interface {
 	GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]interface{}, []interface{}, error)
 	// GetCurrentSupply returns an upper bound on the supply of AVAX in the system
 	GetCurrentSupply(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// GetCurrentSupplyAt returns an upper bound on the supply of AVAX in the
+	// system once the block at [height] was accepted
+	GetCurrentSupplyAt(ctx context.Context, height uint64, options ...rpc.Option) (uint64, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for subnet with ID [subnetID]
 	SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
 	// AddValidator issues a transaction to add a validator to the primary network
@@ -110,6 +119,17 @@ type Client interface {
 		endTime uint64,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// RemoveSubnetValidator issues a transaction to remove validator [nodeID]
+	// from subnet with ID [subnetID] and returns the txID
+	RemoveSubnetValidator(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		subnetID ids.ID,
+		nodeID ids.NodeID,
+		options ...rpc.Option,
+	) (ids.ID, error)
 	// CreateSubnet issues a transaction to create [subnet] and returns the txID
 	CreateSubnet(
 		ctx context.Context,
@@ -120,6 +140,18 @@ type Client interface {
 		threshold uint32,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// TransferSubnetOwnership issues a transaction to replace the owner of
+	// the subnet with ID [subnetID] and returns the txID
+	TransferSubnetOwnership(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		subnetID ids.ID,
+		controlKeys []ids.ShortID,
+		threshold uint32,
+		options ...rpc.Option,
+	) (ids.ID, error)
 	// ExportAVAX issues an ExportTx transaction and returns the txID
 	ExportAVAX(
 		ctx context.Context,
@@ -162,8 +194,13 @@ type Client interface {
 	Validates(ctx context.Context, subnetID ids.ID, options ...rpc.Option) ([]ids.ID, error)
 	// GetBlockchains returns the list of blockchains on the platform
 	GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error)
+	// GetBlockchainsAt returns the list of blockchains on the platform once
+	// the block at [height] was accepted
+	GetBlockchainsAt(ctx context.Context, height uint64, options ...rpc.Option) ([]APIBlockchain, error)
 	// IssueTx issues the transaction and returns its txID
 	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
+	// SimulateTx verifies [tx] against the preferred state without issuing it
+	SimulateTx(ctx context.Context, tx []byte, options ...rpc.Option) (*SimulateTxReply, error)
 	// GetTx returns the byte representation of the transaction corresponding to [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
@@ -177,9 +214,19 @@ type Client interface {
 		freq time.Duration,
 		options ...rpc.Option,
 	) (*GetTxStatusResponse, error)
+	// GetMempoolTxs returns the txs in the mempool
+	GetMempoolTxs(ctx context.Context, options ...rpc.Option) (*GetMempoolTxsReply, error)
+	// GetDroppedTxReason returns the reason [txID] was dropped, if it was
+	// recently dropped
+	GetDroppedTxReason(ctx context.Context, txID ids.ID, options ...rpc.Option) (bool, string, error)
+	// EvictMempoolTx removes [txID] from the mempool
+	EvictMempoolTx(ctx context.Context, txID ids.ID, reason string, options ...rpc.Option) (bool, error)
 	// GetStake returns the amount of nAVAX that [addrs] have cumulatively
 	// staked on the Primary Network.
 	GetStake(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (uint64, [][]byte, error)
+	// GetStakeAt returns the amount of nAVAX that [addrs] had cumulatively
+	// staked on the Primary Network once the block at [height] was accepted.
+	GetStakeAt(ctx context.Context, addrs []ids.ShortID, height uint64, options ...rpc.Option) (uint64, [][]byte, error)
 	// GetMinStake returns the minimum staking amount in nAVAX for validators
 	// and delegators respectively
 	GetMinStake(ctx context.Context, options ...rpc.Option) (uint64, uint64, error)
@@ -246,9 +293,19 @@ func (c *client) ImportKey(ctx context.Context, user api.UserPass, privateKey *c
 }
 
 func (c *client) GetBalance(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (*GetBalanceResponse, error) {
+	return c.getBalance(ctx, addrs, nil, options...)
+}
+
+func (c *client) GetBalanceAt(ctx context.Context, addrs []ids.ShortID, height uint64, options ...rpc.Option) (*GetBalanceResponse, error) {
+	jsonHeight := json.Uint64(height)
+	return c.getBalance(ctx, addrs, &jsonHeight, options...)
+}
+
+func (c *client) getBalance(ctx context.Context, addrs []ids.ShortID, height *json.Uint64, options ...rpc.Option) (*GetBalanceResponse, error) {
 	res := &GetBalanceResponse{}
 	err := c.requester.SendRequest(ctx, "getBalance", &GetBalanceRequest{
 		Addresses: ids.ShortIDsToStrings(addrs),
+		Height:    height,
 	}, res, options...)
 	return res, err
 }
@@ -334,9 +391,19 @@ type ClientSubnet struct {
 }
 
 func (c *client) GetSubnets(ctx context.Context, ids []ids.ID, options ...rpc.Option) ([]ClientSubnet, error) {
+	return c.getSubnets(ctx, ids, nil, options...)
+}
+
+func (c *client) GetSubnetsAt(ctx context.Context, ids []ids.ID, height uint64, options ...rpc.Option) ([]ClientSubnet, error) {
+	jsonHeight := json.Uint64(height)
+	return c.getSubnets(ctx, ids, &jsonHeight, options...)
+}
+
+func (c *client) getSubnets(ctx context.Context, ids []ids.ID, height *json.Uint64, options ...rpc.Option) ([]ClientSubnet, error) {
 	res := &GetSubnetsResponse{}
 	err := c.requester.SendRequest(ctx, "getSubnets", &GetSubnetsArgs{
-		IDs: ids,
+		IDs:    ids,
+		Height: height,
 	}, res, options...)
 	if err != nil {
 		return nil, err
@@ -398,7 +465,16 @@ func (c *client) GetPendingValidators(
 
 func (c *client) GetCurrentSupply(ctx context.Context, options ...rpc.Option) (uint64, error) {
 	res := &GetCurrentSupplyReply{}
-	err := c.requester.SendRequest(ctx, "getCurrentSupply", struct{}{}, res, options...)
+	err := c.requester.SendRequest(ctx, "getCurrentSupply", &GetCurrentSupplyArgs{}, res, options...)
+	return uint64(res.Supply), err
+}
+
+func (c *client) GetCurrentSupplyAt(ctx context.Context, height uint64, options ...rpc.Option) (uint64, error) {
+	res := &GetCurrentSupplyReply{}
+	jsonHeight := json.Uint64(height)
+	err := c.requester.SendRequest(ctx, "getCurrentSupply", &GetCurrentSupplyArgs{
+		Height: &jsonHeight,
+	}, res, options...)
 	return uint64(res.Supply), err
 }
 
@@ -505,6 +581,28 @@ func (c *client) AddSubnetValidator(
 	return res.TxID, err
 }
 
+func (c *client) RemoveSubnetValidator(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "removeSubnetValidator", &RemoveSubnetValidatorArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		NodeID:   nodeID,
+		SubnetID: subnetID.String(),
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) CreateSubnet(
 	ctx context.Context,
 	user api.UserPass,
@@ -529,6 +627,32 @@ func (c *client) CreateSubnet(
 	return res.TxID, err
 }
 
+func (c *client) TransferSubnetOwnership(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	subnetID ids.ID,
+	controlKeys []ids.ShortID,
+	threshold uint32,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "transferSubnetOwnership", &TransferSubnetOwnershipArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		SubnetID: subnetID,
+		APISubnet: APISubnet{
+			ControlKeys: ids.ShortIDsToStrings(controlKeys),
+			Threshold:   json.Uint32(threshold),
+		},
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) ExportAVAX(
 	ctx context.Context,
 	user api.UserPass,
@@ -635,7 +759,16 @@ func (c *client) Validates(ctx context.Context, subnetID ids.ID, options ...rpc.
 
 func (c *client) GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error) {
 	res := &GetBlockchainsResponse{}
-	err := c.requester.SendRequest(ctx, "getBlockchains", struct{}{}, res, options...)
+	err := c.requester.SendRequest(ctx, "getBlockchains", &GetBlockchainsArgs{}, res, options...)
+	return res.Blockchains, err
+}
+
+func (c *client) GetBlockchainsAt(ctx context.Context, height uint64, options ...rpc.Option) ([]APIBlockchain, error) {
+	res := &GetBlockchainsResponse{}
+	jsonHeight := json.Uint64(height)
+	err := c.requester.SendRequest(ctx, "getBlockchains", &GetBlockchainsArgs{
+		Height: &jsonHeight,
+	}, res, options...)
 	return res.Blockchains, err
 }
 
@@ -653,6 +786,20 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.EncodeWithChecksum(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SimulateTxReply{}
+	err = c.requester.SendRequest(ctx, "simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "getTx", &api.GetTxArgs{
@@ -695,13 +842,46 @@ func (c *client) AwaitTxDecided(ctx context.Context, txID ids.ID, includeReason
 	}
 }
 
+func (c *client) GetMempoolTxs(ctx context.Context, options ...rpc.Option) (*GetMempoolTxsReply, error) {
+	res := &GetMempoolTxsReply{}
+	err := c.requester.SendRequest(ctx, "getMempoolTxs", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetDroppedTxReason(ctx context.Context, txID ids.ID, options ...rpc.Option) (bool, string, error) {
+	res := &GetDroppedTxReasonReply{}
+	err := c.requester.SendRequest(ctx, "getDroppedTxReason", &api.JSONTxID{
+		TxID: txID,
+	}, res, options...)
+	return res.Dropped, res.Reason, err
+}
+
+func (c *client) EvictMempoolTx(ctx context.Context, txID ids.ID, reason string, options ...rpc.Option) (bool, error) {
+	res := &api.SuccessResponse{}
+	err := c.requester.SendRequest(ctx, "evictMempoolTx", &EvictMempoolTxArgs{
+		TxID:   txID,
+		Reason: reason,
+	}, res, options...)
+	return res.Success, err
+}
+
 func (c *client) GetStake(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) (uint64, [][]byte, error) {
+	return c.getStake(ctx, addrs, nil, options...)
+}
+
+func (c *client) GetStakeAt(ctx context.Context, addrs []ids.ShortID, height uint64, options ...rpc.Option) (uint64, [][]byte, error) {
+	jsonHeight := json.Uint64(height)
+	return c.getStake(ctx, addrs, &jsonHeight, options...)
+}
+
+func (c *client) getStake(ctx context.Context, addrs []ids.ShortID, height *json.Uint64, options ...rpc.Option) (uint64, [][]byte, error) {
 	res := new(GetStakeReply)
 	err := c.requester.SendRequest(ctx, "getStake", &GetStakeArgs{
 		JSONAddresses: api.JSONAddresses{
 			Addresses: ids.ShortIDsToStrings(addrs),
 		},
 		Encoding: formatting.Hex,
+		Height:   height,
 	}, res, options...)
 	if err != nil {
 		return 0, nil, err
@@ -65,6 +65,9 @@ func init() {
 
 			c.RegisterType(&stakeable.LockIn{}),
 			c.RegisterType(&stakeable.LockOut{}),
+
+			c.RegisterType(&UnsignedRemoveSubnetValidatorTx{}),
+			c.RegisterType(&UnsignedTransferSubnetOwnershipTx{}),
 		)
 	}
 	errs.Add(
@@ -34,6 +34,15 @@ type Config struct {
 	// Set of subnets that this node is validating
 	WhitelistedSubnets ids.Set
 
+	// True if decision txs in the mempool should be issued in order of the
+	// fee they burn per byte, rather than in the order they were received
+	MempoolFeePriority bool
+
+	// Number of accepted blocks, below the last accepted block, that the
+	// history of the state is kept for, so that the state can be queried at
+	// their heights. If 0, the history isn't recorded.
+	StateHistoryRetention uint64
+
 	// Fee that must be burned by every create staker transaction
 	AddStakerTxFee uint64
 
@@ -81,4 +90,7 @@ type Config struct {
 
 	// Time of the AP5 network upgrade
 	ApricotPhase5Time time.Time
+
+	// Time of the AP6 network upgrade
+	ApricotPhase6Time time.Time
 }
@@ -143,13 +143,17 @@ func (tx *UnsignedCreateChainTx) Execute(
 		return nil, err
 	}
 
-	subnet, ok := subnetIntf.UnsignedTx.(*UnsignedCreateSubnetTx)
-	if !ok {
+	if _, ok := subnetIntf.UnsignedTx.(*UnsignedCreateSubnetTx); !ok {
 		return nil, fmt.Errorf("%s isn't a subnet", tx.SubnetID)
 	}
 
+	subnetOwner, err := vs.GetSubnetOwner(tx.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Verify that this chain is authorized by the subnet
-	if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, subnet.Owner); err != nil {
+	if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, subnetOwner); err != nil {
 		return nil, err
 	}
 
@@ -34,6 +34,11 @@ type Fx interface {
 	// assents to [tx]
 	VerifyPermission(tx, in, cred, controlGroup interface{}) error
 
+	// RecoverCredential recovers the public keys that signed [tx] in [cred],
+	// so that verifying [cred] later doesn't have to recover them again. It
+	// must be safe to call concurrently.
+	RecoverCredential(tx, cred interface{}) error
+
 	// CreateOutput creates a new output with the provided control group worth
 	// the specified amount
 	CreateOutput(amount uint64, controlGroup interface{}) (interface{}, error)
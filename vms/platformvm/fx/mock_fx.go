@@ -91,6 +91,20 @@ func (mr *MockFxMockRecorder) Initialize(vm interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockFx)(nil).Initialize), vm)
 }
 
+// RecoverCredential mocks base method.
+func (m *MockFx) RecoverCredential(tx, cred interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverCredential", tx, cred)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecoverCredential indicates an expected call of RecoverCredential.
+func (mr *MockFxMockRecorder) RecoverCredential(tx, cred interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverCredential", reflect.TypeOf((*MockFx)(nil).RecoverCredential), tx, cred)
+}
+
 // VerifyPermission mocks base method.
 func (m *MockFx) VerifyPermission(tx, in, cred, controlGroup interface{}) error {
 	m.ctrl.T.Helper()
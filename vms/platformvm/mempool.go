@@ -6,11 +6,14 @@ package platformvm
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
 )
 
@@ -46,10 +49,21 @@ type Mempool interface {
 
 	RemoveDecisionTxs(txs []*Tx)
 	RemoveProposalTx(tx *Tx)
+	// Remove removes [txID] from the mempool and returns it. If [txID] isn't
+	// in the mempool, nil is returned.
+	Remove(txID ids.ID) *Tx
 
 	PopDecisionTxs(maxTxsBytes int) []*Tx
+	PeekProposalTx() *Tx
 	PopProposalTx() *Tx
 
+	// DecisionTxs and ProposalTxs return the txs in the mempool, ordered by
+	// the time they were added to the mempool.
+	DecisionTxs() []*Tx
+	ProposalTxs() []*Tx
+	// AddedTime returns the time [txID] was added to the mempool.
+	AddedTime(txID ids.ID) (time.Time, bool)
+
 	MarkDropped(txID ids.ID)
 	WasDropped(txID ids.ID) bool
 }
@@ -67,9 +81,19 @@ type mempool struct {
 	droppedTxIDs *cache.LRU
 
 	consumedUTXOs ids.Set
+
+	clock      *mockable.Clock
+	addedTimes map[ids.ID]time.Time // txID -> time the tx was added
 }
 
-func NewMempool(namespace string, registerer prometheus.Registerer) (Mempool, error) {
+// NewMempool returns a new mempool. If [feePriority] is true, decision txs are
+// popped in order of the fee they burn per byte rather than their age.
+func NewMempool(
+	namespace string,
+	registerer prometheus.Registerer,
+	clock *mockable.Clock,
+	feePriority bool,
+) (Mempool, error) {
 	bytesAvailableMetric := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Name:      "bytes_available",
@@ -79,8 +103,12 @@ func NewMempool(namespace string, registerer prometheus.Registerer) (Mempool, er
 		return nil, err
 	}
 
+	decisionTxHeap := NewTxHeapByAge()
+	if feePriority {
+		decisionTxHeap = NewTxHeapByFee()
+	}
 	unissuedDecisionTxs, err := NewTxHeapWithMetrics(
-		NewTxHeapByAge(),
+		decisionTxHeap,
 		fmt.Sprintf("%s_decision_txs", namespace),
 		registerer,
 	)
@@ -115,6 +143,8 @@ func NewMempool(namespace string, registerer prometheus.Registerer) (Mempool, er
 		unknownTxs:           unknownTxs,
 		droppedTxIDs:         &cache.LRU{Size: droppedTxIDsCacheSize},
 		consumedUTXOs:        ids.NewSet(initialConsumedUTXOsSize),
+		clock:                clock,
+		addedTimes:           make(map[ids.ID]time.Time),
 	}, nil
 }
 
@@ -209,12 +239,35 @@ func (m *mempool) PopDecisionTxs(maxTxsBytes int) []*Tx {
 	return txs
 }
 
+func (m *mempool) Remove(txID ids.ID) *Tx {
+	if tx := m.unissuedDecisionTxs.Remove(txID); tx != nil {
+		m.deregister(tx)
+		return tx
+	}
+	if tx := m.unissuedProposalTxs.Remove(txID); tx != nil {
+		m.deregister(tx)
+		return tx
+	}
+	return nil
+}
+
+func (m *mempool) PeekProposalTx() *Tx { return m.unissuedProposalTxs.Peek() }
+
 func (m *mempool) PopProposalTx() *Tx {
 	tx := m.unissuedProposalTxs.RemoveTop()
 	m.deregister(tx)
 	return tx
 }
 
+func (m *mempool) DecisionTxs() []*Tx { return m.sortByAddedTime(m.unissuedDecisionTxs.List()) }
+
+func (m *mempool) ProposalTxs() []*Tx { return m.sortByAddedTime(m.unissuedProposalTxs.List()) }
+
+func (m *mempool) AddedTime(txID ids.ID) (time.Time, bool) {
+	addedTime, ok := m.addedTimes[txID]
+	return addedTime, ok
+}
+
 func (m *mempool) MarkDropped(txID ids.ID) {
 	m.droppedTxIDs.Put(txID, struct{}{})
 }
@@ -224,16 +277,25 @@ func (m *mempool) WasDropped(txID ids.ID) bool {
 	return exist
 }
 
+func (m *mempool) sortByAddedTime(txs []*Tx) []*Tx {
+	sort.SliceStable(txs, func(i, j int) bool {
+		return m.addedTimes[txs[i].ID()].Before(m.addedTimes[txs[j].ID()])
+	})
+	return txs
+}
+
 func (m *mempool) register(tx *Tx) {
 	txBytes := tx.Bytes()
 	m.bytesAvailable -= len(txBytes)
 	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
+	m.addedTimes[tx.ID()] = m.clock.Time()
 }
 
 func (m *mempool) deregister(tx *Tx) {
 	txBytes := tx.Bytes()
 	m.bytesAvailable += len(txBytes)
 	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
+	delete(m.addedTimes, tx.ID())
 
 	inputs := tx.InputIDs()
 	m.consumedUTXOs.Difference(inputs)
@@ -39,6 +39,8 @@ type metrics struct {
 	numCreateSubnetTxs,
 	numExportTxs,
 	numImportTxs,
+	numRemoveSubnetValidatorTxs,
+	numTransferSubnetOwnershipTxs,
 	numRewardValidatorTxs prometheus.Counter
 
 	validatorSetsCached     prometheus.Counter
@@ -120,6 +122,8 @@ func (m *metrics) Initialize(
 	m.numCreateSubnetTxs = newTxMetrics(namespace, "create_subnet")
 	m.numExportTxs = newTxMetrics(namespace, "export")
 	m.numImportTxs = newTxMetrics(namespace, "import")
+	m.numRemoveSubnetValidatorTxs = newTxMetrics(namespace, "remove_subnet_validator")
+	m.numTransferSubnetOwnershipTxs = newTxMetrics(namespace, "transfer_subnet_ownership")
 	m.numRewardValidatorTxs = newTxMetrics(namespace, "reward_validator")
 
 	m.validatorSetsCached = prometheus.NewCounter(prometheus.CounterOpts{
@@ -171,6 +175,8 @@ func (m *metrics) Initialize(
 		registerer.Register(m.numCreateSubnetTxs),
 		registerer.Register(m.numExportTxs),
 		registerer.Register(m.numImportTxs),
+		registerer.Register(m.numRemoveSubnetValidatorTxs),
+		registerer.Register(m.numTransferSubnetOwnershipTxs),
 		registerer.Register(m.numRewardValidatorTxs),
 
 		registerer.Register(m.validatorSetsCreated),
@@ -230,6 +236,10 @@ func (m *metrics) AcceptTx(tx *Tx) error {
 		m.numImportTxs.Inc()
 	case *UnsignedExportTx:
 		m.numExportTxs.Inc()
+	case *UnsignedRemoveSubnetValidatorTx:
+		m.numRemoveSubnetValidatorTxs.Inc()
+	case *UnsignedTransferSubnetOwnershipTx:
+		m.numTransferSubnetOwnershipTxs.Inc()
 	case *UnsignedRewardValidatorTx:
 		m.numRewardValidatorTxs.Inc()
 	default:
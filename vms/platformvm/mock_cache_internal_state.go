@@ -11,6 +11,7 @@ import (
 	database "github.com/ava-labs/avalanchego/database"
 	ids "github.com/ava-labs/avalanchego/ids"
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
+	fx "github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -269,6 +270,21 @@ func (mr *MockInternalStateMockRecorder) GetChains(subnetID interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChains", reflect.TypeOf((*MockInternalState)(nil).GetChains), subnetID)
 }
 
+// GetChainsAt mocks base method.
+func (m *MockInternalState) GetChainsAt(height uint64, subnetID ids.ID) ([]*Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainsAt", height, subnetID)
+	ret0, _ := ret[0].([]*Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChainsAt indicates an expected call of GetChainsAt.
+func (mr *MockInternalStateMockRecorder) GetChainsAt(height, subnetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainsAt", reflect.TypeOf((*MockInternalState)(nil).GetChainsAt), height, subnetID)
+}
+
 // GetCurrentSupply mocks base method.
 func (m *MockInternalState) GetCurrentSupply() uint64 {
 	m.ctrl.T.Helper()
@@ -283,6 +299,35 @@ func (mr *MockInternalStateMockRecorder) GetCurrentSupply() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentSupply", reflect.TypeOf((*MockInternalState)(nil).GetCurrentSupply))
 }
 
+// GetCurrentSupplyAt mocks base method.
+func (m *MockInternalState) GetCurrentSupplyAt(height uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentSupplyAt", height)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentSupplyAt indicates an expected call of GetCurrentSupplyAt.
+func (mr *MockInternalStateMockRecorder) GetCurrentSupplyAt(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentSupplyAt", reflect.TypeOf((*MockInternalState)(nil).GetCurrentSupplyAt), height)
+}
+
+// GetHistoryStartHeight mocks base method.
+func (m *MockInternalState) GetHistoryStartHeight() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryStartHeight")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetHistoryStartHeight indicates an expected call of GetHistoryStartHeight.
+func (mr *MockInternalStateMockRecorder) GetHistoryStartHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryStartHeight", reflect.TypeOf((*MockInternalState)(nil).GetHistoryStartHeight))
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockInternalState) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -312,6 +357,21 @@ func (mr *MockInternalStateMockRecorder) GetRewardUTXOs(txID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardUTXOs", reflect.TypeOf((*MockInternalState)(nil).GetRewardUTXOs), txID)
 }
 
+// GetStakersAt mocks base method.
+func (m *MockInternalState) GetStakersAt(height uint64) ([]*Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakersAt", height)
+	ret0, _ := ret[0].([]*Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakersAt indicates an expected call of GetStakersAt.
+func (mr *MockInternalStateMockRecorder) GetStakersAt(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakersAt", reflect.TypeOf((*MockInternalState)(nil).GetStakersAt), height)
+}
+
 // GetStartTime mocks base method.
 func (m *MockInternalState) GetStartTime(nodeID ids.NodeID) (time.Time, error) {
 	m.ctrl.T.Helper()
@@ -327,6 +387,36 @@ func (mr *MockInternalStateMockRecorder) GetStartTime(nodeID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStartTime", reflect.TypeOf((*MockInternalState)(nil).GetStartTime), nodeID)
 }
 
+// GetSubnetOwner mocks base method.
+func (m *MockInternalState) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetOwner", subnetID)
+	ret0, _ := ret[0].(fx.Owner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetOwner indicates an expected call of GetSubnetOwner.
+func (mr *MockInternalStateMockRecorder) GetSubnetOwner(subnetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetOwner", reflect.TypeOf((*MockInternalState)(nil).GetSubnetOwner), subnetID)
+}
+
+// GetSubnetOwnerAt mocks base method.
+func (m *MockInternalState) GetSubnetOwnerAt(height uint64, subnetID ids.ID) (fx.Owner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetOwnerAt", height, subnetID)
+	ret0, _ := ret[0].(fx.Owner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetOwnerAt indicates an expected call of GetSubnetOwnerAt.
+func (mr *MockInternalStateMockRecorder) GetSubnetOwnerAt(height, subnetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetOwnerAt", reflect.TypeOf((*MockInternalState)(nil).GetSubnetOwnerAt), height, subnetID)
+}
+
 // GetSubnets mocks base method.
 func (m *MockInternalState) GetSubnets() ([]*Tx, error) {
 	m.ctrl.T.Helper()
@@ -342,6 +432,21 @@ func (mr *MockInternalStateMockRecorder) GetSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockInternalState)(nil).GetSubnets))
 }
 
+// GetSubnetsAt mocks base method.
+func (m *MockInternalState) GetSubnetsAt(height uint64) ([]*Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetsAt", height)
+	ret0, _ := ret[0].([]*Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetsAt indicates an expected call of GetSubnetsAt.
+func (mr *MockInternalStateMockRecorder) GetSubnetsAt(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetsAt", reflect.TypeOf((*MockInternalState)(nil).GetSubnetsAt), height)
+}
+
 // GetTimestamp mocks base method.
 func (m *MockInternalState) GetTimestamp() time.Time {
 	m.ctrl.T.Helper()
@@ -356,6 +461,21 @@ func (mr *MockInternalStateMockRecorder) GetTimestamp() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimestamp", reflect.TypeOf((*MockInternalState)(nil).GetTimestamp))
 }
 
+// GetTimestampAt mocks base method.
+func (m *MockInternalState) GetTimestampAt(height uint64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimestampAt", height)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimestampAt indicates an expected call of GetTimestampAt.
+func (mr *MockInternalStateMockRecorder) GetTimestampAt(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimestampAt", reflect.TypeOf((*MockInternalState)(nil).GetTimestampAt), height)
+}
+
 // GetTx mocks base method.
 func (m *MockInternalState) GetTx(txID ids.ID) (*Tx, status.Status, error) {
 	m.ctrl.T.Helper()
@@ -387,6 +507,21 @@ func (mr *MockInternalStateMockRecorder) GetUTXO(utxoID interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockInternalState)(nil).GetUTXO), utxoID)
 }
 
+// GetUTXOsAt mocks base method.
+func (m *MockInternalState) GetUTXOsAt(height uint64, addrs ids.ShortSet) ([]*avax.UTXO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOsAt", height, addrs)
+	ret0, _ := ret[0].([]*avax.UTXO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXOsAt indicates an expected call of GetUTXOsAt.
+func (mr *MockInternalStateMockRecorder) GetUTXOsAt(height, addrs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOsAt", reflect.TypeOf((*MockInternalState)(nil).GetUTXOsAt), height, addrs)
+}
+
 // GetUptime mocks base method.
 func (m *MockInternalState) GetUptime(nodeID ids.NodeID) (time.Duration, time.Time, error) {
 	m.ctrl.T.Helper()
@@ -492,6 +627,18 @@ func (mr *MockInternalStateMockRecorder) SetPendingStakerChainState(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingStakerChainState", reflect.TypeOf((*MockInternalState)(nil).SetPendingStakerChainState), arg0)
 }
 
+// SetSubnetOwner mocks base method.
+func (m *MockInternalState) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetOwner", subnetID, owner)
+}
+
+// SetSubnetOwner indicates an expected call of SetSubnetOwner.
+func (mr *MockInternalStateMockRecorder) SetSubnetOwner(subnetID, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockInternalState)(nil).SetSubnetOwner), subnetID, owner)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockInternalState) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -9,10 +9,14 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/choices"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 )
 
-var _ Block = &ProposalBlock{}
+var (
+	_ Block                    = &ProposalBlock{}
+	_ block.PreVerifiableBlock = &ProposalBlock{}
+)
 
 // ProposalBlock is a proposal to change the chain's state.
 //
@@ -99,6 +103,13 @@ func (pb *ProposalBlock) setBaseState() {
 	pb.onAbortState.SetBase(pb.vm.internalState)
 }
 
+// PreVerify syntactically verifies the transaction of this block and recovers
+// its signers, which doesn't depend on the state of its ancestors. The results
+// are cached, so Verify doesn't repeat them.
+func (pb *ProposalBlock) PreVerify() error {
+	return pb.Tx.preVerify(pb.vm)
+}
+
 // Verify this block is valid.
 //
 // The parent block must either be a Commit or an Abort block.
@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	errRemovePrimaryNetworkValidator = errors.New("can't remove validators of the primary network")
+	errNotSubnetValidator            = errors.New("node isn't a validator of the subnet")
+	errApricotPhase6NotActivated     = errors.New("tx isn't allowed before the AP6 network upgrade")
+
+	_ UnsignedDecisionTx = &UnsignedRemoveSubnetValidatorTx{}
+)
+
+// UnsignedRemoveSubnetValidatorTx is an unsigned removeSubnetValidatorTx. It
+// removes a current or pending validator of a subnet before its end time.
+type UnsignedRemoveSubnetValidatorTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// The node to remove from the subnet
+	NodeID ids.NodeID `serialize:"true" json:"nodeID"`
+	// The subnet to remove the node from
+	Subnet ids.ID `serialize:"true" json:"subnet"`
+	// Auth that will be allowing this validator to be removed from the subnet
+	SubnetAuth verify.Verifiable `serialize:"true" json:"subnetAuthorization"`
+}
+
+// InputUTXOs for [DecisionTxs] will return an empty set to diffrentiate from the [AtomicTxs] input UTXOs
+func (tx *UnsignedRemoveSubnetValidatorTx) InputUTXOs() ids.Set { return nil }
+
+func (tx *UnsignedRemoveSubnetValidatorTx) AtomicOperations() (ids.ID, *atomic.Requests, error) {
+	return ids.ID{}, nil, nil
+}
+
+// SyntacticVerify returns nil iff [tx] is valid
+func (tx *UnsignedRemoveSubnetValidatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return errNilTx
+	case tx.syntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Subnet == constants.PrimaryNetworkID:
+		return errRemovePrimaryNetworkValidator
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.SubnetAuth.Verify(); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.syntacticallyVerified = true
+	return nil
+}
+
+// Attempts to verify this transaction with the provided state.
+func (tx *UnsignedRemoveSubnetValidatorTx) SemanticVerify(vm *VM, parentState MutableState, stx *Tx) error {
+	vs := newVersionedState(
+		parentState,
+		parentState.CurrentStakerChainState(),
+		parentState.PendingStakerChainState(),
+	)
+	_, err := tx.Execute(vm, vs, stx)
+	return err
+}
+
+// Execute this transaction.
+func (tx *UnsignedRemoveSubnetValidatorTx) Execute(
+	vm *VM,
+	vs VersionedState,
+	stx *Tx,
+) (
+	func() error,
+	error,
+) {
+	// Make sure this transaction is well formed.
+	if len(stx.Creds) == 0 {
+		return nil, errWrongNumberOfCredentials
+	}
+
+	if err := tx.SyntacticVerify(vm.ctx); err != nil {
+		return nil, err
+	}
+
+	if currentTimestamp := vs.GetTimestamp(); currentTimestamp.Before(vm.ApricotPhase6Time) {
+		return nil, fmt.Errorf(
+			"%w: chain time %s is before %s",
+			errApricotPhase6NotActivated,
+			currentTimestamp,
+			vm.ApricotPhase6Time,
+		)
+	}
+
+	// Select the credentials for each purpose
+	baseTxCredsLen := len(stx.Creds) - 1
+	baseTxCreds := stx.Creds[:baseTxCredsLen]
+	subnetCred := stx.Creds[baseTxCredsLen]
+
+	// Verify the flowcheck
+	if err := vm.semanticVerifySpend(vs, tx, tx.Ins, tx.Outs, baseTxCreds, vm.TxFee, vm.ctx.AVAXAssetID); err != nil {
+		return nil, err
+	}
+
+	subnetIntf, _, err := vs.GetTx(tx.Subnet)
+	if err == database.ErrNotFound {
+		return nil, fmt.Errorf("%s isn't a known subnet", tx.Subnet)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := subnetIntf.UnsignedTx.(*UnsignedCreateSubnetTx); !ok {
+		return nil, fmt.Errorf("%s isn't a subnet", tx.Subnet)
+	}
+
+	subnetOwner, err := vs.GetSubnetOwner(tx.Subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify that the removal is authorized by the subnet
+	if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, subnetOwner); err != nil {
+		return nil, err
+	}
+
+	// Remove the validator from the current validators if it has started
+	// validating the subnet, otherwise remove it from the pending validators.
+	currentStakers := vs.CurrentStakerChainState()
+	newlyCurrentStakers, err := currentStakers.DeleteSubnetValidator(tx.NodeID, tx.Subnet)
+	switch err {
+	case nil:
+		vs.SetCurrentStakerChainState(newlyCurrentStakers)
+	case database.ErrNotFound:
+		pendingStakers := vs.PendingStakerChainState()
+		newlyPendingStakers, err := pendingStakers.DeleteSubnetValidator(tx.NodeID, tx.Subnet)
+		if err == database.ErrNotFound {
+			return nil, fmt.Errorf(
+				"%w: %s isn't validating subnet %s",
+				errNotSubnetValidator,
+				tx.NodeID,
+				tx.Subnet,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+		vs.SetPendingStakerChainState(newlyPendingStakers)
+	default:
+		return nil, err
+	}
+
+	// Consume the UTXOS
+	consumeInputs(vs, tx.Ins)
+	// Produce the UTXOS
+	txID := tx.ID()
+	produceOutputs(vs, txID, vm.ctx.AVAXAssetID, tx.Outs)
+	return nil, nil
+}
+
+// Create a new transaction
+func (vm *VM) newRemoveSubnetValidatorTx(
+	nodeID ids.NodeID, // ID of the node to remove
+	subnetID ids.ID, // ID of the subnet the node will be removed from
+	keys []*crypto.PrivateKeySECP256K1R, // Keys to use for removing the validator
+	changeAddr ids.ShortID, // Address to send change to, if there is any
+) (*Tx, error) {
+	ins, outs, _, signers, err := vm.stake(keys, 0, vm.TxFee, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := vm.authorize(vm.internalState, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	// Create the tx
+	utx := &UnsignedRemoveSubnetValidatorTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    vm.ctx.NetworkID,
+			BlockchainID: vm.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		NodeID:     nodeID,
+		Subnet:     subnetID,
+		SubnetAuth: subnetAuth,
+	}
+	tx := &Tx{UnsignedTx: utx}
+	if err := tx.Sign(Codec, signers); err != nil {
+		return nil, err
+	}
+	return tx, utx.SyntacticVerify(vm.ctx)
+}
@@ -0,0 +1,237 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestRemoveSubnetValidatorTxSyntacticVerify(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+
+	// Case: tx is nil
+	var unsignedTx *UnsignedRemoveSubnetValidatorTx
+	assert.ErrorIs(unsignedTx.SyntacticVerify(vm.ctx), errNilTx)
+
+	// Case: valid tx
+	tx, err := vm.newRemoveSubnetValidatorTx(
+		ids.NodeID(keys[0].PublicKey().Address()),
+		testSubnet1.ID(),
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	// Case: removing a primary network validator
+	utx := tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx)
+	utx.Subnet = constants.PrimaryNetworkID
+	// This tx was syntactically verified when it was created...pretend it wasn't so we don't use cache
+	utx.syntacticallyVerified = false
+	assert.ErrorIs(utx.SyntacticVerify(vm.ctx), errRemovePrimaryNetworkValidator)
+}
+
+func TestRemoveSubnetValidatorTxExecute(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+
+	controlKeys := []*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]}
+	currentNodeID := ids.NodeID(keys[0].PublicKey().Address())
+	pendingNodeID := ids.NodeID(keys[1].PublicKey().Address())
+
+	currentSubnetTx, err := vm.newAddSubnetValidatorTx(
+		defaultWeight,                           // weight
+		uint64(defaultValidateStartTime.Unix()), // start time
+		uint64(defaultValidateEndTime.Unix()),   // end time
+		currentNodeID,                           // node ID
+		testSubnet1.ID(),                        // subnet ID
+		controlKeys,
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+	pendingSubnetTx, err := vm.newAddSubnetValidatorTx(
+		defaultWeight,                       // weight
+		uint64(defaultGenesisTime.Unix())+1, // start time
+		uint64(defaultGenesisTime.Add(defaultMinStakingDuration).Unix())+1, // end time
+		pendingNodeID,    // node ID
+		testSubnet1.ID(), // subnet ID
+		controlKeys,
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	vm.internalState.AddCurrentStaker(currentSubnetTx, 0)
+	vm.internalState.AddTx(currentSubnetTx, status.Committed)
+	vm.internalState.AddPendingStaker(pendingSubnetTx)
+	vm.internalState.AddTx(pendingSubnetTx, status.Committed)
+	assert.NoError(vm.internalState.Commit())
+	assert.NoError(vm.internalState.(*internalStateImpl).loadCurrentValidators())
+	assert.NoError(vm.internalState.(*internalStateImpl).loadPendingValidators())
+
+	// Don't burn a fee so that the removals don't consume the same UTXO and can
+	// be issued in the same block.
+	vm.TxFee = 0
+	removeCurrentTx, err := vm.newRemoveSubnetValidatorTx(currentNodeID, testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	assert.NoError(err)
+	removePendingTx, err := vm.newRemoveSubnetValidatorTx(pendingNodeID, testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	assert.NoError(err)
+
+	// Both validators are removed in the same block
+	preferred, err := vm.Preferred()
+	assert.NoError(err)
+	blk, err := vm.newStandardBlock(preferred.ID(), preferred.Height()+1, []*Tx{removeCurrentTx, removePendingTx})
+	assert.NoError(err)
+	assert.NoError(blk.Verify())
+	assert.NoError(blk.Accept())
+
+	currentValidator, err := vm.internalState.CurrentStakerChainState().GetValidator(currentNodeID)
+	assert.NoError(err)
+	assert.NotContains(currentValidator.SubnetValidators(), testSubnet1.ID())
+	pendingValidator := vm.internalState.PendingStakerChainState().GetValidator(pendingNodeID)
+	assert.NotContains(pendingValidator.SubnetValidators(), testSubnet1.ID())
+
+	// The removals must have been persisted
+	assert.NoError(vm.internalState.(*internalStateImpl).loadCurrentValidators())
+	assert.NoError(vm.internalState.(*internalStateImpl).loadPendingValidators())
+	currentValidator, err = vm.internalState.CurrentStakerChainState().GetValidator(currentNodeID)
+	assert.NoError(err)
+	assert.NotContains(currentValidator.SubnetValidators(), testSubnet1.ID())
+	pendingValidator = vm.internalState.PendingStakerChainState().GetValidator(pendingNodeID)
+	assert.NotContains(pendingValidator.SubnetValidators(), testSubnet1.ID())
+	_, _, err = vm.internalState.CurrentStakerChainState().GetStaker(currentSubnetTx.ID())
+	assert.ErrorIs(err, database.ErrNotFound)
+
+	// Removing a node that isn't validating the subnet fails
+	removeAgainTx, err := vm.newRemoveSubnetValidatorTx(currentNodeID, testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	assert.NoError(err)
+	vs := newVersionedState(
+		vm.internalState,
+		vm.internalState.CurrentStakerChainState(),
+		vm.internalState.PendingStakerChainState(),
+	)
+	_, err = removeAgainTx.UnsignedTx.(UnsignedDecisionTx).Execute(vm, vs, removeAgainTx)
+	assert.ErrorIs(err, errNotSubnetValidator)
+}
+
+func TestRemoveSubnetValidatorTxUnauthorized(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+
+	controlKeys := []*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]}
+	nodeID := ids.NodeID(keys[0].PublicKey().Address())
+
+	subnetTx, err := vm.newAddSubnetValidatorTx(
+		defaultWeight,                           // weight
+		uint64(defaultValidateStartTime.Unix()), // start time
+		uint64(defaultValidateEndTime.Unix()),   // end time
+		nodeID,                                  // node ID
+		testSubnet1.ID(),                        // subnet ID
+		controlKeys,
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+	vm.internalState.AddCurrentStaker(subnetTx, 0)
+	vm.internalState.AddTx(subnetTx, status.Committed)
+	assert.NoError(vm.internalState.Commit())
+	assert.NoError(vm.internalState.(*internalStateImpl).loadCurrentValidators())
+
+	tx, err := vm.newRemoveSubnetValidatorTx(nodeID, testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	assert.NoError(err)
+
+	// Replace a subnet control signature with a signature from a key that
+	// doesn't control the subnet
+	sig, err := keys[3].SignHash(hashing.ComputeHash256(tx.UnsignedBytes()))
+	assert.NoError(err)
+	subnetCred := tx.Creds[len(tx.Creds)-1].(*secp256k1fx.Credential)
+	copy(subnetCred.Sigs[0][:], sig)
+
+	vs := newVersionedState(
+		vm.internalState,
+		vm.internalState.CurrentStakerChainState(),
+		vm.internalState.PendingStakerChainState(),
+	)
+	_, err = tx.UnsignedTx.(UnsignedDecisionTx).Execute(vm, vs, tx)
+	assert.Error(err)
+
+	// The validator must not have been removed
+	currentValidator, err := vm.internalState.CurrentStakerChainState().GetValidator(nodeID)
+	assert.NoError(err)
+	assert.Contains(currentValidator.SubnetValidators(), testSubnet1.ID())
+}
+
+func TestRemoveSubnetValidatorTxBeforeApricotPhase6(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+	vm.ApricotPhase6Time = defaultGenesisTime.Add(time.Hour)
+
+	controlKeys := []*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]}
+	nodeID := ids.NodeID(keys[0].PublicKey().Address())
+
+	subnetTx, err := vm.newAddSubnetValidatorTx(
+		defaultWeight,                           // weight
+		uint64(defaultValidateStartTime.Unix()), // start time
+		uint64(defaultValidateEndTime.Unix()),   // end time
+		nodeID,                                  // node ID
+		testSubnet1.ID(),                        // subnet ID
+		controlKeys,
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+	vm.internalState.AddCurrentStaker(subnetTx, 0)
+	vm.internalState.AddTx(subnetTx, status.Committed)
+	assert.NoError(vm.internalState.Commit())
+	assert.NoError(vm.internalState.(*internalStateImpl).loadCurrentValidators())
+
+	tx, err := vm.newRemoveSubnetValidatorTx(nodeID, testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	assert.NoError(err)
+
+	// The tx is rejected by the mempool
+	assert.ErrorIs(vm.blockBuilder.AddUnverifiedTx(tx), errApricotPhase6NotActivated)
+	assert.False(vm.blockBuilder.Has(tx.ID()))
+
+	// The tx is rejected in a block
+	vs := newVersionedState(
+		vm.internalState,
+		vm.internalState.CurrentStakerChainState(),
+		vm.internalState.PendingStakerChainState(),
+	)
+	_, err = tx.UnsignedTx.(UnsignedDecisionTx).Execute(vm, vs, tx)
+	assert.ErrorIs(err, errApricotPhase6NotActivated)
+
+	// The tx is accepted once the upgrade activates
+	vs.SetTimestamp(vm.ApricotPhase6Time)
+	_, err = tx.UnsignedTx.(UnsignedDecisionTx).Execute(vm, vs, tx)
+	assert.NoError(err)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/keystore"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -66,6 +67,8 @@ var (
 	errMissingVMID                = errors.New("argument 'vmID' not given")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
 	errMissingPrivateKey          = errors.New("argument 'privateKey' not given")
+	errHeightTooHigh              = errors.New("requested height is greater than the last accepted height")
+	errTxNotInMempool             = errors.New("tx isn't in the mempool")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -167,6 +170,9 @@ type GetBalanceRequest struct {
 	// TODO: remove Address
 	Address   *string  `json:"address,omitempty"`
 	Addresses []string `json:"addresses"`
+	// If provided, the balance once the block at [Height] was accepted is
+	// returned
+	Height *json.Uint64 `json:"height,omitempty"`
 }
 
 type GetBalanceResponse struct {
@@ -192,13 +198,34 @@ func (service *Service) GetBalance(_ *http.Request, args *GetBalanceRequest, res
 		return err
 	}
 
-	utxos, err := avax.GetAllUTXOs(service.vm.internalState, addrs)
-	if err != nil {
-		return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
+	var (
+		utxos       []*avax.UTXO
+		currentTime uint64
+	)
+	if args.Height == nil {
+		utxos, err = avax.GetAllUTXOs(service.vm.internalState, addrs)
+		if err != nil {
+			return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
+		}
+		currentTime = service.vm.clock.Unix()
+	} else {
+		height := uint64(*args.Height)
+		if err := service.checkHeight(height); err != nil {
+			return err
+		}
+		utxos, err = service.vm.internalState.GetUTXOsAt(height, addrs)
+		if err != nil {
+			return fmt.Errorf("couldn't get UTXO set of %v at height %d: %w", args.Addresses, height, err)
+		}
+		// Locks are evaluated against the chain time at [height] rather than
+		// the local clock.
+		timestamp, err := service.vm.internalState.GetTimestampAt(height)
+		if err != nil {
+			return fmt.Errorf("couldn't get timestamp at height %d: %w", height, err)
+		}
+		currentTime = uint64(timestamp.Unix())
 	}
 
-	currentTime := service.vm.clock.Unix()
-
 	unlocked := uint64(0)
 	lockedStakeable := uint64(0)
 	lockedNotStakeable := uint64(0)
@@ -440,6 +467,9 @@ type GetSubnetsArgs struct {
 	// IDs of the subnets to retrieve information about
 	// If omitted, gets all subnets
 	IDs []ids.ID `json:"ids"`
+	// If provided, only the subnets that existed once the block at [Height]
+	// was accepted are returned, with the control keys they had then.
+	Height *json.Uint64 `json:"height,omitempty"`
 }
 
 // GetSubnetsResponse is the response from calling GetSubnets
@@ -456,15 +486,21 @@ func (service *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, respon
 
 	getAll := len(args.IDs) == 0
 	if getAll {
-		subnets, err := service.vm.internalState.GetSubnets() // all subnets
+		subnets, err := service.getSubnets(args.Height) // all subnets
 		if err != nil {
 			return fmt.Errorf("error getting subnets from database: %w", err)
 		}
 
 		response.Subnets = make([]APISubnet, len(subnets)+1)
 		for i, subnet := range subnets {
-			unsignedTx := subnet.UnsignedTx.(*UnsignedCreateSubnetTx)
-			owner := unsignedTx.Owner.(*secp256k1fx.OutputOwners)
+			subnetOwner, err := service.getSubnetOwner(args.Height, subnet.ID())
+			if err != nil {
+				return fmt.Errorf("error getting the owner of subnet %s: %w", subnet.ID(), err)
+			}
+			owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
+			if !ok {
+				return errUnknownOwners
+			}
 			controlAddrs := []string{}
 			for _, controlKeyID := range owner.Addrs {
 				addr, err := service.vm.FormatLocalAddress(controlKeyID)
@@ -488,6 +524,19 @@ func (service *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, respon
 		return nil
 	}
 
+	// If a height was provided, subnets created after it are skipped
+	var existingSubnets ids.Set
+	if args.Height != nil {
+		subnets, err := service.getSubnets(args.Height)
+		if err != nil {
+			return fmt.Errorf("error getting subnets from database: %w", err)
+		}
+		existingSubnets = ids.NewSet(len(subnets))
+		for _, subnet := range subnets {
+			existingSubnets.Add(subnet.ID())
+		}
+	}
+
 	subnetSet := ids.NewSet(len(args.IDs))
 	for _, subnetID := range args.IDs {
 		if subnetSet.Contains(subnetID) {
@@ -506,6 +555,10 @@ func (service *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, respon
 			continue
 		}
 
+		if args.Height != nil && !existingSubnets.Contains(subnetID) {
+			continue
+		}
+
 		subnetTx, _, err := service.vm.internalState.GetTx(subnetID)
 		if err == database.ErrNotFound {
 			continue
@@ -514,11 +567,14 @@ func (service *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, respon
 			return err
 		}
 
-		subnet, ok := subnetTx.UnsignedTx.(*UnsignedCreateSubnetTx)
-		if !ok {
+		if _, ok := subnetTx.UnsignedTx.(*UnsignedCreateSubnetTx); !ok {
 			return errWrongTxType
 		}
-		owner, ok := subnet.Owner.(*secp256k1fx.OutputOwners)
+		subnetOwner, err := service.getSubnetOwner(args.Height, subnetID)
+		if err != nil {
+			return fmt.Errorf("error getting the owner of subnet %s: %w", subnetID, err)
+		}
+		owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
 		if !ok {
 			return errUnknownOwners
 		}
@@ -534,7 +590,7 @@ func (service *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, respon
 
 		response.Subnets = append(response.Subnets,
 			APISubnet{
-				ID:          subnet.ID(),
+				ID:          subnetID,
 				ControlKeys: controlAddrs,
 				Threshold:   json.Uint32(owner.Threshold),
 			},
@@ -848,16 +904,36 @@ func (service *Service) GetPendingValidators(_ *http.Request, args *GetPendingVa
 	return nil
 }
 
+// GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
+type GetCurrentSupplyArgs struct {
+	// If provided, the supply once the block at [Height] was accepted is
+	// returned
+	Height *json.Uint64 `json:"height,omitempty"`
+}
+
 // GetCurrentSupplyReply are the results from calling GetCurrentSupply
 type GetCurrentSupplyReply struct {
 	Supply json.Uint64 `json:"supply"`
 }
 
 // GetCurrentSupply returns an upper bound on the supply of AVAX in the system
-func (service *Service) GetCurrentSupply(_ *http.Request, _ *struct{}, reply *GetCurrentSupplyReply) error {
+func (service *Service) GetCurrentSupply(_ *http.Request, args *GetCurrentSupplyArgs, reply *GetCurrentSupplyReply) error {
 	service.vm.ctx.Log.Debug("Platform: GetCurrentSupply called")
 
-	reply.Supply = json.Uint64(service.vm.internalState.GetCurrentSupply())
+	if args.Height == nil {
+		reply.Supply = json.Uint64(service.vm.internalState.GetCurrentSupply())
+		return nil
+	}
+
+	height := uint64(*args.Height)
+	if err := service.checkHeight(height); err != nil {
+		return err
+	}
+	supply, err := service.vm.internalState.GetCurrentSupplyAt(height)
+	if err != nil {
+		return fmt.Errorf("couldn't get supply at height %d: %w", height, err)
+	}
+	reply.Supply = json.Uint64(supply)
 	return nil
 }
 
@@ -1211,6 +1287,86 @@ func (service *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValid
 	return errs.Err
 }
 
+// RemoveSubnetValidatorArgs are the arguments to RemoveSubnetValidator
+type RemoveSubnetValidatorArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	// ID of the node to remove from the subnet
+	NodeID ids.NodeID `json:"nodeID"`
+	// ID of the subnet to remove the node from
+	SubnetID string `json:"subnetID"`
+}
+
+// RemoveSubnetValidator creates and signs and issues a transaction to remove a
+// current or pending validator of a subnet other than the primary network
+func (service *Service) RemoveSubnetValidator(_ *http.Request, args *RemoveSubnetValidatorArgs, response *api.JSONTxIDChangeAddr) error {
+	service.vm.ctx.Log.Debug("Platform: RemoveSubnetValidator called")
+
+	if args.SubnetID == "" {
+		return errNoSubnetID
+	}
+
+	// Parse the subnet ID
+	subnetID, err := ids.FromString(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("problem parsing subnetID %q: %w", args.SubnetID, err)
+	}
+	if subnetID == constants.PrimaryNetworkID {
+		return errNamedSubnetCantBePrimary
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := avax.ParseServiceAddresses(service.vm, args.From)
+	if err != nil {
+		return err
+	}
+
+	user, err := keystore.NewUserFromKeystore(service.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	keys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address.
+	if len(keys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := keys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = avax.ParseServiceAddress(service.vm, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// Create the transaction
+	tx, err := service.vm.newRemoveSubnetValidatorTx(
+		args.NodeID, // Node ID
+		subnetID,    // Subnet ID
+		keys.Keys,   // Keys
+		changeAddr,  // Change address
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = service.vm.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		service.vm.blockBuilder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
 // CreateSubnetArgs are the arguments to CreateSubnet
 type CreateSubnetArgs struct {
 	// User, password, from addrs, change addr
@@ -1283,6 +1439,85 @@ func (service *Service) CreateSubnet(_ *http.Request, args *CreateSubnetArgs, re
 	return errs.Err
 }
 
+// TransferSubnetOwnershipArgs are the arguments to TransferSubnetOwnership
+type TransferSubnetOwnershipArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	// ID of the subnet to transfer
+	SubnetID ids.ID `json:"subnetID"`
+	// The new owner of the subnet. The ID member of APISubnet is ignored
+	APISubnet
+}
+
+// TransferSubnetOwnership creates and signs and issues a transaction to replace
+// the owner of a subnet. The transaction must be signed by the subnet's
+// current owner.
+func (service *Service) TransferSubnetOwnership(_ *http.Request, args *TransferSubnetOwnershipArgs, response *api.JSONTxIDChangeAddr) error {
+	service.vm.ctx.Log.Debug("Platform: TransferSubnetOwnership called")
+
+	if args.SubnetID == constants.PrimaryNetworkID {
+		return errNamedSubnetCantBePrimary
+	}
+
+	// Parse the control keys
+	controlKeys, err := avax.ParseServiceAddresses(service.vm, args.ControlKeys)
+	if err != nil {
+		return err
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := avax.ParseServiceAddresses(service.vm, args.From)
+	if err != nil {
+		return err
+	}
+
+	user, err := keystore.NewUserFromKeystore(service.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	privKeys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address.
+	if len(privKeys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := privKeys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = avax.ParseServiceAddress(service.vm, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// Create the transaction
+	tx, err := service.vm.newTransferSubnetOwnershipTx(
+		args.SubnetID,          // Subnet ID
+		uint32(args.Threshold), // Threshold
+		controlKeys.List(),     // Control Addresses
+		privKeys.Keys,          // Private keys
+		changeAddr,             // Change address
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = service.vm.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		service.vm.blockBuilder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
 // ExportAVAXArgs are the arguments to ExportAVAX
 type ExportAVAXArgs struct {
 	// User, password, from addrs, change addr
@@ -1770,6 +2005,13 @@ type APIBlockchain struct {
 	VMID ids.ID `json:"vmID"`
 }
 
+// GetBlockchainsArgs are the arguments for calling GetBlockchains
+type GetBlockchainsArgs struct {
+	// If provided, only the blockchains that existed once the block at
+	// [Height] was accepted are returned
+	Height *json.Uint64 `json:"height,omitempty"`
+}
+
 // GetBlockchainsResponse is the response from a call to GetBlockchains
 type GetBlockchainsResponse struct {
 	// blockchains that exist
@@ -1777,10 +2019,10 @@ type GetBlockchainsResponse struct {
 }
 
 // GetBlockchains returns all of the blockchains that exist
-func (service *Service) GetBlockchains(_ *http.Request, args *struct{}, response *GetBlockchainsResponse) error {
+func (service *Service) GetBlockchains(_ *http.Request, args *GetBlockchainsArgs, response *GetBlockchainsResponse) error {
 	service.vm.ctx.Log.Debug("Platform: GetBlockchains called")
 
-	subnets, err := service.vm.internalState.GetSubnets()
+	subnets, err := service.getSubnets(args.Height)
 	if err != nil {
 		return fmt.Errorf("couldn't retrieve subnets: %w", err)
 	}
@@ -1788,7 +2030,7 @@ func (service *Service) GetBlockchains(_ *http.Request, args *struct{}, response
 	response.Blockchains = []APIBlockchain{}
 	for _, subnet := range subnets {
 		subnetID := subnet.ID()
-		chains, err := service.vm.internalState.GetChains(subnetID)
+		chains, err := service.getChains(args.Height, subnetID)
 		if err != nil {
 			return fmt.Errorf(
 				"couldn't retrieve chains for subnet %q: %w",
@@ -1811,7 +2053,7 @@ func (service *Service) GetBlockchains(_ *http.Request, args *struct{}, response
 		}
 	}
 
-	chains, err := service.vm.internalState.GetChains(constants.PrimaryNetworkID)
+	chains, err := service.getChains(args.Height, constants.PrimaryNetworkID)
 	if err != nil {
 		return fmt.Errorf("couldn't retrieve subnets: %w", err)
 	}
@@ -1851,6 +2093,180 @@ func (service *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response
 	return nil
 }
 
+// SimulateTxReply is the response from calling SimulateTx
+type SimulateTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// True iff the tx passed verification against the preferred state
+	Valid bool `json:"valid"`
+	// The reason the tx failed verification, if it did
+	Error string `json:"error,omitempty"`
+	// UTXOs consumed by the tx, including imported UTXOs. UTXOs that don't
+	// exist are omitted.
+	ConsumedUTXOs []string `json:"consumedUTXOs"`
+	// UTXOs produced on this chain once the tx is accepted
+	ProducedUTXOs []string `json:"producedUTXOs"`
+	// Outputs locked by the tx for staking
+	StakedOutputs []string `json:"stakedOutputs"`
+	// Outputs exported by the tx to another chain
+	ExportedOutputs []string `json:"exportedOutputs"`
+	// Amount of each asset consumed by the tx but not produced
+	Burned map[ids.ID]json.Uint64 `json:"burned"`
+	// Encoding of the UTXOs and outputs
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// SimulateTx verifies the tx against the preferred state without issuing it.
+// The tx isn't added to the mempool or gossiped.
+func (service *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, response *SimulateTxReply) error {
+	service.vm.ctx.Log.Debug("Platform: SimulateTx called")
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx := &Tx{}
+	if _, err := Codec.Unmarshal(txBytes, tx); err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	if err := tx.Sign(Codec, nil); err != nil {
+		return fmt.Errorf("couldn't initialize tx: %w", err)
+	}
+
+	preferred, err := service.vm.Preferred()
+	if err != nil {
+		return fmt.Errorf("couldn't get preferred block: %w", err)
+	}
+	preferredDecision, ok := preferred.(decision)
+	if !ok {
+		// The preferred block should always be a decision block
+		return errInvalidBlockType
+	}
+	preferredState := preferredDecision.onAccept()
+
+	response.TxID = tx.ID()
+	response.Encoding = args.Encoding
+
+	funds, _ := getTxFunds(tx.UnsignedTx)
+	var (
+		txID            = tx.ID()
+		consumedUTXOs   []*avax.UTXO
+		producedUTXOs   []*avax.UTXO
+		consumedAmounts = make(map[ids.ID]uint64)
+		producedAmounts = make(map[ids.ID]uint64)
+	)
+
+	for _, in := range funds.ins {
+		utxo, err := preferredState.GetUTXO(in.InputID())
+		if err == nil {
+			consumedUTXOs = append(consumedUTXOs, utxo)
+		}
+	}
+	if len(funds.importedIns) > 0 {
+		utxoIDs := make([][]byte, len(funds.importedIns))
+		for i, in := range funds.importedIns {
+			utxoID := in.InputID()
+			utxoIDs[i] = utxoID[:]
+		}
+		// If the imported UTXOs can't be fetched, the verification error will
+		// report it.
+		if allUTXOBytes, err := service.vm.ctx.SharedMemory.Get(funds.sourceChain, utxoIDs); err == nil {
+			for _, utxoBytes := range allUTXOBytes {
+				utxo := &avax.UTXO{}
+				if _, err := Codec.Unmarshal(utxoBytes, utxo); err != nil {
+					return fmt.Errorf("couldn't parse imported UTXO: %w", err)
+				}
+				consumedUTXOs = append(consumedUTXOs, utxo)
+			}
+		}
+	}
+	for index, out := range funds.outs {
+		producedUTXOs = append(producedUTXOs, &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        txID,
+				OutputIndex: uint32(index),
+			},
+			Asset: avax.Asset{ID: out.AssetID()},
+			Out:   out.Output(),
+		})
+	}
+
+	for _, insList := range [][]*avax.TransferableInput{funds.ins, funds.importedIns} {
+		for _, in := range insList {
+			assetID := in.AssetID()
+			newAmount, err := math.Add64(consumedAmounts[assetID], in.Input().Amount())
+			if err != nil {
+				return fmt.Errorf("couldn't calculate consumed amount: %w", err)
+			}
+			consumedAmounts[assetID] = newAmount
+		}
+	}
+	for _, outsList := range [][]*avax.TransferableOutput{funds.outs, funds.stakedOuts, funds.exportedOuts} {
+		for _, out := range outsList {
+			assetID := out.AssetID()
+			newAmount, err := math.Add64(producedAmounts[assetID], out.Output().Amount())
+			if err != nil {
+				return fmt.Errorf("couldn't calculate produced amount: %w", err)
+			}
+			producedAmounts[assetID] = newAmount
+		}
+	}
+	response.Burned = make(map[ids.ID]json.Uint64, len(consumedAmounts))
+	for assetID, consumed := range consumedAmounts {
+		// If more is produced than consumed, the tx is invalid and there is
+		// nothing burned.
+		if produced := producedAmounts[assetID]; consumed > produced {
+			response.Burned[assetID] = json.Uint64(consumed - produced)
+		}
+	}
+
+	response.ConsumedUTXOs = make([]string, len(consumedUTXOs))
+	for i, utxo := range consumedUTXOs {
+		if response.ConsumedUTXOs[i], err = encode(args.Encoding, utxo); err != nil {
+			return err
+		}
+	}
+	response.ProducedUTXOs = make([]string, len(producedUTXOs))
+	for i, utxo := range producedUTXOs {
+		if response.ProducedUTXOs[i], err = encode(args.Encoding, utxo); err != nil {
+			return err
+		}
+	}
+	response.StakedOutputs = make([]string, len(funds.stakedOuts))
+	for i, out := range funds.stakedOuts {
+		if response.StakedOutputs[i], err = encode(args.Encoding, out); err != nil {
+			return err
+		}
+	}
+	response.ExportedOutputs = make([]string, len(funds.exportedOuts))
+	for i, out := range funds.exportedOuts {
+		if response.ExportedOutputs[i], err = encode(args.Encoding, out); err != nil {
+			return err
+		}
+	}
+
+	// SemanticVerify runs the syntactic verification of the tx before
+	// verifying it against [preferredState]. [preferredState] isn't modified.
+	if err := tx.UnsignedTx.SemanticVerify(service.vm, preferredState, tx); err != nil {
+		response.Error = err.Error()
+		return nil
+	}
+	response.Valid = true
+	return nil
+}
+
+// encode marshals [value] and encodes it with [encoding]
+func encode(encoding formatting.Encoding, value interface{}) (string, error) {
+	bytes, err := Codec.Marshal(CodecVersion, value)
+	if err != nil {
+		return "", fmt.Errorf("couldn't serialize %T: %w", value, err)
+	}
+	str, err := formatting.EncodeWithChecksum(encoding, bytes)
+	if err != nil {
+		return "", fmt.Errorf("couldn't encode %T as string: %w", value, err)
+	}
+	return str, nil
+}
+
 // GetTx gets a tx
 func (service *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
 	service.vm.ctx.Log.Debug("Platform: GetTx called")
@@ -1967,9 +2383,105 @@ func (service *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, resp
 	return nil
 }
 
+// APIMempoolTx is a tx in the mempool
+type APIMempoolTx struct {
+	TxID ids.ID `json:"txID"`
+	// Size of the tx, in bytes
+	Size json.Uint64 `json:"size"`
+	// Number of seconds since the tx was added to the mempool
+	Age json.Uint64 `json:"age"`
+}
+
+// GetMempoolTxsReply is the response from calling GetMempoolTxs
+type GetMempoolTxsReply struct {
+	DecisionTxs []APIMempoolTx `json:"decisionTxs"`
+	ProposalTxs []APIMempoolTx `json:"proposalTxs"`
+}
+
+// GetMempoolTxs returns the txs in the mempool, oldest first
+func (service *Service) GetMempoolTxs(_ *http.Request, _ *struct{}, reply *GetMempoolTxsReply) error {
+	service.vm.ctx.Log.Debug("Platform: GetMempoolTxs called")
+
+	reply.DecisionTxs = service.getAPIMempoolTxs(service.vm.blockBuilder.DecisionTxs())
+	reply.ProposalTxs = service.getAPIMempoolTxs(service.vm.blockBuilder.ProposalTxs())
+	return nil
+}
+
+func (service *Service) getAPIMempoolTxs(txs []*Tx) []APIMempoolTx {
+	now := service.vm.clock.Time()
+	apiTxs := make([]APIMempoolTx, len(txs))
+	for i, tx := range txs {
+		txID := tx.ID()
+		apiTxs[i] = APIMempoolTx{
+			TxID: txID,
+			Size: json.Uint64(len(tx.Bytes())),
+		}
+		if addedTime, ok := service.vm.blockBuilder.AddedTime(txID); ok && now.After(addedTime) {
+			apiTxs[i].Age = json.Uint64(now.Sub(addedTime) / time.Second)
+		}
+	}
+	return apiTxs
+}
+
+// GetDroppedTxReasonReply is the response from calling GetDroppedTxReason
+type GetDroppedTxReasonReply struct {
+	// True iff the tx was recently dropped
+	Dropped bool `json:"dropped"`
+	// Reason the tx was dropped. Only non-empty if [Dropped] is true.
+	Reason string `json:"reason,omitempty"`
+}
+
+// GetDroppedTxReason returns the reason a tx was recently dropped
+func (service *Service) GetDroppedTxReason(_ *http.Request, args *api.JSONTxID, reply *GetDroppedTxReasonReply) error {
+	service.vm.ctx.Log.Debug("Platform: GetDroppedTxReason called with txID: %s", args.TxID)
+
+	reason, dropped := service.vm.droppedTxCache.Get(args.TxID)
+	if !dropped {
+		return nil
+	}
+	reasonStr, ok := reason.(string)
+	if !ok {
+		return errCorruptedReason
+	}
+
+	reply.Dropped = true
+	reply.Reason = reasonStr
+	return nil
+}
+
+// EvictMempoolTxArgs are the arguments for calling EvictMempoolTx
+type EvictMempoolTxArgs struct {
+	TxID ids.ID `json:"txID"`
+	// Reason recorded as the reason the tx was dropped
+	Reason string `json:"reason"`
+}
+
+// EvictMempoolTx removes a tx from the mempool and marks it as dropped. The tx
+// isn't removed from the mempools of other nodes.
+func (service *Service) EvictMempoolTx(_ *http.Request, args *EvictMempoolTxArgs, reply *api.SuccessResponse) error {
+	service.vm.ctx.Log.Info("Platform: EvictMempoolTx called with txID: %s", args.TxID)
+
+	if tx := service.vm.blockBuilder.Remove(args.TxID); tx == nil {
+		return fmt.Errorf("%w: %s", errTxNotInMempool, args.TxID)
+	}
+
+	reason := "evicted through the API"
+	if args.Reason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, args.Reason)
+	}
+	service.vm.droppedTxCache.Put(args.TxID, reason)
+	// Don't request the tx again if it's gossiped to this node
+	service.vm.blockBuilder.MarkDropped(args.TxID)
+	reply.Success = true
+	return nil
+}
+
 type GetStakeArgs struct {
 	api.JSONAddresses
 	Encoding formatting.Encoding `json:"encoding"`
+	// If provided, the stake once the block at [Height] was accepted is
+	// returned
+	Height *json.Uint64 `json:"height,omitempty"`
 }
 
 // GetStakeReply is the response from calling GetStake.
@@ -2065,27 +2577,28 @@ func (service *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *
 		return err
 	}
 
-	currentStakers := service.vm.internalState.CurrentStakerChainState()
-	stakers := currentStakers.Stakers()
-
-	var (
-		totalStake uint64
-		stakedOuts = make([]avax.TransferableOutput, 0, len(stakers))
-	)
-	for _, tx := range stakers { // Iterates over current stakers
-		stakedAmt, outs, err := service.getStakeHelper(tx, addrs)
-		if err != nil {
+	var stakers []*Tx
+	if args.Height == nil {
+		currentStakers := service.vm.internalState.CurrentStakerChainState()
+		pendingStakers := service.vm.internalState.PendingStakerChainState()
+		stakers = append(stakers, currentStakers.Stakers()...)
+		stakers = append(stakers, pendingStakers.Stakers()...)
+	} else {
+		height := uint64(*args.Height)
+		if err := service.checkHeight(height); err != nil {
 			return err
 		}
-		totalStake, err = math.Add64(totalStake, stakedAmt)
+		stakers, err = service.vm.internalState.GetStakersAt(height)
 		if err != nil {
-			return err
+			return fmt.Errorf("couldn't get stakers at height %d: %w", height, err)
 		}
-		stakedOuts = append(stakedOuts, outs...)
 	}
 
-	pendingStakers := service.vm.internalState.PendingStakerChainState()
-	for _, tx := range pendingStakers.Stakers() { // Iterates over pending stakers
+	var (
+		totalStake uint64
+		stakedOuts = make([]avax.TransferableOutput, 0, len(stakers))
+	)
+	for _, tx := range stakers { // Iterates over current and pending stakers
 		stakedAmt, outs, err := service.getStakeHelper(tx, addrs)
 		if err != nil {
 			return err
@@ -2288,3 +2801,45 @@ func (service *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, respon
 
 	return nil
 }
+
+// checkHeight returns an error if the state can't be queried at [height].
+func (service *Service) checkHeight(height uint64) error {
+	lastAcceptedHeight, err := service.vm.GetCurrentHeight()
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted height: %w", err)
+	}
+	if height > lastAcceptedHeight {
+		return fmt.Errorf("%w: %d > %d", errHeightTooHigh, height, lastAcceptedHeight)
+	}
+	return nil
+}
+
+// getSubnets returns the subnets that existed once the block at [height] was
+// accepted. If [height] is nil, the current subnets are returned.
+func (service *Service) getSubnets(height *json.Uint64) ([]*Tx, error) {
+	if height == nil {
+		return service.vm.internalState.GetSubnets()
+	}
+	if err := service.checkHeight(uint64(*height)); err != nil {
+		return nil, err
+	}
+	return service.vm.internalState.GetSubnetsAt(uint64(*height))
+}
+
+// getSubnetOwner returns the owner of [subnetID] once the block at [height]
+// was accepted. If [height] is nil, the current owner is returned.
+func (service *Service) getSubnetOwner(height *json.Uint64, subnetID ids.ID) (fx.Owner, error) {
+	if height == nil {
+		return service.vm.internalState.GetSubnetOwner(subnetID)
+	}
+	return service.vm.internalState.GetSubnetOwnerAt(uint64(*height), subnetID)
+}
+
+// getChains returns the chains of [subnetID] that existed once the block at
+// [height] was accepted. If [height] is nil, the current chains are returned.
+func (service *Service) getChains(height *json.Uint64, subnetID ids.ID) ([]*Tx, error) {
+	if height == nil {
+		return service.vm.internalState.GetChains(subnetID)
+	}
+	return service.vm.internalState.GetChainsAt(uint64(*height), subnetID)
+}
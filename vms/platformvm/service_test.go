@@ -13,6 +13,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ava-labs/avalanchego/api"
@@ -466,10 +468,10 @@ func TestGetStake(t *testing.T) {
 		addr := fmt.Sprintf("P-%s", validator.RewardOwner.Addresses[0])
 		addrsStrs = append(addrsStrs, addr)
 		args := GetStakeArgs{
-			api.JSONAddresses{
+			JSONAddresses: api.JSONAddresses{
 				Addresses: []string{addr},
 			},
-			formatting.Hex,
+			Encoding: formatting.Hex,
 		}
 		response := GetStakeReply{}
 		err := service.GetStake(nil, &args, &response)
@@ -493,10 +495,10 @@ func TestGetStake(t *testing.T) {
 
 	// Make sure this works for multiple addresses
 	args := GetStakeArgs{
-		api.JSONAddresses{
+		JSONAddresses: api.JSONAddresses{
 			Addresses: addrsStrs,
 		},
-		formatting.Hex,
+		Encoding: formatting.Hex,
 	}
 	response := GetStakeReply{}
 	err := service.GetStake(nil, &args, &response)
@@ -813,3 +815,336 @@ func TestGetBlock(t *testing.T) {
 		})
 	}
 }
+
+// Test that the state is queryable at past heights
+func TestGetStateAtHeight(t *testing.T) {
+	assert := assert.New(t)
+	service := defaultService(t)
+	defaultAddress(t, service)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		err := service.vm.Shutdown()
+		assert.NoError(err)
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	// The state history isn't recorded by default
+	is := service.vm.internalState
+	_, err := is.GetCurrentSupplyAt(0)
+	assert.ErrorIs(err, errStateHistoryDisabled)
+
+	// Reloading the state with a retention window starts the history at the
+	// last accepted height
+	startHeight, err := service.vm.GetCurrentHeight()
+	assert.NoError(err)
+	service.vm.StateHistoryRetention = 2
+	is, err = NewMeteredInternalState(
+		service.vm,
+		service.vm.dbManager.Current().Database,
+		nil,
+		prometheus.NewRegistry(),
+	)
+	assert.NoError(err)
+	service.vm.internalState = is
+	assert.Equal(startHeight, is.GetHistoryStartHeight())
+
+	addr := keys[0].PublicKey().Address()
+	addrs := ids.ShortSet{}
+	addrs.Add(addr)
+	initialUTXOs, err := avax.GetAllUTXOs(is, addrs)
+	assert.NoError(err)
+	assert.NotEmpty(initialUTXOs)
+	initialSupply := is.GetCurrentSupply()
+	initialTimestamp := is.GetTimestamp()
+
+	createSubnetTx, err := service.vm.newCreateSubnetTx(
+		1,
+		[]ids.ShortID{addr},
+		[]*crypto.PrivateKeySECP256K1R{keys[0]},
+		addr,
+	)
+	assert.NoError(err)
+	delegatorTx, err := service.vm.newAddDelegatorTx(
+		service.vm.MinDelegatorStake,
+		uint64(defaultGenesisTime.Add(time.Second).Unix()),
+		uint64(defaultGenesisTime.Add(defaultMinStakingDuration).Unix()),
+		ids.NodeID(addr),
+		addr,
+		[]*crypto.PrivateKeySECP256K1R{keys[1]},
+		keys[1].PublicKey().Address(),
+	)
+	assert.NoError(err)
+
+	// Modify the state at the next height
+	newUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr},
+			},
+		},
+	}
+	is.SetHeight(startHeight + 1)
+	is.DeleteUTXO(initialUTXOs[0].InputID())
+	is.AddUTXO(newUTXO)
+	is.SetCurrentSupply(initialSupply + 1)
+	is.SetTimestamp(initialTimestamp.Add(time.Second))
+	is.AddSubnet(createSubnetTx)
+	is.AddTx(createSubnetTx, status.Committed)
+	is.AddPendingStaker(delegatorTx)
+	is.AddTx(delegatorTx, status.Committed)
+	assert.NoError(is.Commit())
+	assert.NoError(is.(*internalStateImpl).loadPendingValidators())
+
+	utxoIDs := func(utxos []*avax.UTXO) []ids.ID {
+		utxoIDs := make([]ids.ID, len(utxos))
+		for i, utxo := range utxos {
+			utxoIDs[i] = utxo.InputID()
+		}
+		return utxoIDs
+	}
+	utxos, err := is.GetUTXOsAt(startHeight, addrs)
+	assert.NoError(err)
+	assert.ElementsMatch(utxoIDs(initialUTXOs), utxoIDs(utxos))
+	utxos, err = is.GetUTXOsAt(startHeight+1, addrs)
+	assert.NoError(err)
+	assert.Len(utxos, len(initialUTXOs))
+	assert.Contains(utxoIDs(utxos), newUTXO.InputID())
+	assert.NotContains(utxoIDs(utxos), initialUTXOs[0].InputID())
+
+	supply, err := is.GetCurrentSupplyAt(startHeight)
+	assert.NoError(err)
+	assert.Equal(initialSupply, supply)
+	supply, err = is.GetCurrentSupplyAt(startHeight + 1)
+	assert.NoError(err)
+	assert.Equal(initialSupply+1, supply)
+
+	timestamp, err := is.GetTimestampAt(startHeight)
+	assert.NoError(err)
+	assert.True(initialTimestamp.Equal(timestamp))
+
+	subnets, err := is.GetSubnetsAt(startHeight)
+	assert.NoError(err)
+	assert.NotContains(subnets, createSubnetTx)
+	subnets, err = is.GetSubnetsAt(startHeight + 1)
+	assert.NoError(err)
+	assert.Contains(subnets, createSubnetTx)
+
+	stakers, err := is.GetStakersAt(startHeight)
+	assert.NoError(err)
+	for _, staker := range stakers {
+		assert.NotEqual(delegatorTx.ID(), staker.ID())
+	}
+	stakers, err = is.GetStakersAt(startHeight + 1)
+	assert.NoError(err)
+	assert.Len(stakers, len(is.CurrentStakerChainState().Stakers())+1)
+
+	// Only heights up to the last accepted block can be queried through the
+	// API
+	initialBalance := uint64(0)
+	for _, utxo := range initialUTXOs {
+		initialBalance += utxo.Out.(avax.Amounter).Amount()
+	}
+	formattedAddr, err := service.vm.FormatLocalAddress(addr)
+	assert.NoError(err)
+	height := cjson.Uint64(startHeight)
+	reply := GetBalanceResponse{}
+	err = service.GetBalance(nil, &GetBalanceRequest{
+		Addresses: []string{formattedAddr},
+		Height:    &height,
+	}, &reply)
+	assert.NoError(err)
+	assert.EqualValues(initialBalance, reply.Balance)
+
+	supplyReply := GetCurrentSupplyReply{}
+	err = service.GetCurrentSupply(nil, &GetCurrentSupplyArgs{Height: &height}, &supplyReply)
+	assert.NoError(err)
+	assert.EqualValues(initialSupply, supplyReply.Supply)
+
+	height = cjson.Uint64(startHeight + 1)
+	err = service.GetCurrentSupply(nil, &GetCurrentSupplyArgs{Height: &height}, &supplyReply)
+	assert.ErrorIs(err, errHeightTooHigh)
+
+	// Transfer the subnet at the height after that
+	subnetID := createSubnetTx.ID()
+	createdOwner := createSubnetTx.UnsignedTx.(*UnsignedCreateSubnetTx).Owner.(*secp256k1fx.OutputOwners)
+	newOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[1].PublicKey().Address()},
+	}
+	is.SetHeight(startHeight + 2)
+	is.SetSubnetOwner(subnetID, newOwner)
+	assert.NoError(is.Commit())
+
+	owner, err := is.GetSubnetOwnerAt(startHeight+1, subnetID)
+	assert.NoError(err)
+	assert.Equal(createdOwner.Addrs, owner.(*secp256k1fx.OutputOwners).Addrs)
+	owner, err = is.GetSubnetOwnerAt(startHeight+2, subnetID)
+	assert.NoError(err)
+	assert.Equal(newOwner.Addrs, owner.(*secp256k1fx.OutputOwners).Addrs)
+
+	// Committing at the height after that prunes the history below the
+	// modified height
+	is.SetHeight(startHeight + 3)
+	is.SetCurrentSupply(initialSupply + 2)
+	assert.NoError(is.Commit())
+	assert.Equal(startHeight+1, is.GetHistoryStartHeight())
+
+	_, err = is.GetCurrentSupplyAt(startHeight)
+	assert.ErrorIs(err, errHeightNotIndexed)
+	supply, err = is.GetCurrentSupplyAt(startHeight + 1)
+	assert.NoError(err)
+	assert.Equal(initialSupply+1, supply)
+	owner, err = is.GetSubnetOwnerAt(startHeight+1, subnetID)
+	assert.NoError(err)
+	assert.Equal(createdOwner.Addrs, owner.(*secp256k1fx.OutputOwners).Addrs)
+
+	// Disabling the state history deletes it
+	service.vm.StateHistoryRetention = 0
+	is, err = NewMeteredInternalState(
+		service.vm,
+		service.vm.dbManager.Current().Database,
+		nil,
+		prometheus.NewRegistry(),
+	)
+	assert.NoError(err)
+	service.vm.internalState = is
+	_, err = is.GetCurrentSupplyAt(startHeight + 1)
+	assert.ErrorIs(err, errStateHistoryDisabled)
+
+	historyIt := is.(*internalStateImpl).historyDB.NewIterator()
+	defer historyIt.Release()
+	assert.False(historyIt.Next())
+}
+
+func TestSimulateTx(t *testing.T) {
+	assert := assert.New(t)
+	service := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		err := service.vm.Shutdown()
+		assert.NoError(err)
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	tx, err := service.vm.newExportTx(
+		100,
+		service.vm.ctx.XChainID,
+		ids.GenerateTestShortID(),
+		[]*crypto.PrivateKeySECP256K1R{keys[0]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	assert.NoError(err)
+
+	txStr, err := formatting.EncodeWithChecksum(formatting.Hex, tx.Bytes())
+	assert.NoError(err)
+	reply := SimulateTxReply{}
+	err = service.SimulateTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply)
+	assert.NoError(err)
+	assert.True(reply.Valid)
+	assert.Empty(reply.Error)
+	assert.Equal(tx.ID(), reply.TxID)
+	assert.NotEmpty(reply.ConsumedUTXOs)
+	assert.Len(reply.ExportedOutputs, 1)
+	assert.Empty(reply.StakedOutputs)
+	assert.EqualValues(service.vm.TxFee, reply.Burned[service.vm.ctx.AVAXAssetID])
+
+	// Simulating the tx must not add it to the mempool
+	assert.False(service.vm.blockBuilder.Has(tx.ID()))
+
+	// A tx without credentials fails verification
+	tx.Creds = nil
+	assert.NoError(tx.Sign(Codec, nil))
+	txStr, err = formatting.EncodeWithChecksum(formatting.Hex, tx.Bytes())
+	assert.NoError(err)
+	reply = SimulateTxReply{}
+	err = service.SimulateTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply)
+	assert.NoError(err)
+	assert.False(reply.Valid)
+	assert.NotEmpty(reply.Error)
+	assert.False(service.vm.blockBuilder.Has(tx.ID()))
+}
+
+func TestMempoolInspectionAndEviction(t *testing.T) {
+	assert := assert.New(t)
+	service := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(service.vm.Shutdown())
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	decisionTx, err := service.vm.newCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		constants.AVMID,
+		nil,
+		"chain name",
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	assert.NoError(err)
+	proposalTx, err := service.vm.newAddValidatorTx(
+		service.vm.MinValidatorStake,
+		uint64(service.vm.clock.Time().Add(syncBound).Unix()),
+		uint64(service.vm.clock.Time().Add(syncBound).Add(defaultMinStakingDuration).Unix()),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestShortID(),
+		0,
+		[]*crypto.PrivateKeySECP256K1R{keys[1]},
+		keys[1].PublicKey().Address(), // change addr
+	)
+	assert.NoError(err)
+
+	assert.NoError(service.vm.blockBuilder.AddUnverifiedTx(decisionTx))
+	assert.NoError(service.vm.blockBuilder.AddUnverifiedTx(proposalTx))
+
+	service.vm.clock.Set(service.vm.clock.Time().Add(5 * time.Second))
+
+	mempoolReply := GetMempoolTxsReply{}
+	assert.NoError(service.GetMempoolTxs(nil, nil, &mempoolReply))
+	assert.Len(mempoolReply.DecisionTxs, 1)
+	assert.Len(mempoolReply.ProposalTxs, 1)
+	assert.Equal(decisionTx.ID(), mempoolReply.DecisionTxs[0].TxID)
+	assert.EqualValues(len(decisionTx.Bytes()), mempoolReply.DecisionTxs[0].Size)
+	assert.EqualValues(5, mempoolReply.DecisionTxs[0].Age)
+	assert.Equal(proposalTx.ID(), mempoolReply.ProposalTxs[0].TxID)
+
+	droppedReply := GetDroppedTxReasonReply{}
+	assert.NoError(service.GetDroppedTxReason(nil, &api.JSONTxID{TxID: decisionTx.ID()}, &droppedReply))
+	assert.False(droppedReply.Dropped)
+
+	evictReply := api.SuccessResponse{}
+	assert.NoError(service.EvictMempoolTx(nil, &EvictMempoolTxArgs{
+		TxID:   decisionTx.ID(),
+		Reason: "stuck",
+	}, &evictReply))
+	assert.True(evictReply.Success)
+	assert.False(service.vm.blockBuilder.Has(decisionTx.ID()))
+
+	// Evicting a tx that isn't in the mempool should fail
+	err = service.EvictMempoolTx(nil, &EvictMempoolTxArgs{TxID: decisionTx.ID()}, &evictReply)
+	assert.ErrorIs(err, errTxNotInMempool)
+
+	assert.NoError(service.GetDroppedTxReason(nil, &api.JSONTxID{TxID: decisionTx.ID()}, &droppedReply))
+	assert.True(droppedReply.Dropped)
+	assert.Contains(droppedReply.Reason, "stuck")
+
+	statusReply := GetTxStatusResponse{}
+	assert.NoError(service.GetTxStatus(nil, &GetTxStatusArgs{TxID: decisionTx.ID()}, &statusReply))
+	assert.Equal(status.Dropped, statusReply.Status)
+
+	mempoolReply = GetMempoolTxsReply{}
+	assert.NoError(service.GetMempoolTxs(nil, nil, &mempoolReply))
+	assert.Len(mempoolReply.DecisionTxs, 0)
+	assert.Len(mempoolReply.ProposalTxs, 1)
+}
@@ -295,13 +295,21 @@ func (vm *VM) authorize(
 			err,
 		)
 	}
-	subnet, ok := subnetTx.UnsignedTx.(*UnsignedCreateSubnetTx)
-	if !ok {
+	if _, ok := subnetTx.UnsignedTx.(*UnsignedCreateSubnetTx); !ok {
 		return nil, nil, errWrongTxType
 	}
 
+	subnetOwner, err := vs.GetSubnetOwner(subnetID)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to fetch the owner of subnet %s: %w",
+			subnetID,
+			err,
+		)
+	}
+
 	// Make sure the owners of the subnet match the provided keys
-	owner, ok := subnet.Owner.(*secp256k1fx.OutputOwners)
+	owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
 	if !ok {
 		return nil, nil, errUnknownOwners
 	}
@@ -10,14 +10,16 @@ import (
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 )
 
 var (
 	errConflictingBatchTxs = errors.New("block contains conflicting transactions")
 
-	_ Block    = &StandardBlock{}
-	_ decision = &StandardBlock{}
+	_ Block                    = &StandardBlock{}
+	_ decision                 = &StandardBlock{}
+	_ block.PreVerifiableBlock = &StandardBlock{}
 )
 
 // StandardBlock being accepted results in the transactions contained in the
@@ -61,6 +63,18 @@ func (sb *StandardBlock) conflicts(s ids.Set) (bool, error) {
 	return parent.conflicts(s)
 }
 
+// PreVerify syntactically verifies the transactions of this block and recovers
+// their signers, which doesn't depend on the state of its ancestors. The
+// results are cached, so Verify doesn't repeat them.
+func (sb *StandardBlock) PreVerify() error {
+	for _, tx := range sb.Txs {
+		if err := tx.preVerify(sb.vm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Verify this block performs a valid state transition.
 //
 // The parent block must be a proposal
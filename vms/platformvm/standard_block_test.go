@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/crypto"
+	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -97,3 +98,69 @@ func TestAtomicTxImports(t *testing.T) {
 	// Ensure standard block contains one atomic transaction
 	assert.Equal(b.(*StandardBlock).inputs.Len(), 1)
 }
+
+func TestStandardBlockPreVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+
+	// Charge a fee so that the tx is signed
+	vm.CreateAssetTxFee = defaultTxFee
+	tx, err := vm.newCreateSubnetTx(
+		1,
+		[]ids.ShortID{keys[0].PublicKey().Address()},
+		[]*crypto.PrivateKeySECP256K1R{keys[0]},
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	preferred, err := vm.Preferred()
+	assert.NoError(err)
+	blk, err := vm.newStandardBlock(preferred.ID(), preferred.Height()+1, []*Tx{tx})
+	assert.NoError(err)
+
+	// Pre-verifying a parsed block caches that its txs are syntactically valid
+	parsedBlkIntf, err := vm.ParseBlock(blk.Bytes())
+	assert.NoError(err)
+	parsedBlk, ok := parsedBlkIntf.(*StandardBlock)
+	assert.True(ok)
+	parsedTx, ok := parsedBlk.Txs[0].UnsignedTx.(*UnsignedCreateSubnetTx)
+	assert.True(ok)
+	assert.False(parsedTx.syntacticallyVerified)
+	assert.NoError(parsedBlk.PreVerify())
+	assert.True(parsedTx.syntacticallyVerified)
+
+	// Pre-verifying the block recovers the signers of its txs. Replacing the
+	// recovered signer with another key makes Verify fail, which shows that
+	// Verify uses the recovered signer rather than recovering it again.
+	recoveryCache := &vm.fx.(*secp256k1fx.Fx).SECPFactory.Cache
+	txHash := hashing.ComputeHash256(parsedTx.UnsignedBytes())
+	sig := parsedBlk.Txs[0].Creds[0].(*secp256k1fx.Credential).Sigs[0]
+	cacheKey := hashing.ComputeHash256Array(append(txHash, sig[:]...))
+	recoveredKey, recovered := recoveryCache.Get(cacheKey)
+	assert.True(recovered)
+	assert.Equal(keys[0].PublicKey().Address(), recoveredKey.(crypto.PublicKey).Address())
+
+	recoveryCache.Put(cacheKey, keys[1].PublicKey())
+	assert.Error(parsedBlk.Verify())
+	recoveryCache.Put(cacheKey, recoveredKey)
+	assert.NoError(parsedBlk.Verify())
+
+	// A block with a syntactically invalid tx fails pre-verification
+	invalidTx := &Tx{UnsignedTx: &UnsignedCreateSubnetTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    vm.ctx.NetworkID + 1,
+			BlockchainID: vm.ctx.ChainID,
+		}},
+		Owner: &secp256k1fx.OutputOwners{},
+	}}
+	assert.NoError(invalidTx.Sign(Codec, nil))
+	invalidBlk, err := vm.newStandardBlock(preferred.ID(), preferred.Height()+1, []*Tx{invalidTx})
+	assert.NoError(err)
+	assert.Error(invalidBlk.PreVerify())
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTransferSubnetOwnershipTxSyntacticVerify(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+
+	// Case: tx is nil
+	var unsignedTx *UnsignedTransferSubnetOwnershipTx
+	assert.ErrorIs(unsignedTx.SyntacticVerify(vm.ctx), errNilTx)
+
+	// Case: valid tx
+	tx, err := vm.newTransferSubnetOwnershipTx(
+		testSubnet1.ID(),
+		1,
+		[]ids.ShortID{keys[3].PublicKey().Address()},
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	// Case: transferring the primary network
+	utx := tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx)
+	utx.Subnet = constants.PrimaryNetworkID
+	// This tx was syntactically verified when it was created...pretend it wasn't so we don't use cache
+	utx.syntacticallyVerified = false
+	assert.ErrorIs(utx.SyntacticVerify(vm.ctx), errTransferPrimaryNetworkOwnership)
+
+	// Case: invalid new owner
+	utx.Subnet = testSubnet1.ID()
+	utx.syntacticallyVerified = false
+	utx.Owner = &secp256k1fx.OutputOwners{
+		Threshold: 2,
+		Addrs:     []ids.ShortID{keys[3].PublicKey().Address()},
+	}
+	assert.Error(utx.SyntacticVerify(vm.ctx))
+}
+
+func TestTransferSubnetOwnershipTxExecute(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+
+	oldOwnerKeys := []*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]}
+	newOwnerKey := keys[3]
+	newOwnerAddr := newOwnerKey.PublicKey().Address()
+
+	tx, err := vm.newTransferSubnetOwnershipTx(
+		testSubnet1.ID(),
+		1,
+		[]ids.ShortID{newOwnerAddr},
+		oldOwnerKeys,
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	preferred, err := vm.Preferred()
+	assert.NoError(err)
+	blk, err := vm.newStandardBlock(preferred.ID(), preferred.Height()+1, []*Tx{tx})
+	assert.NoError(err)
+	assert.NoError(blk.Verify())
+	assert.NoError(blk.Accept())
+
+	// The new owner must have been persisted
+	vm.internalState.(*internalStateImpl).subnetOwnerCache.Flush()
+	subnetOwner, err := vm.internalState.GetSubnetOwner(testSubnet1.ID())
+	assert.NoError(err)
+	owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
+	assert.True(ok)
+	assert.EqualValues(1, owner.Threshold)
+	assert.Equal([]ids.ShortID{newOwnerAddr}, owner.Addrs)
+
+	// getSubnets must report the new owner
+	service := &Service{vm: vm}
+	newOwnerAddrStr, err := vm.FormatLocalAddress(newOwnerAddr)
+	assert.NoError(err)
+	for _, args := range []*GetSubnetsArgs{{}, {IDs: []ids.ID{testSubnet1.ID()}}} {
+		response := GetSubnetsResponse{}
+		assert.NoError(service.GetSubnets(nil, args, &response))
+		found := false
+		for _, subnet := range response.Subnets {
+			if subnet.ID != testSubnet1.ID() {
+				continue
+			}
+			found = true
+			assert.Equal([]string{newOwnerAddrStr}, subnet.ControlKeys)
+			assert.EqualValues(1, subnet.Threshold)
+		}
+		assert.True(found)
+	}
+
+	// The old owner can no longer manage the subnet
+	_, err = vm.newAddSubnetValidatorTx(
+		defaultWeight,
+		uint64(defaultValidateStartTime.Unix()),
+		uint64(defaultValidateEndTime.Unix()),
+		ids.NodeID(keys[0].PublicKey().Address()),
+		testSubnet1.ID(),
+		oldOwnerKeys,
+		ids.ShortEmpty, // change addr
+	)
+	assert.ErrorIs(err, errCantSign)
+
+	// The new owner can manage the subnet
+	_, err = vm.newAddSubnetValidatorTx(
+		defaultWeight,
+		uint64(defaultValidateStartTime.Unix()),
+		uint64(defaultValidateEndTime.Unix()),
+		ids.NodeID(keys[0].PublicKey().Address()),
+		testSubnet1.ID(),
+		[]*crypto.PrivateKeySECP256K1R{keys[0], newOwnerKey},
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	// The old owner can no longer transfer the subnet
+	_, err = vm.newTransferSubnetOwnershipTx(
+		testSubnet1.ID(),
+		1,
+		[]ids.ShortID{keys[0].PublicKey().Address()},
+		oldOwnerKeys,
+		ids.ShortEmpty, // change addr
+	)
+	assert.ErrorIs(err, errCantSign)
+}
+
+func TestTransferSubnetOwnershipTxUnauthorized(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+
+	tx, err := vm.newTransferSubnetOwnershipTx(
+		testSubnet1.ID(),
+		1,
+		[]ids.ShortID{keys[3].PublicKey().Address()},
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	// Remove a subnet control signature so the threshold isn't met
+	utx := tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx)
+	subnetAuth := utx.SubnetAuth.(*secp256k1fx.Input)
+	subnetAuth.SigIndices = subnetAuth.SigIndices[:1]
+	subnetCred := tx.Creds[len(tx.Creds)-1].(*secp256k1fx.Credential)
+	subnetCred.Sigs = subnetCred.Sigs[:1]
+
+	vs := newVersionedState(
+		vm.internalState,
+		vm.internalState.CurrentStakerChainState(),
+		vm.internalState.PendingStakerChainState(),
+	)
+	_, err = utx.Execute(vm, vs, tx)
+	assert.Error(err)
+
+	subnetOwner, err := vm.internalState.GetSubnetOwner(testSubnet1.ID())
+	assert.NoError(err)
+	owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
+	assert.True(ok)
+	assert.EqualValues(2, owner.Threshold)
+}
+
+func TestTransferSubnetOwnershipTxBeforeApricotPhase6(t *testing.T) {
+	assert := assert.New(t)
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+	defer func() {
+		assert.NoError(vm.Shutdown())
+		vm.ctx.Lock.Unlock()
+	}()
+	vm.ApricotPhase6Time = defaultGenesisTime.Add(time.Hour)
+
+	tx, err := vm.newTransferSubnetOwnershipTx(
+		testSubnet1.ID(),
+		1,
+		[]ids.ShortID{keys[3].PublicKey().Address()},
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	assert.NoError(err)
+
+	// The tx is rejected by the mempool
+	assert.ErrorIs(vm.blockBuilder.AddUnverifiedTx(tx), errApricotPhase6NotActivated)
+	assert.False(vm.blockBuilder.Has(tx.ID()))
+
+	// The tx is rejected in a block
+	vs := newVersionedState(
+		vm.internalState,
+		vm.internalState.CurrentStakerChainState(),
+		vm.internalState.PendingStakerChainState(),
+	)
+	_, err = tx.UnsignedTx.(UnsignedDecisionTx).Execute(vm, vs, tx)
+	assert.ErrorIs(err, errApricotPhase6NotActivated)
+
+	// The tx is accepted once the upgrade activates
+	vs.SetTimestamp(vm.ApricotPhase6Time)
+	_, err = tx.UnsignedTx.(UnsignedDecisionTx).Execute(vm, vs, tx)
+	assert.NoError(err)
+}
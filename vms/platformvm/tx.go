@@ -126,3 +126,18 @@ func (tx *Tx) Sign(c codec.Manager, signers [][]*crypto.PrivateKeySECP256K1R) er
 	tx.Initialize(unsignedBytes, signedBytes)
 	return nil
 }
+
+// preVerify verifies the parts of [tx] that don't depend on the chain state:
+// it syntactically verifies [tx] and recovers the public keys that signed it.
+// Both results are cached, so verifying [tx] later doesn't repeat the work.
+func (tx *Tx) preVerify(vm *VM) error {
+	if err := tx.SyntacticVerify(vm.ctx); err != nil {
+		return fmt.Errorf("failed to syntactically verify tx %s: %w", tx.ID(), err)
+	}
+	for _, cred := range tx.Creds {
+		if err := vm.fx.RecoverCredential(tx.UnsignedTx, cred); err != nil {
+			return fmt.Errorf("failed to recover the signers of tx %s: %w", tx.ID(), err)
+		}
+	}
+	return nil
+}
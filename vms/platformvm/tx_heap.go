@@ -18,6 +18,8 @@ type TxHeap interface {
 	Peek() *Tx
 	RemoveTop() *Tx
 	Len() int
+	// List returns the txs in the heap in no particular order
+	List() []*Tx
 }
 
 type heapTx struct {
@@ -63,6 +65,14 @@ func (h *txHeap) RemoveTop() *Tx { return heap.Pop(h.self).(*Tx) }
 
 func (h *txHeap) Len() int { return len(h.txs) }
 
+func (h *txHeap) List() []*Tx {
+	txs := make([]*Tx, len(h.txs))
+	for i, htx := range h.txs {
+		txs[i] = htx.tx
+	}
+	return txs
+}
+
 func (h *txHeap) Swap(i, j int) {
 	// The follow "i"s and "j"s are intentionally swapped to perform the actual
 	// swap
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"math/bits"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var _ TxHeap = &txHeapByFee{}
+
+// txHeapByFee orders txs by the fee they burn per byte, highest first. Txs
+// with the same fee rate are ordered by age.
+type txHeapByFee struct {
+	txHeap
+
+	txIDToFee map[ids.ID]uint64
+}
+
+func NewTxHeapByFee() TxHeap {
+	h := &txHeapByFee{
+		txIDToFee: make(map[ids.ID]uint64),
+	}
+	h.initialize(h)
+	return h
+}
+
+func (h *txHeapByFee) Less(i, j int) bool {
+	iTx := h.txs[i]
+	jTx := h.txs[j]
+	iFee := h.txIDToFee[iTx.tx.ID()]
+	jFee := h.txIDToFee[jTx.tx.ID()]
+	iSize := uint64(len(iTx.tx.Bytes()))
+	jSize := uint64(len(jTx.tx.Bytes()))
+
+	// Compare iFee/iSize with jFee/jSize without losing precision or
+	// overflowing.
+	iHi, iLo := bits.Mul64(iFee, jSize)
+	jHi, jLo := bits.Mul64(jFee, iSize)
+	switch {
+	case iHi != jHi:
+		return iHi > jHi
+	case iLo != jLo:
+		return iLo > jLo
+	default:
+		return iTx.age < jTx.age
+	}
+}
+
+func (h *txHeapByFee) Push(x interface{}) {
+	tx := x.(*Tx)
+	h.txIDToFee[tx.ID()] = txFee(tx)
+	h.txHeap.Push(x)
+}
+
+func (h *txHeapByFee) Pop() interface{} {
+	tx := h.txHeap.Pop().(*Tx)
+	delete(h.txIDToFee, tx.ID())
+	return tx
+}
+
+// txFunds are the inputs and outputs of a tx that move funds
+type txFunds struct {
+	ins []*avax.TransferableInput
+	// importedIns are consumed from the shared memory of [sourceChain]
+	importedIns  []*avax.TransferableInput
+	sourceChain  ids.ID
+	outs         []*avax.TransferableOutput
+	stakedOuts   []*avax.TransferableOutput
+	exportedOuts []*avax.TransferableOutput
+}
+
+// getTxFunds returns the inputs and outputs of [utx]. Returns false if [utx]
+// doesn't move funds.
+func getTxFunds(utx UnsignedTx) (txFunds, bool) {
+	switch utx := utx.(type) {
+	case *UnsignedAddValidatorTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs, stakedOuts: utx.Stake}, true
+	case *UnsignedAddDelegatorTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs, stakedOuts: utx.Stake}, true
+	case *UnsignedAddSubnetValidatorTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs}, true
+	case *UnsignedCreateChainTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs}, true
+	case *UnsignedCreateSubnetTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs}, true
+	case *UnsignedImportTx:
+		return txFunds{
+			ins:         utx.Ins,
+			importedIns: utx.ImportedInputs,
+			sourceChain: utx.SourceChain,
+			outs:        utx.Outs,
+		}, true
+	case *UnsignedExportTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs, exportedOuts: utx.ExportedOutputs}, true
+	case *UnsignedRemoveSubnetValidatorTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs}, true
+	case *UnsignedTransferSubnetOwnershipTx:
+		return txFunds{ins: utx.Ins, outs: utx.Outs}, true
+	default:
+		return txFunds{}, false
+	}
+}
+
+// txFee returns the amount that [tx] burns. Since the P-chain only supports
+// AVAX, the amounts of all the assets are summed. If the amount can't be
+// calculated, 0 is returned.
+func txFee(tx *Tx) uint64 {
+	funds, ok := getTxFunds(tx.UnsignedTx)
+	if !ok {
+		return 0
+	}
+
+	var (
+		consumed uint64
+		produced uint64
+		err      error
+	)
+	for _, inputs := range [][]*avax.TransferableInput{funds.ins, funds.importedIns} {
+		for _, in := range inputs {
+			consumed, err = safemath.Add64(consumed, in.Input().Amount())
+			if err != nil {
+				return 0
+			}
+		}
+	}
+	for _, outputs := range [][]*avax.TransferableOutput{funds.outs, funds.stakedOuts, funds.exportedOuts} {
+		for _, out := range outputs {
+			produced, err = safemath.Add64(produced, out.Output().Amount())
+			if err != nil {
+				return 0
+			}
+		}
+	}
+	if produced > consumed {
+		return 0
+	}
+	return consumed - produced
+}
+
+// txFeeRate returns the amount that [tx] burns per byte
+func txFeeRate(tx *Tx) float64 {
+	size := len(tx.Bytes())
+	if size == 0 {
+		return 0
+	}
+	return float64(txFee(tx)) / float64(size)
+}
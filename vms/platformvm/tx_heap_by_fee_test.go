@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func newTestFeeTx(t *testing.T, fee uint64, memo []byte) *Tx {
+	assetID := ids.GenerateTestID()
+	tx := &Tx{UnsignedTx: &UnsignedCreateSubnetTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    testNetworkID,
+			BlockchainID: ids.GenerateTestID(),
+			Ins: []*avax.TransferableInput{{
+				UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+				Asset:  avax.Asset{ID: assetID},
+				In: &secp256k1fx.TransferInput{
+					Amt:   fee + 1000,
+					Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+				},
+			}},
+			Outs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 1000,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+					},
+				},
+			}},
+			Memo: memo,
+		}},
+		Owner: &secp256k1fx.OutputOwners{},
+	}}
+	if err := tx.Sign(Codec, nil); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestTxHeapByFee(t *testing.T) {
+	assert := assert.New(t)
+
+	txHeap := NewTxHeapByFee()
+
+	lowFeeTx := newTestFeeTx(t, 100, nil)
+	highFeeTx := newTestFeeTx(t, 300, nil)
+	midFeeTx := newTestFeeTx(t, 200, nil)
+	assert.EqualValues(100, txFee(lowFeeTx))
+
+	txHeap.Add(lowFeeTx)
+	assert.Equal(lowFeeTx.ID(), txHeap.Peek().ID())
+	txHeap.Add(highFeeTx)
+	assert.Equal(highFeeTx.ID(), txHeap.Peek().ID())
+	txHeap.Add(midFeeTx)
+	assert.Equal(highFeeTx.ID(), txHeap.Peek().ID())
+
+	assert.Equal(highFeeTx.ID(), txHeap.RemoveTop().ID())
+	assert.Equal(midFeeTx.ID(), txHeap.RemoveTop().ID())
+	assert.Equal(lowFeeTx.ID(), txHeap.RemoveTop().ID())
+	assert.Zero(txHeap.Len())
+}
+
+func TestTxHeapByFeeRate(t *testing.T) {
+	assert := assert.New(t)
+
+	txHeap := NewTxHeapByFee()
+
+	// Burns the same amount as [smallTx] but is larger, so it has a lower fee
+	// rate.
+	largeTx := newTestFeeTx(t, 200, make([]byte, 256))
+	smallTx := newTestFeeTx(t, 200, nil)
+	// Has the same fee rate as [smallTx] but was added later.
+	sameRateTx := newTestFeeTx(t, 200, nil)
+
+	txHeap.Add(largeTx)
+	txHeap.Add(smallTx)
+	txHeap.Add(sameRateTx)
+
+	assert.NotNil(txHeap.Remove(largeTx.ID()))
+	assert.Nil(txHeap.Remove(largeTx.ID()))
+	txHeap.Add(largeTx)
+
+	assert.Equal(smallTx.ID(), txHeap.RemoveTop().ID())
+	assert.Equal(sameRateTx.ID(), txHeap.RemoveTop().ID())
+	assert.Equal(largeTx.ID(), txHeap.RemoveTop().ID())
+	assert.Empty(txHeap.(*txHeapByFee).txIDToFee)
+}
+
+func TestTxHeapWithMetricsFeeRate(t *testing.T) {
+	assert := assert.New(t)
+
+	registerer := prometheus.NewRegistry()
+	byAgeHeap, err := NewTxHeapWithMetrics(NewTxHeapByAge(), "by_age", registerer)
+	assert.NoError(err)
+	byFeeHeap, err := NewTxHeapWithMetrics(NewTxHeapByFee(), "by_fee", registerer)
+	assert.NoError(err)
+
+	tx := newTestFeeTx(t, 100, nil)
+	byAgeHeap.Add(tx)
+	byFeeHeap.Add(tx)
+
+	metrics, err := registerer.Gather()
+	assert.NoError(err)
+	names := make([]string, len(metrics))
+	for i, metric := range metrics {
+		names[i] = metric.GetName()
+	}
+	// Only the heap ordered by fee reports fee rates
+	assert.ElementsMatch(
+		[]string{
+			"by_age_count",
+			"by_fee_count",
+			"by_fee_fee_rate",
+			"by_fee_top_fee_rate",
+		},
+		names,
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
 )
 
 var _ TxHeap = &txHeapWithMetrics{}
@@ -15,8 +16,13 @@ type txHeapWithMetrics struct {
 	TxHeap
 
 	numTxs prometheus.Gauge
+	// Only set if [TxHeap] is ordered by fee
+	feeRate    prometheus.Histogram
+	topFeeRate prometheus.Gauge
 }
 
+// NewTxHeapWithMetrics reports the number of txs in [txHeap]. If [txHeap] is
+// ordered by fee, the fee rates of its txs are reported as well.
 func NewTxHeapWithMetrics(
 	txHeap TxHeap,
 	namespace string,
@@ -30,22 +36,61 @@ func NewTxHeapWithMetrics(
 			Help:      "Number of transactions in the heap",
 		}),
 	}
-	return h, registerer.Register(h.numTxs)
+	if err := registerer.Register(h.numTxs); err != nil {
+		return nil, err
+	}
+	if _, byFee := txHeap.(*txHeapByFee); !byFee {
+		return h, nil
+	}
+
+	h.feeRate = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "fee_rate",
+		Help:      "Fee, in nAVAX per byte, of the transactions added to the heap",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 16),
+	})
+	h.topFeeRate = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "top_fee_rate",
+		Help:      "Fee, in nAVAX per byte, of the transaction at the top of the heap",
+	})
+	errs := wrappers.Errs{}
+	errs.Add(
+		registerer.Register(h.feeRate),
+		registerer.Register(h.topFeeRate),
+	)
+	return h, errs.Err
 }
 
 func (h *txHeapWithMetrics) Add(tx *Tx) {
 	h.TxHeap.Add(tx)
-	h.numTxs.Set(float64(h.TxHeap.Len()))
+	if h.feeRate != nil {
+		h.feeRate.Observe(txFeeRate(tx))
+	}
+	h.update()
 }
 
 func (h *txHeapWithMetrics) Remove(txID ids.ID) *Tx {
 	tx := h.TxHeap.Remove(txID)
-	h.numTxs.Set(float64(h.TxHeap.Len()))
+	h.update()
 	return tx
 }
 
 func (h *txHeapWithMetrics) RemoveTop() *Tx {
 	tx := h.TxHeap.RemoveTop()
-	h.numTxs.Set(float64(h.TxHeap.Len()))
+	h.update()
 	return tx
 }
+
+func (h *txHeapWithMetrics) update() {
+	numTxs := h.TxHeap.Len()
+	h.numTxs.Set(float64(numTxs))
+	if h.topFeeRate == nil {
+		return
+	}
+	if numTxs == 0 {
+		h.topFeeRate.Set(0)
+		return
+	}
+	h.topFeeRate.Set(txFeeRate(h.TxHeap.Peek()))
+}
@@ -323,6 +323,7 @@ func defaultVM() (*VM, database.Database, *common.SenderTest) {
 			ApricotPhase3Time:      defaultValidateEndTime,
 			ApricotPhase4Time:      defaultValidateEndTime,
 			ApricotPhase5Time:      defaultValidateEndTime,
+			ApricotPhase6Time:      defaultGenesisTime,
 		},
 	}}
 
@@ -2116,6 +2117,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		nil,
 		time.Hour,
 		cpuTracker,
+		handler.DefaultLaneWeights,
 	)
 	assert.NoError(t, err)
 
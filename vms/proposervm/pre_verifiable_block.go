@@ -0,0 +1,26 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+var (
+	_ block.PreVerifiableBlock = &preForkBlock{}
+	_ block.PreVerifiableBlock = &postForkBlock{}
+	_ block.PreVerifiableBlock = &postForkOption{}
+)
+
+// PreVerify pre-verifies the inner block, if the inner VM supports it
+func (b *preForkBlock) PreVerify() error {
+	return block.PreVerify(b.Block)
+}
+
+// PreVerify pre-verifies the inner block, if the inner VM supports it. The
+// proposer signature is checked by Verify, as the proposer depends on the
+// validator set at the parent's P-chain height.
+func (p *postForkCommonComponents) PreVerify() error {
+	return block.PreVerify(p.innerBlk)
+}
@@ -66,7 +66,8 @@ var (
 	_ block.StateSyncableVM      = &VMClient{}
 	_ prometheus.Gatherer        = &VMClient{}
 
-	_ snowman.Block = &blockClient{}
+	_ snowman.Block            = &blockClient{}
+	_ block.PreVerifiableBlock = &blockClient{}
 
 	_ block.StateSummary = &summaryClient{}
 )
@@ -846,6 +847,15 @@ func (b *blockClient) Verify() error {
 	return err
 }
 
+// PreVerify pre-verifies the block in the plugin, if the plugin's block
+// supports it
+func (b *blockClient) PreVerify() error {
+	_, err := b.vm.client.BlockPreVerify(context.Background(), &vmpb.BlockPreVerifyRequest{
+		Id: b.id[:],
+	})
+	return err
+}
+
 func (b *blockClient) Bytes() []byte        { return b.bytes }
 func (b *blockClient) Height() uint64       { return b.height }
 func (b *blockClient) Timestamp() time.Time { return b.time }
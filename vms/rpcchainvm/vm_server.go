@@ -15,6 +15,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/api/keystore/gkeystore"
 	"github.com/ava-labs/avalanchego/api/metrics"
+	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/chains/atomic/gsharedmemory"
 	"github.com/ava-labs/avalanchego/database/corruptabledb"
 	"github.com/ava-labs/avalanchego/database/manager"
@@ -22,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/ids/galiasreader"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/appsender"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
@@ -44,6 +46,16 @@ import (
 	vmpb "github.com/ava-labs/avalanchego/proto/pb/vm"
 )
 
+const (
+	// preVerifiableBlocksCacheSize is the number of parsed blocks that are kept
+	// to be pre-verified
+	preVerifiableBlocksCacheSize = 256
+
+	// preVerifiedBlocksCacheSize is the number of pre-verified blocks that are
+	// kept to be verified
+	preVerifiedBlocksCacheSize = 256
+)
+
 var _ vmpb.VMServer = &VMServer{}
 
 // VMServer is a VM that is managed over RPC.
@@ -59,6 +71,15 @@ type VMServer struct {
 
 	ctx    *snow.Context
 	closed chan struct{}
+
+	// blockID --> block.PreVerifiableBlock that was parsed. PreVerify may be
+	// called concurrently with the other methods of the VM, so the block is
+	// pre-verified on the instance that was parsed rather than being parsed
+	// again.
+	preVerifiableBlocks cache.LRU
+	// blockID --> block.PreVerifiableBlock that was pre-verified. Only these
+	// instances replace the block that is parsed to be verified.
+	preVerifiedBlocks cache.LRU
 }
 
 // NewServer returns a vm instance connected to a remote vm instance
@@ -66,9 +87,11 @@ func NewServer(vm block.ChainVM) *VMServer {
 	hVM, _ := vm.(block.HeightIndexedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
 	return &VMServer{
-		vm:   vm,
-		hVM:  hVM,
-		ssVM: ssVM,
+		vm:                  vm,
+		hVM:                 hVM,
+		ssVM:                ssVM,
+		preVerifiableBlocks: cache.LRU{Size: preVerifiableBlocksCacheSize},
+		preVerifiedBlocks:   cache.LRU{Size: preVerifiedBlocksCacheSize},
 	}
 }
 
@@ -362,6 +385,9 @@ func (vm *VMServer) ParseBlock(_ context.Context, req *vmpb.ParseBlockRequest) (
 		return nil, err
 	}
 	blkID := blk.ID()
+	if _, ok := blk.(block.PreVerifiableBlock); ok {
+		vm.preVerifiableBlocks.Put(blkID, blk)
+	}
 	parentID := blk.Parent()
 	return &vmpb.ParseBlockResponse{
 		Id:        blkID[:],
@@ -704,6 +730,11 @@ func (vm *VMServer) BlockVerify(_ context.Context, req *vmpb.BlockVerifyRequest)
 	if err != nil {
 		return nil, err
 	}
+	blkID := blk.ID()
+	if preVerifiedBlk, ok := vm.preVerifiedBlocks.Get(blkID); ok {
+		vm.preVerifiedBlocks.Evict(blkID)
+		blk = preVerifiedBlk.(snowman.Block)
+	}
 	if err := blk.Verify(); err != nil {
 		return nil, err
 	}
@@ -712,6 +743,27 @@ func (vm *VMServer) BlockVerify(_ context.Context, req *vmpb.BlockVerifyRequest)
 	}, nil
 }
 
+// BlockPreVerify pre-verifies the instance of the block that was parsed, which
+// is then verified instead of the instance that is parsed to be verified. If
+// the block wasn't parsed recently, or doesn't support pre-verification,
+// nothing is done, as Verify runs all the checks of PreVerify.
+func (vm *VMServer) BlockPreVerify(_ context.Context, req *vmpb.BlockPreVerifyRequest) (*emptypb.Empty, error) {
+	id, err := ids.ToID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	blk, ok := vm.preVerifiableBlocks.Get(id)
+	if !ok {
+		return &emptypb.Empty{}, nil
+	}
+	vm.preVerifiableBlocks.Evict(id)
+	if err := blk.(block.PreVerifiableBlock).PreVerify(); err != nil {
+		return nil, err
+	}
+	vm.preVerifiedBlocks.Put(id, blk)
+	return &emptypb.Empty{}, nil
+}
+
 func (vm *VMServer) BlockAccept(_ context.Context, req *vmpb.BlockAcceptRequest) (*emptypb.Empty, error) {
 	id, err := ids.ToID(req.Id)
 	if err != nil {
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2021, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+
+	vmpb "github.com/ava-labs/avalanchego/proto/pb/vm"
+)
+
+var _ block.PreVerifiableBlock = &testPreVerifiableBlock{}
+
+type testPreVerifiableBlock struct {
+	*snowman.TestBlock
+	preVerifyErr error
+	preVerified  bool
+	verified     bool
+}
+
+func (b *testPreVerifiableBlock) PreVerify() error {
+	b.preVerified = true
+	return b.preVerifyErr
+}
+
+func (b *testPreVerifiableBlock) Verify() error {
+	b.verified = true
+	return b.TestBlock.Verify()
+}
+
+// Test that the instance of a block that was parsed is the one that is
+// pre-verified, and that only a pre-verified instance replaces the block that is
+// parsed to be verified
+func TestBlockPreVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	blkID, blkBytes := ids.GenerateTestID(), []byte{1}
+	errPreVerify := errors.New("invalid block")
+	var (
+		parsed        []*testPreVerifiableBlock
+		preVerifyErrs = []error{errPreVerify, nil, nil, nil, nil}
+	)
+	vm := &block.TestVM{}
+	vm.ParseBlockF = func([]byte) (snowman.Block, error) {
+		blk := &testPreVerifiableBlock{
+			TestBlock: &snowman.TestBlock{
+				TestDecidable: choices.TestDecidable{
+					IDV:     blkID,
+					StatusV: choices.Processing,
+				},
+				BytesV:     blkBytes,
+				TimestampV: time.Unix(1, 0),
+			},
+			preVerifyErr: preVerifyErrs[len(parsed)],
+		}
+		parsed = append(parsed, blk)
+		return blk, nil
+	}
+	server := NewServer(vm)
+
+	// Pre-verifying a block that wasn't parsed is a noop
+	_, err := server.BlockPreVerify(context.Background(), &vmpb.BlockPreVerifyRequest{Id: blkID[:]})
+	assert.NoError(err)
+
+	_, err = server.ParseBlock(context.Background(), &vmpb.ParseBlockRequest{Bytes: blkBytes})
+	assert.NoError(err)
+	_, err = server.BlockPreVerify(context.Background(), &vmpb.BlockPreVerifyRequest{Id: blkID[:]})
+	assert.ErrorIs(err, errPreVerify)
+
+	_, err = server.ParseBlock(context.Background(), &vmpb.ParseBlockRequest{Bytes: blkBytes})
+	assert.NoError(err)
+	_, err = server.BlockPreVerify(context.Background(), &vmpb.BlockPreVerifyRequest{Id: blkID[:]})
+	assert.NoError(err)
+	assert.Len(parsed, 2)
+	assert.True(parsed[1].preVerified)
+
+	// Verifying the block re-parses it, but verifies the pre-verified instance
+	_, err = server.BlockVerify(context.Background(), &vmpb.BlockVerifyRequest{Bytes: blkBytes})
+	assert.NoError(err)
+	assert.Len(parsed, 3)
+	assert.True(parsed[1].verified)
+	assert.False(parsed[2].verified)
+
+	// A block that was parsed, but not pre-verified, is verified as it is
+	// parsed by BlockVerify
+	_, err = server.ParseBlock(context.Background(), &vmpb.ParseBlockRequest{Bytes: blkBytes})
+	assert.NoError(err)
+	_, err = server.BlockVerify(context.Background(), &vmpb.BlockVerifyRequest{Bytes: blkBytes})
+	assert.NoError(err)
+	assert.Len(parsed, 5)
+	assert.False(parsed[3].verified)
+	assert.True(parsed[4].verified)
+}
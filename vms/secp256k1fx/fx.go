@@ -206,6 +206,27 @@ func (fx *Fx) VerifyCredentials(tx Tx, in *Input, cred *Credential, out *OutputO
 	return nil
 }
 
+// RecoverCredential recovers the public keys that signed [txIntf] in
+// [credIntf] into the recovery cache, so that verifying the credential later
+// doesn't have to recover them again. Signatures that can't be recovered are
+// reported when the credential is verified. It is safe to call concurrently.
+func (fx *Fx) RecoverCredential(txIntf, credIntf interface{}) error {
+	tx, ok := txIntf.(Tx)
+	if !ok {
+		return errWrongTxType
+	}
+	cred, ok := credIntf.(*Credential)
+	if !ok {
+		return errWrongCredentialType
+	}
+
+	txHash := hashing.ComputeHash256(tx.UnsignedBytes())
+	for _, sig := range cred.Sigs {
+		_, _ = fx.SECPFactory.RecoverHashPublicKey(txHash, sig[:])
+	}
+	return nil
+}
+
 // CreateOutput creates a new output with the provided control group worth
 // the specified amount
 func (fx *Fx) CreateOutput(amount uint64, ownerIntf interface{}) (interface{}, error) {
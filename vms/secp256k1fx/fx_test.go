@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/crypto"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/logging"
 )
 
@@ -1141,3 +1142,38 @@ func TestVerifyPermission(t *testing.T) {
 		}
 	}
 }
+
+func TestFxRecoverCredential(t *testing.T) {
+	vm := TestVM{
+		Codec: linearcodec.NewDefault(),
+		Log:   logging.NoLog{},
+	}
+	fx := Fx{}
+	if err := fx.Initialize(&vm); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := &TestTx{Bytes: txBytes}
+	if err := fx.RecoverCredential(nil, &Credential{}); err != errWrongTxType {
+		t.Fatalf("expected %q, got %v", errWrongTxType, err)
+	}
+	if err := fx.RecoverCredential(tx, nil); err != errWrongCredentialType {
+		t.Fatalf("expected %q, got %v", errWrongCredentialType, err)
+	}
+
+	// Invalid signatures are left to be reported when the credential is
+	// verified
+	cred := &Credential{Sigs: [][crypto.SECP256K1RSigLen]byte{sigBytes, {}}}
+	if err := fx.RecoverCredential(tx, cred); err != nil {
+		t.Fatal(err)
+	}
+
+	cacheKey := hashing.ComputeHash256Array(append(hashing.ComputeHash256(txBytes), sigBytes[:]...))
+	pk, recovered := fx.SECPFactory.Cache.Get(cacheKey)
+	if !recovered {
+		t.Fatal("expected the signer to be recovered")
+	}
+	if recoveredAddr := pk.(crypto.PublicKey).Address(); recoveredAddr != addr {
+		t.Fatalf("expected signer %s, got %s", addr, recoveredAddr)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 )
 
 var _ Backend = &backend{}
@@ -33,6 +34,13 @@ type Backend interface {
 	SignerBackend
 
 	AcceptTx(ctx stdcontext.Context, tx *platformvm.Tx) error
+
+	// SetSubnetOwner records [owner] as the current owner of [subnetID].
+	//
+	// The backend only learns of ownership transfers through AcceptTx, so this
+	// must be called if the ownership of the subnet was transferred by anyone
+	// other than this wallet.
+	SetSubnetOwner(subnetID ids.ID, owner fx.Owner)
 }
 
 type backend struct {
@@ -42,6 +50,9 @@ type backend struct {
 	txsLock sync.RWMutex
 	// txID -> tx
 	txs map[ids.ID]*platformvm.Tx
+	// subnetID -> owner, for subnets whose ownership has been transferred or
+	// whose owner was set by the caller
+	subnetOwners map[ids.ID]fx.Owner
 }
 
 func NewBackend(ctx Context, utxos ChainUTXOs, txs map[ids.ID]*platformvm.Tx) Backend {
@@ -49,6 +60,8 @@ func NewBackend(ctx Context, utxos ChainUTXOs, txs map[ids.ID]*platformvm.Tx) Ba
 		Context:    ctx,
 		ChainUTXOs: utxos,
 		txs:        txs,
+
+		subnetOwners: make(map[ids.ID]fx.Owner),
 	}
 }
 
@@ -60,6 +73,10 @@ func (b *backend) AcceptTx(ctx stdcontext.Context, tx *platformvm.Tx) error {
 		baseTx = &utx.BaseTx
 	case *platformvm.UnsignedAddSubnetValidatorTx:
 		baseTx = &utx.BaseTx
+	case *platformvm.UnsignedRemoveSubnetValidatorTx:
+		baseTx = &utx.BaseTx
+	case *platformvm.UnsignedTransferSubnetOwnershipTx:
+		baseTx = &utx.BaseTx
 	case *platformvm.UnsignedAddValidatorTx:
 		baseTx = &utx.BaseTx
 	case *platformvm.UnsignedExportTx:
@@ -114,6 +131,9 @@ func (b *backend) AcceptTx(ctx stdcontext.Context, tx *platformvm.Tx) error {
 	defer b.txsLock.Unlock()
 
 	b.txs[txID] = tx
+	if utx, ok := tx.UnsignedTx.(*platformvm.UnsignedTransferSubnetOwnershipTx); ok {
+		b.subnetOwners[utx.Subnet] = utx.Owner
+	}
 	return nil
 }
 
@@ -145,3 +165,33 @@ func (b *backend) GetTx(_ stdcontext.Context, txID ids.ID) (*platformvm.Tx, erro
 	}
 	return tx, nil
 }
+
+func (b *backend) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	b.txsLock.Lock()
+	defer b.txsLock.Unlock()
+
+	b.subnetOwners[subnetID] = owner
+}
+
+// GetSubnetOwner returns the owner of [subnetID] that was set with
+// SetSubnetOwner or by the last accepted TransferSubnetOwnershipTx. Otherwise,
+// the owner in the subnet's CreateSubnetTx is returned. The node isn't queried,
+// so a transfer that wasn't accepted through this backend isn't known.
+func (b *backend) GetSubnetOwner(ctx stdcontext.Context, subnetID ids.ID) (fx.Owner, error) {
+	b.txsLock.RLock()
+	owner, transferred := b.subnetOwners[subnetID]
+	b.txsLock.RUnlock()
+	if transferred {
+		return owner, nil
+	}
+
+	subnetTx, err := b.GetTx(ctx, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	subnet, ok := subnetTx.UnsignedTx.(*platformvm.UnsignedCreateSubnetTx)
+	if !ok {
+		return nil, errWrongTxType
+	}
+	return subnet.Owner, nil
+}
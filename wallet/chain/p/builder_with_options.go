@@ -76,6 +76,30 @@ func (b *builderWithOptions) NewAddSubnetValidatorTx(
 	)
 }
 
+func (b *builderWithOptions) NewRemoveSubnetValidatorTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	options ...common.Option,
+) (*platformvm.UnsignedRemoveSubnetValidatorTx, error) {
+	return b.Builder.NewRemoveSubnetValidatorTx(
+		nodeID,
+		subnetID,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*platformvm.UnsignedTransferSubnetOwnershipTx, error) {
+	return b.Builder.NewTransferSubnetOwnershipTx(
+		subnetID,
+		owner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
 func (b *builderWithOptions) NewAddDelegatorTx(
 	validator *pChainValidator.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
@@ -42,6 +43,7 @@ type Signer interface {
 type SignerBackend interface {
 	GetUTXO(ctx stdcontext.Context, chainID, utxoID ids.ID) (*avax.UTXO, error)
 	GetTx(ctx stdcontext.Context, txID ids.ID) (*platformvm.Tx, error)
+	GetSubnetOwner(ctx stdcontext.Context, subnetID ids.ID) (fx.Owner, error)
 }
 
 type signer struct {
@@ -69,6 +71,10 @@ func (s *signer) Sign(ctx stdcontext.Context, tx *platformvm.Tx) error {
 		return s.signAddValidatorTx(ctx, tx, utx)
 	case *platformvm.UnsignedAddSubnetValidatorTx:
 		return s.signAddSubnetValidatorTx(ctx, tx, utx)
+	case *platformvm.UnsignedRemoveSubnetValidatorTx:
+		return s.signRemoveSubnetValidatorTx(ctx, tx, utx)
+	case *platformvm.UnsignedTransferSubnetOwnershipTx:
+		return s.signTransferSubnetOwnershipTx(ctx, tx, utx)
 	case *platformvm.UnsignedAddDelegatorTx:
 		return s.signAddDelegatorTx(ctx, tx, utx)
 	case *platformvm.UnsignedCreateChainTx:
@@ -105,6 +111,32 @@ func (s *signer) signAddSubnetValidatorTx(ctx stdcontext.Context, tx *platformvm
 	return s.sign(tx, txSigners)
 }
 
+func (s *signer) signRemoveSubnetValidatorTx(ctx stdcontext.Context, tx *platformvm.Tx, utx *platformvm.UnsignedRemoveSubnetValidatorTx) error {
+	txSigners, err := s.getSigners(ctx, constants.PlatformChainID, utx.Ins)
+	if err != nil {
+		return err
+	}
+	subnetAuthSigners, err := s.getSubnetSigners(ctx, utx.Subnet, utx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, subnetAuthSigners)
+	return s.sign(tx, txSigners)
+}
+
+func (s *signer) signTransferSubnetOwnershipTx(ctx stdcontext.Context, tx *platformvm.Tx, utx *platformvm.UnsignedTransferSubnetOwnershipTx) error {
+	txSigners, err := s.getSigners(ctx, constants.PlatformChainID, utx.Ins)
+	if err != nil {
+		return err
+	}
+	subnetAuthSigners, err := s.getSubnetSigners(ctx, utx.Subnet, utx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, subnetAuthSigners)
+	return s.sign(tx, txSigners)
+}
+
 func (s *signer) signAddDelegatorTx(ctx stdcontext.Context, tx *platformvm.Tx, utx *platformvm.UnsignedAddDelegatorTx) error {
 	txSigners, err := s.getSigners(ctx, constants.PlatformChainID, utx.Ins)
 	if err != nil {
@@ -211,20 +243,16 @@ func (s *signer) getSubnetSigners(ctx stdcontext.Context, subnetID ids.ID, subne
 		return nil, errUnknownSubnetAuthType
 	}
 
-	subnetTx, err := s.backend.GetTx(ctx, subnetID)
+	subnetOwner, err := s.backend.GetSubnetOwner(ctx, subnetID)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"failed to fetch subnet %q: %w",
+			"failed to fetch the owner of subnet %q: %w",
 			subnetID,
 			err,
 		)
 	}
-	subnet, ok := subnetTx.UnsignedTx.(*platformvm.UnsignedCreateSubnetTx)
-	if !ok {
-		return nil, errWrongTxType
-	}
 
-	owner, ok := subnet.Owner.(*secp256k1fx.OutputOwners)
+	owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
 	if !ok {
 		return nil, errUnknownOwnerType
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
@@ -31,6 +32,12 @@ type Wallet interface {
 	// Signer returns the signer that will be used to sign the transactions.
 	Signer() Signer
 
+	// SetSubnetOwner records [owner] as the current owner of [subnetID]. The
+	// wallet only learns of the ownership transfers it issues, so this must be
+	// called before managing a subnet whose ownership was transferred by
+	// anyone else.
+	SetSubnetOwner(subnetID ids.ID, owner fx.Owner)
+
 	// IssueBaseTx creates, signs, and issues a new simple value transfer.
 	// Because the P-chain doesn't intend for balance transfers to occur, this
 	// method is expensive and abuses the creation of subnets.
@@ -69,6 +76,28 @@ type Wallet interface {
 		options ...common.Option,
 	) (ids.ID, error)
 
+	// IssueRemoveSubnetValidatorTx creates, signs, and issues a transaction
+	// that removes a current or pending validator from a subnet.
+	//
+	// - [nodeID] specifies the node to remove from the subnet.
+	// - [subnetID] specifies the subnet to remove the node from.
+	IssueRemoveSubnetValidatorTx(
+		nodeID ids.NodeID,
+		subnetID ids.ID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueTransferSubnetOwnershipTx creates, signs, and issues a transaction
+	// that replaces the owner of a subnet.
+	//
+	// - [subnetID] specifies the subnet to transfer.
+	// - [owner] specifies who will be authorized to manage the subnet.
+	IssueTransferSubnetOwnershipTx(
+		subnetID ids.ID,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (ids.ID, error)
+
 	// IssueAddDelegatorTx creates, signs, and issues a new delegator to a
 	// validator on the primary network.
 	//
@@ -205,6 +234,30 @@ func (w *wallet) IssueAddSubnetValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueRemoveSubnetValidatorTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewRemoveSubnetValidatorTx(nodeID, subnetID, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewTransferSubnetOwnershipTx(subnetID, owner, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueAddDelegatorTx(
 	validator *pChainValidator.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
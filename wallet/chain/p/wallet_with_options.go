@@ -71,6 +71,30 @@ func (w *walletWithOptions) IssueAddSubnetValidatorTx(
 	)
 }
 
+func (w *walletWithOptions) IssueRemoveSubnetValidatorTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueRemoveSubnetValidatorTx(
+		nodeID,
+		subnetID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.Wallet.IssueTransferSubnetOwnershipTx(
+		subnetID,
+		owner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueAddDelegatorTx(
 	validator *pChainValidator.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,